@@ -2,22 +2,52 @@
 package main
 
 import (
+	"context"
+	"flag"
+	"os"
+
 	"PostedIn/internal/cli"
 	"PostedIn/internal/config"
 	"PostedIn/internal/cron"
+	"PostedIn/internal/models"
+	"PostedIn/internal/notify"
 	"PostedIn/internal/scheduler"
 )
 
 func main() {
-	// Initialize scheduler with JSON storage
-	sched := scheduler.NewScheduler("posts.json")
+	configFlag := flag.String("config", "", "config file path (overrides $"+config.ConfigEnv+")")
+	flag.Parse()
+
+	config.UseConfigPath(*configFlag)
+
+	// Run a non-interactive subcommand when arguments are given
+	if flag.NArg() > 0 {
+		os.Exit(cli.RunCommand(flag.Args()))
+	}
 
-	// Load config for cron scheduler
+	// Load config for the schedulers
 	cfg, err := config.LoadConfig()
 	if err != nil {
 		panic(err)
 	}
 
+	// Initialize scheduler on the configured storage backend
+	sched, err := scheduler.NewSchedulerFromConfig(cfg)
+	if err != nil {
+		panic(err)
+	}
+
+	sched.LoadSwitches(cfg) // Honor the persisted kill switch before anything can publish
+
+	// Send publish notifications to the configured webhook
+	notifications := notify.NewQueueFromConfig(cfg)
+	if notifications != nil {
+		notifications.Start()
+		sched.OnPublished(func(post models.Post, err error) {
+			notifications.Enqueue(notify.NewPublishEvent(post, err))
+		})
+	}
+
 	// Initialize cron scheduler
 	cronScheduler := cron.NewScheduler(sched, cfg)
 
@@ -32,9 +62,18 @@ func main() {
 		}
 	}
 
+	// Reconcile posts whose scheduled time passed while the app was not running, now that catch-up
+	// publishes are notified and the dependents they release can be armed
+	cronScheduler.CatchUp(context.Background())
+
 	// Initialize CLI with both schedulers
 	cliApp := cli.NewCLI(sched, cronScheduler)
 
 	// Run the application
 	cliApp.Run()
+
+	// Deliver pending notifications before exiting
+	if notifications != nil {
+		notifications.Stop()
+	}
 }
@@ -0,0 +1,45 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"net"
+
+	"PostedIn/internal/grpcapi"
+
+	"google.golang.org/grpc"
+)
+
+// startGRPCServer serves the gRPC API on addr in the background.
+func startGRPCServer(addr string, service *grpcapi.Server) (*grpc.Server, error) {
+	if err := validateGRPCAddress(addr); err != nil {
+		return nil, err
+	}
+
+	listener, err := net.Listen("tcp", addr)
+	if err != nil {
+		return nil, fmt.Errorf("failed to listen on %s: %w", addr, err)
+	}
+
+	server := grpc.NewServer()
+	service.Register(server)
+
+	go func() {
+		if err := server.Serve(listener); err != nil {
+			log.Printf("❌ gRPC server error: %v", err)
+		}
+	}()
+
+	log.Printf("🛰️ gRPC API listening on %s", displayAddress(addr))
+
+	return server, nil
+}
+
+// validateGRPCAddress checks that addr is a host:port pair.
+func validateGRPCAddress(addr string) error {
+	if _, _, err := net.SplitHostPort(addr); err != nil {
+		return fmt.Errorf("invalid gRPC address %q: %w", addr, err)
+	}
+
+	return nil
+}
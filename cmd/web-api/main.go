@@ -2,8 +2,11 @@
 package main
 
 import (
+	"context"
 	"errors"
+	"flag"
 	"log"
+	"net"
 	"os"
 	"os/signal"
 	"syscall"
@@ -11,16 +14,39 @@ import (
 	"PostedIn/internal/api"
 	"PostedIn/internal/config"
 	"PostedIn/internal/cron"
+	"PostedIn/internal/email"
+	"PostedIn/internal/grpcapi"
+	"PostedIn/internal/models"
+	"PostedIn/internal/notify"
 	"PostedIn/internal/scheduler"
+	"PostedIn/internal/sheets"
 
 	"github.com/gofiber/fiber/v2"
 	fiberSwagger "github.com/swaggo/fiber-swagger" // fiber middleware for Swagger UI
+	"google.golang.org/grpc"
 
 	// swagger embed files.
 	_ "PostedIn/docs" // swagger docs
 )
 
+// @title LinkedIn Post Scheduler API
+// @version 1.0
+// @description REST API for scheduling and publishing LinkedIn posts.
+// @BasePath /api
 func main() {
+	bindFlag := flag.String("bind", "", "address to listen on, e.g. 0.0.0.0:8080 (overrides linkedin.bind_address and PORT)")
+	configFlag := flag.String("config", "", "config file path (overrides $"+config.ConfigEnv+")")
+	grpcFlag := flag.String("grpc", "", "also serve the gRPC API on this address, e.g. :9090")
+	httpFlag := flag.Bool("http", true, "serve the REST API; use -http=false with -grpc to serve gRPC only")
+	flag.Parse()
+
+	if !*httpFlag && *grpcFlag == "" {
+		log.Println("❌ -http=false needs -grpc, otherwise no API would be served")
+		os.Exit(1)
+	}
+
+	configPath := config.UseConfigPath(*configFlag)
+
 	log.Println("🚀 LinkedIn Post Scheduler - Fiber Web API Server")
 	log.Println("==============================================")
 
@@ -28,16 +54,25 @@ func main() {
 	cfg, err := config.LoadConfig()
 	if err != nil {
 		log.Printf("❌ Failed to load config: %v", err)
-		log.Println("💡 Make sure config.json exists with your LinkedIn app credentials")
+		log.Printf("💡 Make sure %s exists with your LinkedIn app credentials", configPath)
 		os.Exit(1)
 	}
 
-	log.Printf("✅ Configuration loaded successfully")
+	log.Printf("✅ Configuration loaded from %s", configPath)
 	log.Printf("🔧 LinkedIn Client ID: %s", maskString(cfg.LinkedIn.ClientID))
 	log.Printf("🔧 Redirect URL: %s", cfg.LinkedIn.RedirectURL)
 
-	// Initialize scheduler with JSON storage
-	sched := scheduler.NewScheduler("posts.json")
+	// Initialize scheduler on the configured storage backend
+	sched, err := scheduler.NewSchedulerFromConfig(cfg)
+	if err != nil {
+		log.Printf("❌ Failed to open post storage: %v", err)
+		os.Exit(1)
+	}
+
+	sched.LoadSwitches(cfg) // Honor the persisted kill switch before anything can publish
+
+	// Send publish notifications to the configured webhook
+	notifications := startNotifications(cfg, sched)
 
 	// Initialize cron scheduler
 	cronScheduler := cron.NewScheduler(sched, cfg)
@@ -54,7 +89,89 @@ func main() {
 		}
 	}
 
-	// Create Fiber app
+	// Reconcile posts whose scheduled time passed while the server was down, now that catch-up
+	// publishes are notified and the dependents they release can be armed
+	cronScheduler.CatchUp(context.Background())
+
+	// Pull scheduled posts from Google Sheets when configured
+	startSheetsSync(cfg, sched, cronScheduler)
+
+	// Schedule posts from emails when configured
+	startEmailIngestion(cfg, sched, cronScheduler)
+
+	listenAddr, err := resolveListenAddress(*bindFlag, cfg)
+	if err != nil {
+		log.Printf("❌ Invalid bind address: %v", err)
+		os.Exit(1)
+	}
+
+	// Initialize API router
+	router := api.NewRouter(cfg, sched, cronScheduler)
+
+	// The HTTP server can be stopped and restarted on its own while the auto-scheduler keeps running
+	server := &httpServer{addr: listenAddr, newApp: func() *fiber.App { return newApp(router) }}
+	router.SetServerStopper(server.Stop)
+
+	// The gRPC API shares the scheduler, auto-scheduler and create logic with the REST API
+	var grpcServer *grpc.Server
+	if *grpcFlag != "" {
+		grpcServer, err = startGRPCServer(*grpcFlag, grpcapi.NewServer(cfg, sched, cronScheduler, router))
+		if err != nil {
+			log.Printf("❌ gRPC server failed to start: %v", err)
+			os.Exit(1)
+		}
+	}
+
+	if *httpFlag {
+		if err := server.Start(); err != nil {
+			log.Printf("❌ Server failed to start: %v", err)
+			os.Exit(1)
+		}
+
+		log.Printf("📚 API endpoints available at: http://%s/api", displayAddress(listenAddr))
+		log.Printf("🔗 Health check: http://%s/health", displayAddress(listenAddr))
+		log.Printf("🔗 Readiness check: http://%s/ready", displayAddress(listenAddr))
+	}
+
+	sigChan := make(chan os.Signal, 1)
+	signal.Notify(sigChan, append([]os.Signal{syscall.SIGINT, syscall.SIGTERM}, toggleSignals...)...)
+
+	for sig := range sigChan {
+		if sig == syscall.SIGINT || sig == syscall.SIGTERM {
+			break
+		}
+
+		if err := server.Toggle(); err != nil {
+			log.Printf("❌ %v", err)
+		}
+	}
+
+	// Graceful shutdown
+	log.Println("🛑 Shutdown signal received...")
+	if cronScheduler.IsRunning() {
+		log.Println("🛑 Stopping auto-scheduler...")
+		cronScheduler.Stop()
+	}
+
+	if notifications != nil {
+		log.Println("🛑 Flushing notifications...")
+		notifications.Stop()
+	}
+
+	if grpcServer != nil {
+		log.Println("🛑 Stopping gRPC server...")
+		grpcServer.GracefulStop()
+	}
+
+	if err := server.Stop(); err != nil {
+		log.Printf("❌ Server shutdown error: %v", err)
+	}
+
+	log.Println("✅ Server stopped gracefully")
+}
+
+// newApp creates a Fiber app serving the API routes and Swagger UI.
+func newApp(router *api.Router) *fiber.App {
 	app := fiber.New(fiber.Config{
 		AppName: "LinkedIn Post Scheduler API",
 		ErrorHandler: func(c *fiber.Ctx, err error) error {
@@ -70,32 +187,25 @@ func main() {
 		},
 	})
 
-	// Initialize API router
-	router := api.NewRouter(cfg, sched, cronScheduler)
 	router.SetupRoutes(app)
 
 	// Serve Swagger UI at /swagger/*
 	app.Get("/swagger/*", fiberSwagger.WrapHandler)
 
-	// Graceful shutdown
-	go func() {
-		sigChan := make(chan os.Signal, 1)
-		signal.Notify(sigChan, syscall.SIGINT, syscall.SIGTERM)
-		<-sigChan
-
-		log.Println("🛑 Shutdown signal received...")
-		if cronScheduler.IsRunning() {
-			log.Println("🛑 Stopping auto-scheduler...")
-			cronScheduler.Stop()
-		}
+	return app
+}
 
-		log.Println("🛑 Shutting down server...")
-		if err := app.Shutdown(); err != nil {
-			log.Printf("❌ Server shutdown error: %v", err)
-		}
-		log.Println("✅ Server stopped gracefully")
-		os.Exit(0)
-	}()
+// resolveListenAddress picks the listen address from the -bind flag, the configured
+// bind address, or the PORT env var (default 8080), in that order.
+// The redirect URL advertised to LinkedIn is not affected.
+func resolveListenAddress(bindFlag string, cfg *config.Config) (string, error) {
+	if bindFlag != "" {
+		return bindFlag, config.ValidateBindAddress(bindFlag)
+	}
+
+	if cfg.LinkedIn.BindAddress != "" {
+		return cfg.LinkedIn.BindAddress, config.ValidateBindAddress(cfg.LinkedIn.BindAddress)
+	}
 
 	// Use PORT env var if set, otherwise default to 8080
 	port := os.Getenv("PORT")
@@ -103,14 +213,17 @@ func main() {
 		port = "8080"
 	}
 
-	log.Printf("🌐 Fiber Web API server starting on port %s", port)
-	log.Printf("📚 API endpoints available at: http://localhost:%s/api", port)
-	log.Printf("🔗 Health check: http://localhost:%s/health", port)
+	return ":" + port, nil
+}
 
-	if err := app.Listen(":" + port); err != nil {
-		log.Printf("❌ Server failed to start: %v", err)
-		os.Exit(1)
+// displayAddress turns a listen address into a browsable host:port.
+func displayAddress(addr string) string {
+	host, port, err := net.SplitHostPort(addr)
+	if err != nil || host == "" || host == "0.0.0.0" || host == "::" {
+		return "localhost:" + port
 	}
+
+	return addr
 }
 
 // maskString masks all but the first 4 characters of a string for logging.
@@ -120,3 +233,72 @@ func maskString(s string) string {
 	}
 	return s[:4] + "****"
 }
+
+// startSheetsSync runs the Google Sheets sync in the background and arms imported posts.
+func startSheetsSync(cfg *config.Config, sched *scheduler.Scheduler, cronScheduler *cron.Scheduler) {
+	syncer, err := sheets.NewSyncerFromConfig(cfg, sched)
+	if err != nil {
+		log.Printf("⚠️ Google Sheets sync disabled: %v", err)
+		return
+	}
+
+	if syncer == nil {
+		return
+	}
+
+	syncer.OnAdded(func(post models.Post) { armPost(cfg, cronScheduler, post) })
+
+	go syncer.Run(context.Background())
+}
+
+// armPost arms the timer of a post created in the background, starting the auto-scheduler if
+// it is enabled but not running yet, which arms every pending post.
+func armPost(cfg *config.Config, cronScheduler *cron.Scheduler, post models.Post) {
+	if !cronScheduler.IsRunning() {
+		if cfg.Cron.Enabled {
+			if err := cronScheduler.Start(); err != nil {
+				log.Printf("⚠️ Could not start auto-scheduler: %v", err)
+			}
+		}
+
+		return
+	}
+
+	if err := cronScheduler.AddNewPost(&post); err != nil {
+		log.Printf("⚠️ Failed to schedule imported post %d: %v", post.ID, err)
+	}
+}
+
+// startEmailIngestion polls the configured mailbox in the background and arms posts created from emails.
+func startEmailIngestion(cfg *config.Config, sched *scheduler.Scheduler, cronScheduler *cron.Scheduler) {
+	poller, err := email.NewPollerFromConfig(cfg, sched)
+	if err != nil {
+		log.Printf("⚠️ Email ingestion disabled: %v", err)
+		return
+	}
+
+	if poller == nil {
+		return
+	}
+
+	poller.OnAdded(func(post models.Post) { armPost(cfg, cronScheduler, post) })
+
+	go poller.Run(context.Background())
+}
+
+// startNotifications queues a webhook event for every publish attempt when a webhook is configured.
+func startNotifications(cfg *config.Config, sched *scheduler.Scheduler) *notify.Queue {
+	queue := notify.NewQueueFromConfig(cfg)
+	if queue == nil {
+		return nil
+	}
+
+	queue.Start()
+	sched.OnPublished(func(post models.Post, err error) {
+		queue.Enqueue(notify.NewPublishEvent(post, err))
+	})
+
+	log.Println("🔔 Publish notifications enabled")
+
+	return queue
+}
@@ -0,0 +1,55 @@
+package main
+
+import (
+	"testing"
+
+	"PostedIn/internal/config"
+)
+
+func TestResolveListenAddress(t *testing.T) {
+	configured := &config.Config{LinkedIn: config.LinkedInConfig{BindAddress: "0.0.0.0:9000"}}
+
+	tests := []struct {
+		name    string
+		flag    string
+		cfg     *config.Config
+		port    string
+		want    string
+		wantErr bool
+	}{
+		{"flag wins", "127.0.0.1:7000", configured, "6000", "127.0.0.1:7000", false},
+		{"config", "", configured, "6000", "0.0.0.0:9000", false},
+		{"PORT", "", &config.Config{}, "6000", ":6000", false},
+		{"default", "", &config.Config{}, "", ":8080", false},
+		{"invalid flag", "localhost", configured, "", "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("PORT", tt.port)
+
+			got, err := resolveListenAddress(tt.flag, tt.cfg)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if !tt.wantErr && got != tt.want {
+				t.Errorf("address = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDisplayAddress(t *testing.T) {
+	for addr, want := range map[string]string{
+		":8080":          "localhost:8080",
+		"0.0.0.0:9000":   "localhost:9000",
+		"[::]:9000":      "localhost:9000",
+		"10.0.0.5:8080":  "10.0.0.5:8080",
+		"example.com:80": "example.com:80",
+	} {
+		if got := displayAddress(addr); got != want {
+			t.Errorf("displayAddress(%q) = %q, want %q", addr, got, want)
+		}
+	}
+}
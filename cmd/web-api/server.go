@@ -0,0 +1,98 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// drainTimeout bounds how long stopping the HTTP server waits for in-flight requests.
+const drainTimeout = 30 * time.Second
+
+// httpServer runs the Fiber app and can be stopped and started again without touching the
+// cron scheduler, so the API can go offline for maintenance while posts keep publishing.
+type httpServer struct {
+	addr   string
+	newApp func() *fiber.App
+
+	mu  sync.Mutex
+	app *fiber.App
+	ln  net.Listener
+}
+
+// Start binds the listen address and serves a fresh app in the background.
+func (s *httpServer) Start() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.app != nil {
+		return fmt.Errorf("HTTP server is already running")
+	}
+
+	// Bind synchronously so a busy port is reported to the caller
+	ln, err := net.Listen("tcp", s.addr)
+	if err != nil {
+		return fmt.Errorf("failed to listen on %s: %w", s.addr, err)
+	}
+
+	app := s.newApp()
+	s.app, s.ln = app, ln
+
+	go func() {
+		if err := app.Listener(ln); err != nil {
+			log.Printf("❌ HTTP server error: %v", err)
+		}
+	}()
+
+	log.Printf("🌐 HTTP server listening on %s", s.addr)
+
+	return nil
+}
+
+// Stop closes the listener and waits up to drainTimeout for in-flight requests to finish.
+func (s *httpServer) Stop() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.app == nil {
+		return nil
+	}
+
+	log.Println("🛑 Stopping HTTP server, draining in-flight requests...")
+
+	err := s.app.ShutdownWithTimeout(drainTimeout)
+
+	// Shutdown only closes the listener once serving has begun, so close it here too; otherwise a
+	// stop right after a start would keep the port bound and the next start would fail
+	_ = s.ln.Close()
+	s.app, s.ln = nil, nil
+
+	if err != nil {
+		return fmt.Errorf("HTTP server shutdown: %w", err)
+	}
+
+	log.Println("✅ HTTP server stopped")
+
+	return nil
+}
+
+// Toggle stops a running server or starts a stopped one.
+func (s *httpServer) Toggle() error {
+	if s.Running() {
+		return s.Stop()
+	}
+
+	return s.Start()
+}
+
+// Running reports whether the HTTP server is serving.
+func (s *httpServer) Running() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.app != nil
+}
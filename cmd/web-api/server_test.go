@@ -0,0 +1,160 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"PostedIn/internal/config"
+	"PostedIn/internal/cron"
+	"PostedIn/internal/models"
+	"PostedIn/internal/scheduler"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// freeAddr returns a loopback address with a port nothing is listening on.
+func freeAddr(t *testing.T) string {
+	t.Helper()
+
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	addr := ln.Addr().String()
+	_ = ln.Close()
+
+	return addr
+}
+
+func TestHTTPServerStopDrainsRequests(t *testing.T) {
+	started := make(chan struct{})
+	release := make(chan struct{})
+
+	server := &httpServer{addr: freeAddr(t), newApp: func() *fiber.App {
+		app := fiber.New(fiber.Config{DisableStartupMessage: true})
+		app.Get("/slow", func(c *fiber.Ctx) error {
+			close(started)
+			<-release
+
+			return c.SendString("done")
+		})
+
+		return app
+	}}
+
+	if err := server.Start(); err != nil {
+		t.Fatalf("Start: %v", err)
+	}
+
+	if err := server.Start(); err == nil {
+		t.Error("second Start succeeded while running")
+	}
+
+	response := make(chan string, 1)
+
+	go func() {
+		resp, err := http.Get(fmt.Sprintf("http://%s/slow", server.addr))
+		if err != nil {
+			response <- err.Error()
+			return
+		}
+
+		defer resp.Body.Close()
+
+		body, _ := io.ReadAll(resp.Body)
+		response <- string(body)
+	}()
+
+	<-started
+
+	stopped := make(chan error, 1)
+
+	go func() { stopped <- server.Stop() }()
+
+	// The in-flight request holds the shutdown open until it finishes
+	select {
+	case err := <-stopped:
+		t.Fatalf("Stop returned %v before the in-flight request finished", err)
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	close(release)
+
+	if body := <-response; body != "done" {
+		t.Errorf("in-flight request got %q, want it answered", body)
+	}
+
+	if err := <-stopped; err != nil {
+		t.Fatalf("Stop: %v", err)
+	}
+
+	if server.Running() {
+		t.Error("server still running after Stop")
+	}
+
+	if _, err := http.Get(fmt.Sprintf("http://%s/slow", server.addr)); err == nil {
+		t.Error("stopped server still accepted a request")
+	}
+}
+
+func TestStoppingHTTPServerKeepsCronRunning(t *testing.T) {
+	cfg := &config.Config{Timezone: config.TimezoneConfig{Location: "UTC"}, DryRun: true}
+
+	sched := scheduler.NewScheduler(filepath.Join(t.TempDir(), "posts.json"))
+	sched.LoadSwitches(cfg)
+
+	var published atomic.Int32
+
+	sched.OnPublished(func(models.Post, error) { published.Add(1) })
+
+	if _, err := sched.Add(models.Post{Content: "keeps going", ScheduledAt: time.Now().Add(700 * time.Millisecond)}, cfg); err != nil {
+		t.Fatalf("Add: %v", err)
+	}
+
+	cronScheduler := cron.NewScheduler(sched, cfg)
+	if err := cronScheduler.Start(); err != nil {
+		t.Fatalf("cron Start: %v", err)
+	}
+
+	t.Cleanup(cronScheduler.Stop)
+
+	server := &httpServer{addr: freeAddr(t), newApp: func() *fiber.App {
+		return fiber.New(fiber.Config{DisableStartupMessage: true})
+	}}
+
+	if err := server.Start(); err != nil {
+		t.Fatalf("Start: %v", err)
+	}
+
+	if err := server.Toggle(); err != nil || server.Running() {
+		t.Fatalf("Toggle = %v, running %v, want the server stopped", err, server.Running())
+	}
+
+	if !cronScheduler.IsRunning() {
+		t.Fatal("stopping the HTTP server stopped the auto-scheduler")
+	}
+
+	deadline := time.Now().Add(5 * time.Second)
+	for published.Load() == 0 && time.Now().Before(deadline) {
+		time.Sleep(20 * time.Millisecond)
+	}
+
+	if published.Load() != 1 {
+		t.Fatal("the armed timer did not publish while the HTTP server was down")
+	}
+
+	if err := server.Toggle(); err != nil || !server.Running() {
+		t.Fatalf("Toggle = %v, running %v, want the server started again", err, server.Running())
+	}
+
+	if err := server.Stop(); err != nil {
+		t.Fatalf("Stop: %v", err)
+	}
+}
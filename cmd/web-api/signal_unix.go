@@ -0,0 +1,11 @@
+//go:build !windows
+
+package main
+
+import (
+	"os"
+	"syscall"
+)
+
+// toggleSignals stop a running HTTP server or start a stopped one.
+var toggleSignals = []os.Signal{syscall.SIGUSR1}
@@ -0,0 +1,6 @@
+package main
+
+import "os"
+
+// toggleSignals is empty on Windows, which has no SIGUSR1; use POST /api/server/stop instead.
+var toggleSignals []os.Signal
@@ -3,6 +3,7 @@ package api
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
 	"os"
@@ -24,11 +25,21 @@ type AuthResponse struct {
 	UserID  string `json:"user_id,omitempty"`
 }
 
+// Reasons reported by the auth status when the token cannot be used as it is.
+const (
+	AuthReasonMissing     = "missing"     // Never authenticated or logged out: authenticate
+	AuthReasonInvalid     = "invalid"     // The token file cannot be read or decrypted: authenticate again
+	AuthReasonExpired     = "expired"     // Expired without a refresh token: authenticate again
+	AuthReasonRefreshable = "refreshable" // Expired, but a stored refresh token can renew it without signing in
+)
+
 // @Description Response format for authentication status.
 type AuthStatusResponse struct {
 	Authenticated bool   `json:"authenticated"`
 	UserID        string `json:"user_id"`
 	ExpiresAt     string `json:"expires_at,omitempty"`
+	Expired       bool   `json:"expired"`
+	Reason        string `json:"reason,omitempty"` // Why the token is not usable as it is; empty when authenticated
 }
 
 // setupAuthRoutes configures all authentication-related routes.
@@ -48,8 +59,18 @@ func (r *Router) getLinkedInAuthURL(c *fiber.Ctx) error {
 		r.config.LinkedIn.ClientSecret,
 		r.config.LinkedIn.RedirectURL,
 	)
+	linkedinConfig.Scopes = r.config.OAuthScopes()
 	client := linkedin.NewClient(linkedinConfig)
-	authURL := client.GetAuthURL("linkedin-auth-state")
+
+	state, err := r.authStates.Issue()
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"success": false,
+			"error":   err.Error(),
+		})
+	}
+
+	authURL := client.GetAuthURL(state)
 
 	return c.JSON(fiber.Map{
 		"success":  true,
@@ -61,24 +82,44 @@ func (r *Router) getLinkedInAuthURL(c *fiber.Ctx) error {
 func (r *Router) getAuthStatus(c *fiber.Ctx) error {
 	token, err := config.LoadToken(r.config.Storage.TokenFile)
 	if err != nil || token == nil {
+		reason := AuthReasonInvalid
+		if token == nil && (err == nil || errors.Is(err, config.ErrTokenNotFound)) {
+			reason = AuthReasonMissing
+		} else {
+			log.Printf("⚠️ Token file unusable: %v", err)
+		}
+
 		return c.JSON(fiber.Map{
 			"success": true,
 			"data": AuthStatusResponse{
 				Authenticated: false,
 				UserID:        "",
+				Reason:        reason,
 			},
 		})
 	}
 
+	// Read the identity through a snapshot, as publishes may be caching it concurrently
+	identity, _ := r.config.ForAccount(config.DefaultAccount)
+
 	response := AuthStatusResponse{
-		Authenticated: true,
-		UserID:        r.config.LinkedIn.UserID,
+		Authenticated: token.Valid(),
+		UserID:        identity.LinkedIn.UserID,
 	}
 
 	if !token.Expiry.IsZero() {
 		response.ExpiresAt = token.Expiry.Format("2006-01-02T15:04:05Z07:00")
 	}
 
+	if !response.Authenticated {
+		response.Expired = true
+		response.Reason = AuthReasonExpired
+
+		if token.RefreshToken != "" {
+			response.Reason = AuthReasonRefreshable
+		}
+	}
+
 	return c.JSON(fiber.Map{
 		"success": true,
 		"data":    response,
@@ -95,8 +136,8 @@ func (r *Router) logout(c *fiber.Ctx) error {
 		})
 	}
 
-	// Clear user ID from config
-	r.config.LinkedIn.UserID = ""
+	// Clear user ID and cached author URN from config
+	r.config.StoreAccountIdentity(config.DefaultAccount, &config.Config{})
 	if err := config.SaveConfig(r.config); err != nil {
 		log.Printf("⚠️ Config save failed during logout: %v", err)
 		// Don't fail completely - token removal is more important
@@ -180,9 +221,9 @@ func (r *Router) handleCallback(c *fiber.Ctx) error {
 		return r.renderError(c, fmt.Sprintf("LinkedIn OAuth Error: %s - %s", errorParam, errorDesc))
 	}
 
-	// Validate state parameter
-	if state != "linkedin-auth-state" {
-		return r.renderError(c, "Invalid state parameter - possible CSRF attack")
+	// Validate state parameter: it must be one this server issued, unused and unexpired
+	if !r.authStates.Consume(state) {
+		return r.renderError(c, "Invalid or expired state parameter - possible CSRF attack, start the sign-in again")
 	}
 
 	if code == "" {
@@ -219,35 +260,49 @@ func (r *Router) handleCallback(c *fiber.Ctx) error {
 		log.Printf("⚠️ Profile fetch failed: %v", err)
 		// Don't fail completely - token is still valid
 	} else {
-		// Save user ID to config
-		if id, ok := profile["sub"].(string); ok {
-			r.config.LinkedIn.UserID = id
-			if err := config.SaveConfig(r.config); err != nil {
-				log.Printf("⚠️ Config save failed: %v", err)
-			}
+		// Save user ID to config; the author URN is cached by the first successful publish
+		if err := r.config.ApplyProfile(profile); err != nil {
+			log.Printf("⚠️ User ID detection failed: %v", err)
+		}
+
+		if err := config.SaveConfig(r.config); err != nil {
+			log.Printf("⚠️ Config save failed: %v", err)
 		}
 	}
 
+	identity, _ := r.config.ForAccount(config.DefaultAccount)
+
 	log.Println("✅ LinkedIn authentication successful!")
-	return r.renderSuccess(c, r.config.LinkedIn.UserID)
+	return r.renderSuccess(c, identity.LinkedIn.UserID)
 }
 
-// handleHome displays the authentication page.
-func (r *Router) handleHome(c *fiber.Ctx) error {
-	// Only show auth page if we're on the root path
-	if c.Path() != "/" {
-		return c.Status(fiber.StatusNotFound).SendString("Not Found")
-	}
-
+// handleLogin starts signing in: it issues a state and redirects to LinkedIn's authorization page.
+func (r *Router) handleLogin(c *fiber.Ctx) error {
 	linkedinConfig := linkedin.NewConfig(
 		r.config.LinkedIn.ClientID,
 		r.config.LinkedIn.ClientSecret,
 		r.config.LinkedIn.RedirectURL,
 	)
+	linkedinConfig.Scopes = r.config.OAuthScopes()
 	client := linkedin.NewClient(linkedinConfig)
-	authURL := client.GetAuthURL("linkedin-auth-state")
 
-	html := fmt.Sprintf(`
+	state, err := r.authStates.Issue()
+	if err != nil {
+		return r.renderError(c, err.Error())
+	}
+
+	return c.Redirect(client.GetAuthURL(state), fiber.StatusFound)
+}
+
+// handleHome displays the authentication page. Its button goes through handleLogin, so a state is
+// only issued when someone actually signs in.
+func (r *Router) handleHome(c *fiber.Ctx) error {
+	// Only show auth page if we're on the root path
+	if c.Path() != "/" {
+		return c.Status(fiber.StatusNotFound).SendString("Not Found")
+	}
+
+	html := `
 <!DOCTYPE html>
 <html lang="en">
 <head>
@@ -306,7 +361,7 @@ func (r *Router) handleHome(c *fiber.Ctx) error {
         <h1>LinkedIn Post Scheduler</h1>
         <p>Authenticate with LinkedIn to enable automatic post publishing</p>
         
-        <a href="%s" class="button">🚀 Authenticate with LinkedIn</a>
+        <a href="/login" class="button">🚀 Authenticate with LinkedIn</a>
         
         <div class="info">
             <h3>📋 How it works:</h3>
@@ -323,13 +378,14 @@ func (r *Router) handleHome(c *fiber.Ctx) error {
             <div class="step"><strong>POST /api/posts</strong> - Create new post</div>
             <div class="step"><strong>GET /api/auth/status</strong> - Check auth status</div>
             <div class="step"><strong>GET /health</strong> - Health check</div>
+            <div class="step"><strong>GET /ready</strong> - Readiness check</div>
             <div class="step">And many more... see documentation for full API</div>
         </div>
         
         <p><small>This server handles OAuth callbacks securely and provides a full REST API for post management.</small></p>
     </div>
 </body>
-</html>`, authURL)
+</html>`
 
 	c.Set("Content-Type", "text/html")
 	return c.SendString(html)
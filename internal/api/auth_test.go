@@ -0,0 +1,105 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"PostedIn/internal/config"
+
+	"golang.org/x/oauth2"
+)
+
+func TestGetAuthStatus(t *testing.T) {
+	tests := []struct {
+		name              string
+		token             *oauth2.Token // Saved to the token file; nil leaves it missing
+		corrupt           bool          // Writes garbage to the token file instead
+		wantAuthenticated bool
+		wantExpired       bool
+		wantReason        string
+	}{
+		{"missing", nil, false, false, false, AuthReasonMissing},
+		{"corrupt", nil, true, false, false, AuthReasonInvalid},
+		{"valid", &oauth2.Token{AccessToken: "access", Expiry: time.Now().Add(time.Hour)}, false, true, false, ""},
+		{"expired", &oauth2.Token{AccessToken: "access", Expiry: time.Now().Add(-time.Hour)}, false, false, true, AuthReasonExpired},
+		{"refreshable", &oauth2.Token{AccessToken: "access", RefreshToken: "refresh", Expiry: time.Now().Add(-time.Hour)}, false, false, true, AuthReasonRefreshable},
+	}
+
+	for _, tt := range tests {
+		cfg := &config.Config{Timezone: config.TimezoneConfig{Location: "UTC"}}
+		cfg.Storage.TokenFile = filepath.Join(t.TempDir(), "token.json")
+		cfg.LinkedIn.UserID = "user-1"
+
+		switch {
+		case tt.corrupt:
+			if err := os.WriteFile(cfg.Storage.TokenFile, []byte("not a token"), 0o600); err != nil {
+				t.Fatal(err)
+			}
+		case tt.token != nil:
+			if err := config.SaveToken(tt.token, cfg.Storage.TokenFile); err != nil {
+				t.Fatal(err)
+			}
+		}
+
+		app, _ := newTestApp(t, cfg)
+
+		status, body := doRequest(t, app, http.MethodGet, "/api/auth/status", "")
+		if status != http.StatusOK {
+			t.Fatalf("%s: status = %d (body %s)", tt.name, status, body)
+		}
+
+		var response struct {
+			Data AuthStatusResponse `json:"data"`
+		}
+
+		if err := json.Unmarshal(body, &response); err != nil {
+			t.Fatalf("%s: response is not JSON: %v", tt.name, err)
+		}
+
+		got := response.Data
+		if got.Authenticated != tt.wantAuthenticated || got.Expired != tt.wantExpired || got.Reason != tt.wantReason {
+			t.Errorf("%s: authenticated %v, expired %v, reason %q, want %v, %v, %q",
+				tt.name, got.Authenticated, got.Expired, got.Reason, tt.wantAuthenticated, tt.wantExpired, tt.wantReason)
+		}
+
+		if tt.token != nil && got.ExpiresAt == "" {
+			t.Errorf("%s: expires_at is empty for a saved token", tt.name)
+		}
+	}
+}
+
+func TestHomeIssuesStateOnlyOnLogin(t *testing.T) {
+	cfg := &config.Config{Timezone: config.TimezoneConfig{Location: "UTC"}}
+	cfg.LinkedIn.ClientID = "client"
+	cfg.LinkedIn.RedirectURL = "http://localhost:8080/callback"
+
+	app, _ := newTestApp(t, cfg)
+
+	status, body := doRequest(t, app, http.MethodGet, "/", "")
+	if status != http.StatusOK || strings.Contains(string(body), "state=") || !strings.Contains(string(body), `href="/login"`) {
+		t.Fatalf("home: status = %d, want a page linking to /login without a state (body %s)", status, body)
+	}
+
+	resp, err := app.Test(httptest.NewRequest(http.MethodGet, "/login", nil), -1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer resp.Body.Close()
+
+	location, err := url.Parse(resp.Header.Get("Location"))
+	if err != nil || resp.StatusCode != http.StatusFound {
+		t.Fatalf("login: status = %d, location %q, want a redirect", resp.StatusCode, resp.Header.Get("Location"))
+	}
+
+	if location.Query().Get("state") == "" || location.Query().Get("client_id") != "client" {
+		t.Errorf("login redirected to %s, want LinkedIn's authorization page with a state", location)
+	}
+}
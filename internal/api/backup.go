@@ -0,0 +1,61 @@
+package api
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"time"
+
+	"PostedIn/internal/models"
+	"PostedIn/internal/scheduler"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// @Router /backup [get].
+func (r *Router) getBackup(c *fiber.Ctx) error {
+	// Render before sending so a failed backup returns an error instead of a truncated file
+	var buf bytes.Buffer
+	if err := r.scheduler.Backup(&buf, r.config); err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"success": false,
+			"error":   err.Error(),
+		})
+	}
+
+	c.Attachment(fmt.Sprintf("postedin-backup-%s.json", time.Now().UTC().Format("20060102-150405")))
+
+	return c.Send(buf.Bytes())
+}
+
+// @Router /restore [post].
+func (r *Router) restoreBackup(c *fiber.Ctx) error {
+	restored, err := r.scheduler.Restore(bytes.NewReader(c.Body()), r.config)
+	if errors.Is(err, scheduler.ErrInvalidBackup) {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"success": false,
+			"error":   err.Error(),
+		})
+	}
+
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"success": false,
+			"error":   err.Error(),
+		})
+	}
+
+	if r.cronScheduler != nil && r.cronScheduler.IsRunning() {
+		for i := range restored {
+			if restored[i].Status == models.StatusScheduled {
+				_ = r.cronScheduler.AddNewPost(&restored[i])
+			}
+		}
+	}
+
+	return c.JSON(fiber.Map{
+		"success":  true,
+		"restored": len(restored),
+		"data":     restored,
+	})
+}
@@ -0,0 +1,53 @@
+package api
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"PostedIn/internal/config"
+	"PostedIn/internal/models"
+)
+
+func TestBackupRestoreRoundTrip(t *testing.T) {
+	cfg := &config.Config{Timezone: config.TimezoneConfig{Location: "UTC"}}
+	source, sourceSched := newTestApp(t, cfg)
+
+	launch, err := sourceSched.Add(models.Post{Content: "launch", ScheduledAt: time.Now().Add(time.Hour)}, cfg)
+	if err != nil {
+		t.Fatalf("Add: %v", err)
+	}
+
+	if _, err := sourceSched.Add(models.Post{Content: "follow-up", DependsOn: launch.ID, OffsetMinutes: 30}, cfg); err != nil {
+		t.Fatalf("Add: %v", err)
+	}
+
+	code, backup := doRequest(t, source, http.MethodGet, "/api/backup", "")
+	if code != http.StatusOK {
+		t.Fatalf("backup status = %d: %s", code, backup)
+	}
+
+	target, targetSched := newTestApp(t, cfg)
+
+	if code, body := doRequest(t, target, http.MethodPost, "/api/restore", string(backup)); code != http.StatusOK {
+		t.Fatalf("restore status = %d: %s", code, body)
+	}
+
+	restored := targetSched.GetPosts()
+	if len(restored) != 2 || restored[1].DependsOn != launch.ID || restored[1].Status != models.StatusWaiting {
+		t.Errorf("restored posts = %+v, want the launch and its waiting follow-up", restored)
+	}
+
+	// A backup with an invalid post is rejected as a whole
+	invalid := strings.Replace(string(backup), `"follow-up"`, `""`, 1)
+	target, targetSched = newTestApp(t, cfg)
+
+	if code, body := doRequest(t, target, http.MethodPost, "/api/restore", invalid); code != http.StatusBadRequest {
+		t.Errorf("invalid restore status = %d: %s, want 400", code, body)
+	}
+
+	if posts := targetSched.GetPosts(); len(posts) != 0 {
+		t.Errorf("invalid restore stored %d posts, want none", len(posts))
+	}
+}
@@ -0,0 +1,67 @@
+package api
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"PostedIn/internal/scheduler"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// refreshStatsTimeout bounds the LinkedIn analytics fetch of a ?refresh=true request.
+const refreshStatsTimeout = 2 * time.Minute
+
+// @Router /best-of [get].
+func (r *Router) getBestOf(c *fiber.Ctx) error {
+	r.refreshStats(c)
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"metric":  r.config.BestOf.RankMetric(),
+		"data":    r.scheduler.SuggestBestOf(r.config),
+	})
+}
+
+// @Router /best-of [post].
+func (r *Router) scheduleBestOf(c *fiber.Ctx) error {
+	r.refreshStats(c)
+
+	reposts, err := r.scheduler.ScheduleBestOf(r.config)
+
+	if r.cronScheduler != nil && r.cronScheduler.IsRunning() {
+		for i := range reposts {
+			_ = r.cronScheduler.AddNewPost(&reposts[i])
+		}
+	}
+
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"success": false,
+			"error":   err.Error(),
+			"data":    reposts,
+		})
+	}
+
+	return c.JSON(fiber.Map{
+		"success":   true,
+		"scheduled": len(reposts),
+		"data":      reposts,
+	})
+}
+
+// refreshStats fetches post analytics from LinkedIn first when the request asks for ?refresh=true.
+// Failed fetches are logged and the posts keep their previous stats.
+func (r *Router) refreshStats(c *fiber.Ctx) {
+	if !c.QueryBool("refresh") {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), refreshStatsTimeout)
+	defer cancel()
+
+	if _, err := r.scheduler.RefreshStats(ctx, r.config, scheduler.LinkedInStats(r.config)); err != nil {
+		log.Printf("⚠️ Failed to refresh some post analytics: %v", err)
+	}
+}
@@ -0,0 +1,80 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"PostedIn/internal/config"
+	"PostedIn/internal/models"
+	"PostedIn/internal/scheduler"
+)
+
+func TestBestOfSuggestAndSchedule(t *testing.T) {
+	cfg := &config.Config{Timezone: config.TimezoneConfig{Location: "UTC"}}
+	cfg.BestOf.Slots = []string{time.Now().UTC().AddDate(0, 0, 3).Weekday().String() + " 11:00"}
+
+	app, sched := newTestApp(t, cfg)
+
+	publishedAt := time.Now().AddDate(0, 0, -45)
+	backup, err := json.Marshal(scheduler.Backup{
+		Version: scheduler.BackupVersion,
+		Posts: []models.Post{
+			{ID: 1, Content: "quiet", Status: models.StatusPosted, PublishedAt: &publishedAt, PostURN: "urn:li:share:1", Stats: &models.Stats{Reactions: 2}},
+			{ID: 2, Content: "popular", Status: models.StatusPosted, PublishedAt: &publishedAt, PostURN: "urn:li:share:2", Stats: &models.Stats{Reactions: 40, Comments: 8}},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := sched.Restore(bytes.NewReader(backup), cfg); err != nil {
+		t.Fatalf("Restore: %v", err)
+	}
+
+	status, body := doRequest(t, app, http.MethodGet, "/api/best-of", "")
+	if status != http.StatusOK {
+		t.Fatalf("GET status = %d, body %s", status, body)
+	}
+
+	var suggested struct {
+		Metric string                       `json:"metric"`
+		Data   []scheduler.BestOfSuggestion `json:"data"`
+	}
+	if err := json.Unmarshal(body, &suggested); err != nil {
+		t.Fatal(err)
+	}
+
+	if suggested.Metric != config.MetricEngagement || len(suggested.Data) != 1 || suggested.Data[0].Post.ID != 2 || suggested.Data[0].Score != 48 || suggested.Data[0].SlotAt == nil {
+		t.Fatalf("suggestions = %s", body)
+	}
+
+	status, body = doRequest(t, app, http.MethodPost, "/api/best-of", "")
+	if status != http.StatusOK {
+		t.Fatalf("POST status = %d, body %s", status, body)
+	}
+
+	var scheduled struct {
+		Scheduled int           `json:"scheduled"`
+		Data      []models.Post `json:"data"`
+	}
+	if err := json.Unmarshal(body, &scheduled); err != nil {
+		t.Fatal(err)
+	}
+
+	if scheduled.Scheduled != 1 || scheduled.Data[0].RepostOf != 2 || !scheduled.Data[0].ScheduledAt.Equal(*suggested.Data[0].SlotAt) {
+		t.Errorf("scheduled = %s", body)
+	}
+
+	// The pending repost keeps post 2 out of the suggestions
+	_, body = doRequest(t, app, http.MethodGet, "/api/best-of", "")
+	if err := json.Unmarshal(body, &suggested); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(suggested.Data) != 1 || suggested.Data[0].Post.ID != 1 {
+		t.Errorf("suggestions after scheduling = %s", body)
+	}
+}
@@ -0,0 +1,78 @@
+package api
+
+import (
+	"slices"
+
+	"PostedIn/internal/config"
+	"PostedIn/internal/models"
+	"PostedIn/pkg/linkedin"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// PostTypeImage is reported as a capability; image posts are text posts with an attached image.
+const PostTypeImage = "image"
+
+// Capabilities describes what the server can publish with the current config and OAuth scopes.
+type Capabilities struct {
+	PostTypes           []string         `json:"post_types"`
+	Visibilities        []string         `json:"visibilities"`
+	DefaultVisibility   string           `json:"default_visibility"`
+	OrganizationPosting bool             `json:"organization_posting"`
+	ContentFiles        bool             `json:"content_files"` // content_file is accepted (content.files_dir is set)
+	Scopes              []string         `json:"scopes"`
+	Audiences           []string         `json:"audiences"` // Names accepted in a post's audience field
+	Limits              CapabilityLimits `json:"limits"`
+}
+
+// CapabilityLimits holds the size limits clients should validate against.
+type CapabilityLimits struct {
+	MaxContentLength int   `json:"max_content_length"`
+	MaxImages        int   `json:"max_images"`
+	MaxImageBytes    int64 `json:"max_image_bytes"`
+	MaxVideoBytes    int64 `json:"max_video_bytes"`
+	MaxVideoSeconds  int   `json:"max_video_seconds"`
+	MinPollOptions   int   `json:"min_poll_options"`
+	MaxPollOptions   int   `json:"max_poll_options"`
+}
+
+// capabilitiesFor computes the capabilities from the config and the granted OAuth scopes.
+func capabilitiesFor(cfg *config.Config, scopes []string) Capabilities {
+	caps := Capabilities{
+		PostTypes:           []string{},
+		Visibilities:        linkedin.Visibilities,
+		DefaultVisibility:   linkedin.VisibilityPublic,
+		OrganizationPosting: slices.Contains(scopes, linkedin.ScopeOrganizationSocial),
+		ContentFiles:        cfg.Content.FilesDir != "",
+		Scopes:              scopes,
+		Audiences:           cfg.AudienceNames(),
+		Limits: CapabilityLimits{
+			MaxContentLength: linkedin.MaxPostLength,
+			MaxImages:        1,
+			MaxImageBytes:    linkedin.MaxImageSize,
+			MaxVideoBytes:    linkedin.MaxVideoSize,
+			MaxVideoSeconds:  int(linkedin.MaxVideoDuration.Seconds()),
+			MinPollOptions:   linkedin.MinPollOptions,
+			MaxPollOptions:   linkedin.MaxPollOptions,
+		},
+	}
+
+	if cfg.Visibility.Default != "" {
+		caps.DefaultVisibility = cfg.Visibility.Default
+	}
+
+	// Every post type is published as the member, which needs the member social scope
+	if slices.Contains(scopes, linkedin.ScopeMemberSocial) {
+		caps.PostTypes = append(caps.PostTypes, models.PostTypeText, PostTypeImage, models.PostTypePoll, models.PostTypeVideo)
+	}
+
+	return caps
+}
+
+// @Router /capabilities [get].
+func (r *Router) getCapabilities(c *fiber.Ctx) error {
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    capabilitiesFor(r.config, linkedin.DefaultScopes),
+	})
+}
@@ -0,0 +1,90 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"slices"
+	"testing"
+
+	"PostedIn/internal/config"
+	"PostedIn/internal/models"
+	"PostedIn/pkg/linkedin"
+)
+
+func TestCapabilitiesReflectScopes(t *testing.T) {
+	cfg := &config.Config{}
+
+	member := capabilitiesFor(cfg, []string{linkedin.ScopeMemberSocial})
+	for _, postType := range []string{models.PostTypeText, PostTypeImage, models.PostTypePoll, models.PostTypeVideo} {
+		if !slices.Contains(member.PostTypes, postType) {
+			t.Errorf("post types %v are missing %s", member.PostTypes, postType)
+		}
+	}
+
+	if member.OrganizationPosting {
+		t.Error("organization posting enabled without the organization scope")
+	}
+
+	readOnly := capabilitiesFor(cfg, []string{"openid", "profile"})
+	if len(readOnly.PostTypes) != 0 {
+		t.Errorf("post types without the member social scope = %v, want none", readOnly.PostTypes)
+	}
+
+	organization := capabilitiesFor(cfg, []string{linkedin.ScopeMemberSocial, linkedin.ScopeOrganizationSocial})
+	if !organization.OrganizationPosting {
+		t.Error("organization posting disabled despite the organization scope")
+	}
+}
+
+func TestCapabilitiesReflectConfig(t *testing.T) {
+	caps := capabilitiesFor(&config.Config{}, linkedin.DefaultScopes)
+
+	if caps.ContentFiles || len(caps.Audiences) != 0 || caps.DefaultVisibility != linkedin.VisibilityPublic {
+		t.Errorf("defaults = %+v, want no content files, no audiences and public visibility", caps)
+	}
+
+	cfg := &config.Config{
+		Content:    config.ContentConfig{FilesDir: "/srv/posts"},
+		Visibility: config.VisibilityConfig{Default: linkedin.VisibilityConnections},
+		Audiences:  map[string]linkedin.Audience{"engineers": {}, "designers": {}},
+	}
+
+	caps = capabilitiesFor(cfg, linkedin.DefaultScopes)
+
+	if !caps.ContentFiles {
+		t.Error("content files disabled despite content.files_dir")
+	}
+
+	if caps.DefaultVisibility != linkedin.VisibilityConnections {
+		t.Errorf("default visibility = %q, want the configured one", caps.DefaultVisibility)
+	}
+
+	if !slices.Equal(caps.Audiences, []string{"designers", "engineers"}) {
+		t.Errorf("audiences = %v, want the configured names sorted", caps.Audiences)
+	}
+
+	if caps.Limits.MaxContentLength != linkedin.MaxPostLength || caps.Limits.MaxPollOptions != linkedin.MaxPollOptions {
+		t.Errorf("limits = %+v, want LinkedIn's limits", caps.Limits)
+	}
+}
+
+func TestGetCapabilities(t *testing.T) {
+	app, _ := newTestApp(t, nil)
+
+	status, body := doRequest(t, app, http.MethodGet, "/api/capabilities", "")
+	if status != http.StatusOK {
+		t.Fatalf("status = %d (body %s)", status, body)
+	}
+
+	var response struct {
+		Data Capabilities `json:"data"`
+	}
+
+	if err := json.Unmarshal(body, &response); err != nil {
+		t.Fatalf("capabilities are not JSON: %v", err)
+	}
+
+	if !slices.Equal(response.Data.Scopes, linkedin.DefaultScopes) || len(response.Data.PostTypes) == 0 {
+		t.Errorf("capabilities = %+v, want the post types of the requested scopes", response.Data)
+	}
+}
@@ -0,0 +1,67 @@
+package api
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"unicode/utf8"
+)
+
+// maxContentFileSize bounds content files well above LinkedIn's post length to reject obvious mistakes early.
+const maxContentFileSize = 64 << 10
+
+// readContentFile reads post content from a file inside the configured content directory.
+// Paths are resolved relative to that directory, and anything that escapes it, directly or
+// through a symlink, is rejected.
+func (r *Router) readContentFile(name string) (string, error) {
+	dir := r.config.Content.FilesDir
+	if dir == "" {
+		return "", fmt.Errorf("content_file is disabled; set content.files_dir to allow reading content from files")
+	}
+
+	root, err := filepath.EvalSymlinks(dir)
+	if err != nil {
+		return "", fmt.Errorf("content directory is not accessible: %w", err)
+	}
+
+	root, err = filepath.Abs(root)
+	if err != nil {
+		return "", fmt.Errorf("content directory is not accessible: %w", err)
+	}
+
+	path := name
+	if !filepath.IsAbs(path) {
+		path = filepath.Join(root, path)
+	}
+
+	path, err = filepath.EvalSymlinks(path)
+	if err != nil {
+		return "", fmt.Errorf("content file not found")
+	}
+
+	rel, err := filepath.Rel(root, path)
+	if err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return "", fmt.Errorf("content file must be inside the content directory")
+	}
+
+	info, err := os.Stat(path)
+	if err != nil || !info.Mode().IsRegular() {
+		return "", fmt.Errorf("content file must be a regular file")
+	}
+
+	if info.Size() > maxContentFileSize {
+		return "", fmt.Errorf("content file is larger than %d bytes", maxContentFileSize)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return "", fmt.Errorf("failed to read content file: %w", err)
+	}
+
+	if !utf8.Valid(data) {
+		return "", fmt.Errorf("content file must be UTF-8 text")
+	}
+
+	return strings.TrimSpace(string(data)), nil
+}
@@ -0,0 +1,135 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"PostedIn/internal/config"
+	"PostedIn/internal/models"
+)
+
+// contentDir creates a content directory holding post.md and a secret file next to it.
+func contentDir(t *testing.T) (string, string) {
+	t.Helper()
+
+	base := t.TempDir()
+	dir := filepath.Join(base, "content")
+
+	if err := os.MkdirAll(filepath.Join(dir, "drafts"), 0o700); err != nil {
+		t.Fatal(err)
+	}
+
+	files := map[string]string{
+		filepath.Join(dir, "post.md"):           "  Long-form post from a file  \n",
+		filepath.Join(dir, "drafts", "next.md"): "Nested post",
+		filepath.Join(dir, "binary.bin"):        "\xff\xfe\xfd",
+		filepath.Join(dir, "huge.md"):           strings.Repeat("a", maxContentFileSize+1),
+		filepath.Join(base, "secret.txt"):       "do not read",
+	}
+
+	for path, data := range files {
+		if err := os.WriteFile(path, []byte(data), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	return dir, filepath.Join(base, "secret.txt")
+}
+
+func TestReadContentFile(t *testing.T) {
+	dir, secret := contentDir(t)
+
+	if err := os.Symlink(secret, filepath.Join(dir, "link.md")); err != nil {
+		t.Skipf("symlinks unavailable: %v", err)
+	}
+
+	router := NewRouter(&config.Config{Content: config.ContentConfig{FilesDir: dir}}, nil, nil)
+
+	allowed := map[string]string{
+		"post.md":                            "Long-form post from a file",
+		"drafts/next.md":                     "Nested post",
+		"drafts/../post.md":                  "Long-form post from a file",
+		filepath.Join(dir, "post.md"):        "Long-form post from a file",
+		filepath.Join(dir, "drafts/next.md"): "Nested post",
+	}
+
+	for name, want := range allowed {
+		got, err := router.readContentFile(name)
+		if err != nil {
+			t.Errorf("readContentFile(%q): %v", name, err)
+			continue
+		}
+
+		if got != want {
+			t.Errorf("readContentFile(%q) = %q, want %q", name, got, want)
+		}
+	}
+
+	rejected := []string{
+		"../secret.txt",
+		"drafts/../../secret.txt",
+		secret,
+		"link.md",
+		"missing.md",
+		"drafts",
+		"binary.bin",
+		"huge.md",
+	}
+
+	for _, name := range rejected {
+		if content, err := router.readContentFile(name); err == nil {
+			t.Errorf("readContentFile(%q) = %q, want it rejected", name, content)
+		}
+	}
+}
+
+func TestReadContentFileDisabled(t *testing.T) {
+	router := NewRouter(&config.Config{}, nil, nil)
+
+	if _, err := router.readContentFile("post.md"); err == nil || !strings.Contains(err.Error(), "content.files_dir") {
+		t.Errorf("error = %v, want content_file to be disabled without content.files_dir", err)
+	}
+}
+
+func TestCreatePostFromContentFile(t *testing.T) {
+	dir, _ := contentDir(t)
+	cfg := &config.Config{Timezone: config.TimezoneConfig{Location: "UTC"}, Content: config.ContentConfig{FilesDir: dir}}
+	app, sched := newTestApp(t, cfg)
+	when := time.Now().UTC().Add(24 * time.Hour).Format("2006-01-02 15:04")
+
+	status, body := doRequest(t, app, http.MethodPost, "/api/posts", fmt.Sprintf(`{"content_file": "post.md", "scheduled_at": %q}`, when))
+	if status != http.StatusOK && status != http.StatusCreated {
+		t.Fatalf("status = %d (body %s)", status, body)
+	}
+
+	var created struct {
+		Data models.Post `json:"data"`
+	}
+
+	if err := json.Unmarshal(body, &created); err != nil {
+		t.Fatalf("response is not JSON: %v", err)
+	}
+
+	if created.Data.Content != "Long-form post from a file" {
+		t.Errorf("content = %q, want the file content", created.Data.Content)
+	}
+
+	for _, req := range []string{
+		fmt.Sprintf(`{"content_file": "../secret.txt", "scheduled_at": %q}`, when),
+		fmt.Sprintf(`{"content": "inline", "content_file": "post.md", "scheduled_at": %q}`, when),
+	} {
+		if status, body := doRequest(t, app, http.MethodPost, "/api/posts", req); status != http.StatusBadRequest {
+			t.Errorf("%s: status = %d, want 400 (body %s)", req, status, body)
+		}
+	}
+
+	if posts := sched.GetPosts(); len(posts) != 1 {
+		t.Errorf("%d posts stored, want only the allowed one", len(posts))
+	}
+}
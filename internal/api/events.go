@@ -0,0 +1,112 @@
+package api
+
+import (
+	"PostedIn/internal/config"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// @Description An event posts can be scheduled relative to.
+type EventResponse struct {
+	Name string `json:"name"`
+	At   string `json:"at"`
+}
+
+// @Description Request payload for creating or moving an event.
+type EventUpdateRequest struct {
+	At string `json:"at"`
+}
+
+// setupEventRoutes configures the calendar event routes.
+func (r *Router) setupEventRoutes(api fiber.Router) {
+	events := api.Group("/events")
+
+	events.Get("/", r.getEvents)
+	events.Put("/:name", r.updateEvent)
+}
+
+// @Router /events [get].
+func (r *Router) getEvents(c *fiber.Ctx) error {
+	events := make([]EventResponse, 0, len(r.config.Events))
+	for _, name := range r.config.EventNames() {
+		events = append(events, EventResponse{Name: name, At: r.config.Events[name]})
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    events,
+	})
+}
+
+// @Router /events/{name} [put].
+func (r *Router) updateEvent(c *fiber.Ctx) error {
+	name := c.Params("name")
+
+	var req EventUpdateRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"success": false,
+			"error":   "Invalid JSON payload",
+		})
+	}
+
+	if req.At == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"success": false,
+			"error":   "at is required",
+		})
+	}
+
+	at, err := r.parseFutureTime(req.At)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"success": false,
+			"error":   err.Error(),
+		})
+	}
+
+	// Reject the move before saving it if a post tied to the event would land in the past
+	if err := r.scheduler.CheckEventMove(name, at, r.config); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"success": false,
+			"error":   err.Error(),
+		})
+	}
+
+	if err := r.config.SetEvent(name, at); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"success": false,
+			"error":   err.Error(),
+		})
+	}
+
+	if err := config.SaveConfig(r.config); err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"success": false,
+			"error":   err.Error(),
+		})
+	}
+
+	// Move every scheduled post tied to the event along with it
+	moved, err := r.scheduler.RescheduleEvent(name, r.config)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"success": false,
+			"error":   err.Error(),
+		})
+	}
+
+	if r.cronScheduler != nil && r.cronScheduler.IsRunning() {
+		for i := range moved {
+			_ = r.cronScheduler.AddNewPost(&moved[i])
+		}
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data": fiber.Map{
+			"event": EventResponse{Name: name, At: r.config.Events[name]},
+			"moved": moved,
+		},
+	})
+}
@@ -0,0 +1,85 @@
+package api
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"PostedIn/internal/config"
+	"PostedIn/internal/models"
+	"PostedIn/internal/scheduler"
+)
+
+func TestUpdateEventRejectsPastMoves(t *testing.T) {
+	useTempConfig(t)
+
+	cfg := &config.Config{Timezone: config.TimezoneConfig{Location: "UTC"}}
+	if err := cfg.SetEvent("webinar", time.Now().Add(48*time.Hour)); err != nil {
+		t.Fatal(err)
+	}
+
+	app, sched := newTestApp(t, cfg)
+	original := cfg.Events["webinar"]
+
+	post, err := sched.Add(models.Post{Content: "reminder", Event: "webinar", EventOffsetMinutes: -24 * 60}, cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name string
+		at   string
+	}{
+		{name: "event in the past", at: time.Now().Add(-time.Hour).UTC().Format("2006-01-02 15:04")},
+		{name: "post would be in the past", at: time.Now().Add(12 * time.Hour).UTC().Format("2006-01-02 15:04")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			status, body := doRequest(t, app, http.MethodPut, "/api/events/webinar", `{"at": "`+tt.at+`"}`)
+			if status != http.StatusBadRequest {
+				t.Fatalf("status = %d, want 400 (body %s)", status, body)
+			}
+
+			if cfg.Events["webinar"] != original {
+				t.Errorf("event moved to %q by a rejected request", cfg.Events["webinar"])
+			}
+
+			if got := findTestPost(t, sched, post.ID); !got.ScheduledAt.Equal(post.ScheduledAt) {
+				t.Errorf("post moved to %s by a rejected request", got.ScheduledAt)
+			}
+		})
+	}
+
+	later := time.Now().Add(72 * time.Hour).UTC().Format("2006-01-02 15:04")
+	if status, body := doRequest(t, app, http.MethodPut, "/api/events/webinar", `{"at": "`+later+`"}`); status != http.StatusOK {
+		t.Fatalf("moving the event later: status = %d (body %s)", status, body)
+	}
+
+	if got := findTestPost(t, sched, post.ID); got.ScheduledAt.Equal(post.ScheduledAt) {
+		t.Error("post did not move with the event")
+	}
+}
+
+// useTempConfig points SaveConfig at a temporary file for the test.
+func useTempConfig(t *testing.T) {
+	t.Helper()
+
+	config.SetConfigPath(t.TempDir() + "/config.json")
+	t.Cleanup(func() { config.SetConfigPath("") })
+}
+
+// findTestPost returns the post with the ID or fails the test.
+func findTestPost(t *testing.T, sched *scheduler.Scheduler, id int) models.Post {
+	t.Helper()
+
+	for _, post := range sched.GetPosts() {
+		if post.ID == id {
+			return post
+		}
+	}
+
+	t.Fatalf("post %d not found", id)
+
+	return models.Post{}
+}
@@ -0,0 +1,52 @@
+package api
+
+import (
+	"bytes"
+	"fmt"
+
+	"PostedIn/internal/scheduler"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// @Router /posts/export [get].
+func (r *Router) exportPosts(c *fiber.Ctx) error {
+	format := c.Query("format", scheduler.ExportJSONFormat)
+
+	if format != scheduler.ExportCSVFormat && format != scheduler.ExportJSONFormat {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"success": false,
+			"error":   fmt.Sprintf("format must be %s or %s", scheduler.ExportCSVFormat, scheduler.ExportJSONFormat),
+		})
+	}
+
+	loc, err := r.config.GetTimezone()
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"success": false,
+			"error":   err.Error(),
+		})
+	}
+
+	rng, err := scheduler.ParseExportRange(c.Query("from"), c.Query("to"), c.Query("by"), loc)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"success": false,
+			"error":   err.Error(),
+		})
+	}
+
+	// Render before sending so a failed export returns an error instead of a truncated file
+	var buf bytes.Buffer
+	if _, err := r.scheduler.Export(&buf, format, rng); err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"success": false,
+			"error":   err.Error(),
+		})
+	}
+
+	// Sets the content type from the extension too
+	c.Attachment("posts." + format)
+
+	return c.Send(buf.Bytes())
+}
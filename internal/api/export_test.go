@@ -0,0 +1,95 @@
+package api
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"PostedIn/internal/config"
+	"PostedIn/internal/models"
+	"PostedIn/internal/scheduler"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// newTestApp serves the API over a scheduler saving to a temporary posts file, without a cron scheduler.
+func newTestApp(t *testing.T, cfg *config.Config) (*fiber.App, *scheduler.Scheduler) {
+	t.Helper()
+
+	if cfg == nil {
+		cfg = &config.Config{Timezone: config.TimezoneConfig{Location: "UTC"}}
+	}
+
+	sched := scheduler.NewScheduler(filepath.Join(t.TempDir(), "posts.json"))
+	app := fiber.New()
+	NewRouter(cfg, sched, nil).SetupRoutes(app)
+
+	return app, sched
+}
+
+// doRequest sends a request to the app and returns the status code and body.
+func doRequest(t *testing.T, app *fiber.App, method, target, body string) (int, []byte) {
+	t.Helper()
+
+	req := httptest.NewRequest(method, target, strings.NewReader(body))
+	if body != "" {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := app.Test(req, -1)
+	if err != nil {
+		t.Fatalf("%s %s: %v", method, target, err)
+	}
+
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return resp.StatusCode, data
+}
+
+func TestExportPostsDateRange(t *testing.T) {
+	cfg := &config.Config{Timezone: config.TimezoneConfig{Location: "America/New_York"}}
+	if _, err := cfg.GetTimezone(); err != nil {
+		t.Skipf("timezone data unavailable: %v", err)
+	}
+
+	app, sched := newTestApp(t, cfg)
+	sched.Posts = []models.Post{
+		{ID: 1, Content: "May 31st in New York", ScheduledAt: time.Date(2025, 6, 1, 3, 0, 0, 0, time.UTC)},
+		{ID: 2, Content: "June 1st", ScheduledAt: time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)},
+		{ID: 3, Content: "June 30th in New York", ScheduledAt: time.Date(2025, 7, 1, 3, 0, 0, 0, time.UTC)},
+	}
+
+	status, body := doRequest(t, app, http.MethodGet, "/api/posts/export?from=2025-06-01&to=2025-06-30", "")
+	if status != http.StatusOK {
+		t.Fatalf("status = %d, body %s", status, body)
+	}
+
+	var posts []models.Post
+	if err := json.Unmarshal(body, &posts); err != nil {
+		t.Fatalf("export is not JSON: %v", err)
+	}
+
+	if len(posts) != 2 || posts[0].ID != 2 || posts[1].ID != 3 {
+		t.Errorf("exported %+v, want posts 2 and 3", posts)
+	}
+}
+
+func TestExportPostsRejectsInvalidRange(t *testing.T) {
+	app, _ := newTestApp(t, nil)
+
+	for _, query := range []string{"from=2025-07-01&to=2025-06-30", "from=yesterday", "from=2025-06-01&by=created"} {
+		if status, body := doRequest(t, app, http.MethodGet, "/api/posts/export?"+query, ""); status != http.StatusBadRequest {
+			t.Errorf("%s: status = %d, want 400 (body %s)", query, status, body)
+		}
+	}
+}
@@ -0,0 +1,86 @@
+package api
+
+import (
+	"errors"
+	"time"
+
+	"PostedIn/internal/importer"
+	"PostedIn/internal/models"
+	"PostedIn/internal/scheduler"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// @Router /posts/import [post].
+func (r *Router) importPosts(c *fiber.Ctx) error {
+	header, err := c.FormFile("file")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"success": false,
+			"error":   "A CSV file is required in the file field",
+		})
+	}
+
+	format := c.FormValue("format", "generic")
+
+	mapper, err := importer.MapperFor(format)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"success": false,
+			"error":   err.Error(),
+		})
+	}
+
+	// Times in the file are read in its own timezone, defaulting to the configured one
+	loc, err := r.config.GetTimezone()
+	if zone := c.FormValue("timezone"); zone != "" {
+		loc, err = time.LoadLocation(zone)
+	}
+
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"success": false,
+			"error":   "Invalid timezone: " + err.Error(),
+		})
+	}
+
+	file, err := header.Open()
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"success": false,
+			"error":   err.Error(),
+		})
+	}
+
+	defer func() {
+		_ = file.Close()
+	}()
+
+	result, err := r.scheduler.ImportCSV(file, mapper, loc, r.config)
+	if errors.Is(err, scheduler.ErrInvalidImport) {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"success": false,
+			"error":   err.Error(),
+		})
+	}
+
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"success": false,
+			"error":   err.Error(),
+		})
+	}
+
+	if r.cronScheduler != nil && r.cronScheduler.IsRunning() {
+		for i := range result.Imported {
+			if result.Imported[i].Status == models.StatusScheduled {
+				_ = r.cronScheduler.AddNewPost(&result.Imported[i])
+			}
+		}
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    result,
+	})
+}
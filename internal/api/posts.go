@@ -1,11 +1,17 @@
 package api
 
 import (
+	"errors"
 	"fmt"
+	"log"
 	"sort"
+	"strconv"
 	"time"
 
 	"PostedIn/internal/models"
+	"PostedIn/internal/scheduler"
+	"PostedIn/internal/timezone"
+	"PostedIn/pkg/linkedin"
 
 	"github.com/gofiber/fiber/v2"
 )
@@ -13,12 +19,59 @@ import (
 const (
 	// DateTimeMinLength represents the minimum length for 'YYYY-MM-DD HH:MM' format.
 	DateTimeMinLength = 16
+	// MaxPostsPageSize caps a page of GET /posts, and is the page size when no limit is given.
+	MaxPostsPageSize = 1000
 )
 
 // PostRequest represents the request payload for creating/updating posts.
 type PostRequest struct {
-	Content     string `json:"content"`
-	ScheduledAt string `json:"scheduled_at"`
+	Content     string       `json:"content"`
+	ContentFile string       `json:"content_file,omitempty"` // Read content from a file under content.files_dir instead
+	ScheduledAt string       `json:"scheduled_at"`
+	Poll        *PollRequest `json:"poll,omitempty"`
+	// Language is the language of Content; Variants holds translations keyed by language code.
+	Language       string            `json:"language,omitempty"`
+	Variants       map[string]string `json:"variants,omitempty"`
+	TargetLanguage string            `json:"target_language,omitempty"` // Variant to publish, or "all" to fan out
+	// DependsOn schedules the post OffsetMinutes after the referenced post actually publishes.
+	DependsOn     int    `json:"depends_on,omitempty"`
+	OffsetMinutes int    `json:"offset_minutes,omitempty"`
+	APIVersion    string `json:"api_version,omitempty"` // LinkedIn-Version override, e.g. "202506"
+	ImagePath     string `json:"image_path,omitempty"`  // Local image file on the server, uploaded at publish time
+	ImageAltText  string `json:"image_alt_text,omitempty"`
+	VideoPath     string `json:"video_path,omitempty"` // Local MP4 file on the server, uploaded at publish time
+	VideoTitle    string `json:"video_title,omitempty"`
+	Audience      string `json:"audience,omitempty"` // Name of an audience configured under audiences
+	// Event schedules the post EventOffsetMinutes from a named event; negative offsets are before it.
+	Event              string   `json:"event,omitempty"`
+	EventOffsetMinutes int      `json:"event_offset_minutes,omitempty"`
+	Tags               []string `json:"tags,omitempty"`
+	Recurrence         string   `json:"recurrence,omitempty"` // "daily", "weekly", "monthly" or a cron expression
+	Draft              bool     `json:"draft,omitempty"`      // Store as a draft; scheduled_at is then an optional tentative time
+	Account            string   `json:"account,omitempty"`    // Name of an account configured under accounts; empty publishes as the default
+	// AuthorType is "person" (default) or "organization" to publish as the company page OrganizationURN,
+	// which defaults to linkedin.organization_urn.
+	AuthorType      string `json:"author_type,omitempty"`
+	OrganizationURN string `json:"organization_urn,omitempty"`
+	Visibility      string `json:"visibility,omitempty"` // PUBLIC, CONNECTIONS or LOGGED_IN; empty uses the visibility rules
+	// Recipe fills content from the template of a recipe configured under recipes, with Vars, and
+	// defaults the schedule, tags, image and audience the request leaves empty to the recipe's.
+	Recipe string            `json:"recipe,omitempty"`
+	Vars   map[string]string `json:"vars,omitempty"`
+}
+
+// ScheduleDraftRequest represents the request payload for scheduling a draft.
+type ScheduleDraftRequest struct {
+	ScheduledAt string `json:"scheduled_at,omitempty"` // Empty uses the draft's tentative time
+}
+
+// PollRequest represents the poll section of a post request.
+type PollRequest struct {
+	Question string   `json:"question"`
+	Options  []string `json:"options"`
+	Duration string   `json:"duration,omitempty"`
+	// ResultsTemplate schedules a follow-up announcing the results when the poll closes.
+	ResultsTemplate string `json:"results_template,omitempty"`
 }
 
 // PostResponse represents the response format for posts.
@@ -45,33 +98,51 @@ func (a byScheduledAt) Less(i, j int) bool { return a[i].ScheduledAt.Before(a[j]
 
 // validateAndParsePostRequest validates the post request and returns the parsed scheduled time.
 func (r *Router) validateAndParsePostRequest(req PostRequest) (time.Time, error) {
+	// Posts depending on another post get their time once that post publishes
+	if req.Content != "" && req.ScheduledAt == "" && req.DependsOn > 0 {
+		return time.Time{}, nil
+	}
+
+	// Drafts need no time until they are scheduled
+	if req.Content != "" && req.ScheduledAt == "" && req.Draft {
+		return time.Time{}, nil
+	}
+
+	// Posts tied to an event get their time from the event when normalized
+	if req.Content != "" && req.ScheduledAt == "" && req.Event != "" {
+		return time.Time{}, nil
+	}
+
 	// Validate required fields
 	if req.Content == "" || req.ScheduledAt == "" {
 		return time.Time{}, fmt.Errorf("content and scheduled_at are required")
 	}
 
-	// Validate date format
-	if len(req.ScheduledAt) < DateTimeMinLength {
-		return time.Time{}, fmt.Errorf("scheduled_at must be in 'YYYY-MM-DD HH:MM' format")
-	}
+	return r.parseFutureTime(req.ScheduledAt)
+}
 
-	// Parse the scheduled time
-	dateStr := req.ScheduledAt[:10]
-	timeStr := req.ScheduledAt[11:]
-	scheduledAt, err := r.config.ParseTimeInTimezone(dateStr, timeStr)
+// parseFutureTime parses a scheduled time and rejects times in the past or inside the minimum lead time.
+func (r *Router) parseFutureTime(value string) (time.Time, error) {
+	now, err := r.config.Now()
 	if err != nil {
-		return time.Time{}, fmt.Errorf("invalid date/time format. Use 'YYYY-MM-DD HH:MM'")
+		now = time.Now()
 	}
 
-	// Check if scheduled time is in the future
-	now, err := r.config.Now()
+	// Parse the scheduled time, either strict or a phrase like "tomorrow 9am"
+	scheduledAt, err := r.parseScheduledAt(value, now)
 	if err != nil {
-		now = time.Now()
+		return time.Time{}, err
 	}
+
+	// Check if scheduled time is in the future
 	if scheduledAt.Before(now) {
 		return time.Time{}, fmt.Errorf("cannot schedule posts in the past")
 	}
 
+	if err := scheduler.CheckLeadTime(scheduledAt, r.config); err != nil {
+		return time.Time{}, err
+	}
+
 	return scheduledAt, nil
 }
 
@@ -83,26 +154,139 @@ func (r *Router) setupPostRoutes(api fiber.Router) {
 	posts.Post("/", r.createPost)
 	posts.Delete("/", r.deleteMultiplePosts)
 	posts.Get("/due", r.getDuePosts)
+	posts.Get("/board", r.getPostsBoard)
+	posts.Get("/export", r.exportPosts)
 	posts.Post("/publish-due", r.publishDuePosts)
+	posts.Post("/transaction", r.postsTransaction)
+	posts.Post("/validate", r.validatePost)
+	posts.Post("/tag", r.tagPosts)
+	posts.Post("/import", r.importPosts)
 	posts.Get("/:id", r.getPost)
 	posts.Put("/:id", r.updatePost)
 	posts.Delete("/:id", r.deletePost)
+	posts.Get("/:id/history", r.getPostHistory)
 	posts.Post("/:id/publish", r.publishPost)
+	posts.Post("/:id/retry", r.retryPost)
+	posts.Post("/:id/mark-posted", r.markPosted)
+	posts.Post("/:id/schedule", r.scheduleDraft)
 }
 
 // @Router /posts [get].
 func (r *Router) getPosts(c *fiber.Ctx) error {
+	filter, err := r.parsePostFilter(TagFilter{
+		Status: c.Query("status"),
+		From:   c.Query("from"),
+		To:     c.Query("to"),
+		Search: c.Query("q"),
+	}, "")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"success": false,
+			"error":   err.Error(),
+		})
+	}
+
+	limit, err := queryCount(c, "limit", MaxPostsPageSize)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"success": false,
+			"error":   err.Error(),
+		})
+	}
+
+	offset, err := queryCount(c, "offset", 0)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"success": false,
+			"error":   err.Error(),
+		})
+	}
+
+	posts := r.scheduler.FilterPosts(filter)
+
+	if len(posts) > 1 {
+		sort.Sort(byScheduledAt(posts))
+	}
+
+	limit = min(limit, MaxPostsPageSize)
+	start := min(offset, len(posts))
+	end := min(start+limit, len(posts))
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    posts[start:end],
+		"total":   len(posts),
+		"limit":   limit,
+		"offset":  offset,
+	})
+}
+
+// queryCount reads a non-negative integer query parameter, returning fallback when it is absent.
+func queryCount(c *fiber.Ctx, name string, fallback int) (int, error) {
+	value := c.Query(name)
+	if value == "" {
+		return fallback, nil
+	}
+
+	n, err := strconv.Atoi(value)
+	if err != nil || n < 0 {
+		return 0, fmt.Errorf("%s must be a non-negative integer", name)
+	}
+
+	return n, nil
+}
+
+// boardStatuses lists the board columns in display order.
+var boardStatuses = []string{
+	models.StatusDraft,
+	models.StatusScheduled,
+	models.StatusWaiting,
+	models.StatusNeedsReview,
+	models.StatusPosted,
+	models.StatusFailed,
+}
+
+// filterByStatus returns the posts with the given status.
+func filterByStatus(posts []models.Post, status string) []models.Post {
+	filtered := []models.Post{}
+
+	for _, post := range posts {
+		if post.Status == status {
+			filtered = append(filtered, post)
+		}
+	}
+
+	return filtered
+}
+
+// @Router /posts/board [get].
+func (r *Router) getPostsBoard(c *fiber.Ctx) error {
 	posts := r.scheduler.GetPosts()
-	postsCopy := make([]models.Post, len(posts))
-	copy(postsCopy, posts)
+	groups := make(map[string][]models.Post, len(boardStatuses))
+	counts := make(map[string]int, len(boardStatuses))
+
+	for _, status := range boardStatuses {
+		group := filterByStatus(posts, status)
+
+		// Upcoming work soonest first, finished work most recent first
+		switch status {
+		case models.StatusPosted, models.StatusFailed:
+			sort.Sort(sort.Reverse(byScheduledAt(group)))
+		default:
+			sort.Sort(byScheduledAt(group))
+		}
 
-	if len(postsCopy) > 1 {
-		sort.Sort(byScheduledAt(postsCopy))
+		groups[status] = group
+		counts[status] = len(group)
 	}
 
 	return c.JSON(fiber.Map{
 		"success": true,
-		"data":    postsCopy,
+		"data": fiber.Map{
+			"groups": groups,
+			"counts": counts,
+			"total":  len(posts),
+		},
 	})
 }
 
@@ -116,17 +300,15 @@ func (r *Router) createPost(c *fiber.Ctx) error {
 		})
 	}
 
-	// Validate and parse the request
-	scheduledAt, err := r.validateAndParsePostRequest(req)
-	if err != nil {
+	created, err := r.CreatePost(req)
+	var requestErr *RequestError
+	if errors.As(err, &requestErr) || errors.Is(err, scheduler.ErrInvalidDependency) {
 		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
 			"success": false,
 			"error":   err.Error(),
 		})
 	}
 
-	// Create the post
-	err = r.scheduler.AddPost(req.Content, scheduledAt, r.config)
 	if err != nil {
 		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
 			"success": false,
@@ -134,27 +316,200 @@ func (r *Router) createPost(c *fiber.Ctx) error {
 		})
 	}
 
-	// Get the most recently added post (highest ID)
-	posts := r.scheduler.GetPosts()
-	var newestPost *models.Post
-	for i := range posts {
-		if newestPost == nil || posts[i].ID > newestPost.ID {
-			newestPost = &posts[i]
+	response := fiber.Map{
+		"success": true,
+		"data":    created,
+	}
+
+	// Show how a phrase such as "tomorrow 9am" was resolved
+	if !created.ScheduledAt.IsZero() {
+		response["resolved_at"] = created.ScheduledAt.Format("2006-01-02 15:04 MST")
+	}
+
+	var warnings []string
+
+	if warning := scheduler.TokenExpiryWarning(created.ScheduledAt, created.Account, r.config); warning != "" {
+		warnings = append(warnings, warning)
+	}
+
+	if warning := r.scheduler.DuplicateWarning(created.Content, r.config); warning != "" {
+		warnings = append(warnings, warning)
+	}
+
+	warnings = append(warnings, linkedin.ScanContent(created.Content).Warnings...)
+
+	if len(warnings) > 0 {
+		response["warnings"] = warnings
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(response)
+}
+
+// RequestError reports a create request that failed validation.
+type RequestError struct {
+	Err error
+}
+
+func (e *RequestError) Error() string { return e.Err.Error() }
+
+func (e *RequestError) Unwrap() error { return e.Err }
+
+// CreatePost validates the request, adds the post and arms its timer when the auto-scheduler runs.
+// Validation failures are returned as a *RequestError.
+func (r *Router) CreatePost(req PostRequest) (models.Post, error) {
+	post, err := r.preparePost(req)
+	if err != nil {
+		return models.Post{}, &RequestError{Err: err}
+	}
+
+	if req.Draft {
+		draft, err := r.scheduler.AddDraft(post, r.config)
+		if err != nil {
+			return models.Post{}, &RequestError{Err: err}
 		}
+
+		return draft, nil
+	}
+
+	created, err := r.scheduler.Add(post, r.config)
+	if err != nil {
+		return models.Post{}, err
 	}
 
 	// Add to cron scheduler if running
-	if r.cronScheduler != nil && r.cronScheduler.IsRunning() && newestPost != nil {
-		if err := r.cronScheduler.AddNewPost(newestPost); err != nil {
+	if r.cronScheduler != nil && r.cronScheduler.IsRunning() {
+		if err := r.cronScheduler.AddNewPost(&created); err != nil {
 			// Log error but don't fail the request - post creation succeeds even if scheduling fails
 			_ = err
 		}
 	}
 
-	return c.Status(fiber.StatusCreated).JSON(fiber.Map{
-		"success": true,
-		"data":    newestPost,
-	})
+	return created, nil
+}
+
+// preparePost reads the content file if requested, validates the request and returns the normalized post.
+func (r *Router) preparePost(req PostRequest) (models.Post, error) {
+	if req.ContentFile != "" {
+		if req.Content != "" {
+			return models.Post{}, fmt.Errorf("content and content_file are mutually exclusive")
+		}
+
+		content, err := r.readContentFile(req.ContentFile)
+		if err != nil {
+			return models.Post{}, err
+		}
+
+		req.Content = content
+	}
+
+	if req.Recipe != "" {
+		var err error
+
+		if req, err = r.applyRecipe(req); err != nil {
+			return models.Post{}, err
+		}
+	}
+
+	scheduledAt, err := r.validateAndParsePostRequest(req)
+	if err != nil {
+		return models.Post{}, err
+	}
+
+	post := buildPost(req, scheduledAt)
+	if err := scheduler.NormalizePost(&post, r.config); err != nil {
+		return models.Post{}, err
+	}
+
+	return post, nil
+}
+
+// applyRecipe fills the request's content from its recipe and defaults the fields it leaves empty to
+// the recipe's. Date functions in the template render at the requested time, or now when it has none.
+func (r *Router) applyRecipe(req PostRequest) (PostRequest, error) {
+	if req.Content != "" {
+		return req, fmt.Errorf("content, content_file and recipe are mutually exclusive")
+	}
+
+	recipe, err := r.config.Recipe(req.Recipe)
+	if err != nil {
+		return req, err
+	}
+
+	if req.ScheduledAt == "" && !req.Draft && req.DependsOn == 0 && req.Event == "" {
+		req.ScheduledAt = recipe.Schedule
+	}
+
+	now, err := r.config.Now()
+	if err != nil {
+		now = time.Now()
+	}
+
+	// An invalid time is reported by validateAndParsePostRequest
+	at := now
+	if req.ScheduledAt != "" {
+		if scheduledAt, err := r.parseScheduledAt(req.ScheduledAt, now); err == nil {
+			at = scheduledAt
+		}
+	}
+
+	if req.Content, err = scheduler.RenderRecipe(r.config, req.Recipe, req.Vars, at); err != nil {
+		return req, err
+	}
+
+	if len(req.Tags) == 0 {
+		req.Tags = recipe.Tags
+	}
+
+	if req.ImagePath == "" {
+		req.ImagePath = recipe.ImagePath
+		req.ImageAltText = recipe.ImageAltText
+	}
+
+	if req.Audience == "" {
+		req.Audience = recipe.Audience
+	}
+
+	return req, nil
+}
+
+// buildPost maps a create request onto a post model.
+func buildPost(req PostRequest, scheduledAt time.Time) models.Post {
+	post := models.Post{
+		Content:            req.Content,
+		ScheduledAt:        scheduledAt,
+		PostType:           models.PostTypeText,
+		Language:           req.Language,
+		Variants:           req.Variants,
+		TargetLanguage:     req.TargetLanguage,
+		DependsOn:          req.DependsOn,
+		OffsetMinutes:      req.OffsetMinutes,
+		APIVersion:         req.APIVersion,
+		ImagePath:          req.ImagePath,
+		ImageAltText:       req.ImageAltText,
+		VideoPath:          req.VideoPath,
+		VideoTitle:         req.VideoTitle,
+		Audience:           req.Audience,
+		Event:              req.Event,
+		EventOffsetMinutes: req.EventOffsetMinutes,
+		Tags:               req.Tags,
+		Recurrence:         req.Recurrence,
+		Account:            req.Account,
+		AuthorType:         req.AuthorType,
+		OrganizationURN:    req.OrganizationURN,
+		Visibility:         req.Visibility,
+	}
+
+	if req.Poll != nil {
+		post.PostType = models.PostTypePoll
+		post.Poll = &models.Poll{
+			Question:        req.Poll.Question,
+			Options:         req.Poll.Options,
+			Duration:        req.Poll.Duration,
+			ResultsTemplate: req.Poll.ResultsTemplate,
+		}
+	}
+
+	return post
 }
 
 // @Router /posts/{id} [get].
@@ -183,6 +538,37 @@ func (r *Router) getPost(c *fiber.Ctx) error {
 	})
 }
 
+// @Router /posts/{id}/history [get].
+func (r *Router) getPostHistory(c *fiber.Ctx) error {
+	id, err := c.ParamsInt("id")
+	if err != nil || id <= 0 {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"success": false,
+			"error":   "Invalid post ID",
+		})
+	}
+
+	for _, post := range r.scheduler.GetPosts() {
+		if post.ID == id {
+			history := post.History
+			if history == nil {
+				history = []models.StatusChange{}
+			}
+
+			return c.JSON(fiber.Map{
+				"success": true,
+				"data":    history,
+				"count":   len(history),
+			})
+		}
+	}
+
+	return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+		"success": false,
+		"error":   "Post not found",
+	})
+}
+
 // @Router /posts/{id} [put].
 func (r *Router) updatePost(c *fiber.Ctx) error {
 	id, err := c.ParamsInt("id")
@@ -201,61 +587,79 @@ func (r *Router) updatePost(c *fiber.Ctx) error {
 		})
 	}
 
-	posts := r.scheduler.GetPosts()
-	var targetPost *models.Post
-	for i := range posts {
-		if posts[i].ID == id {
-			targetPost = &posts[i]
-			break
+	// Update fields if provided
+	var scheduledAt time.Time
+
+	if req.ScheduledAt != "" {
+		scheduledAt, err = r.parseFutureTime(req.ScheduledAt)
+		if err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"success": false,
+				"error":   err.Error(),
+			})
 		}
 	}
 
-	if targetPost == nil {
+	updated, err := r.scheduler.UpdatePost(id, req.Content, scheduledAt, r.config)
+	if errors.Is(err, scheduler.ErrPostNotFound) {
 		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
 			"success": false,
 			"error":   "Post not found",
 		})
 	}
 
-	// Update fields if provided
-	if req.Content != "" {
-		targetPost.Content = req.Content
+	if errors.Is(err, scheduler.ErrInvalidPost) {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"success": false,
+			"error":   err.Error(),
+		})
 	}
 
-	if req.ScheduledAt != "" {
-		if len(req.ScheduledAt) < DateTimeMinLength {
-			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
-				"success": false,
-				"error":   "scheduled_at must be in 'YYYY-MM-DD HH:MM' format",
-			})
-		}
-
-		dateStr := req.ScheduledAt[:10]
-		timeStr := req.ScheduledAt[11:]
-		scheduledAt, err := r.config.ParseTimeInTimezone(dateStr, timeStr)
-		if err != nil {
-			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
-				"success": false,
-				"error":   "Invalid date/time format. Use 'YYYY-MM-DD HH:MM'",
-			})
-		}
-		targetPost.ScheduledAt = scheduledAt
+	if errors.Is(err, scheduler.ErrNotEditable) || errors.Is(err, scheduler.ErrPublishInProgress) {
+		return c.Status(fiber.StatusConflict).JSON(fiber.Map{
+			"success": false,
+			"error":   err.Error(),
+		})
 	}
 
-	// Save the updated posts
-	if err := r.scheduler.SavePosts(); err != nil {
+	if err != nil {
 		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
 			"success": false,
 			"error":   err.Error(),
 		})
 	}
 
+	// Drop the timer armed for the old time, then re-arm it for the new one if the post is still scheduled
+	if !scheduledAt.IsZero() && r.cronScheduler != nil {
+		r.cronScheduler.RemovePostTimers([]int{id})
+
+		if err := r.cronScheduler.AddNewPost(&updated); err != nil {
+			log.Printf("⚠️ Failed to reschedule post %d: %v", id, err)
+		}
+	}
+
 	return c.JSON(fiber.Map{
 		"success": true,
-		"data":    targetPost,
+		"data":    updated,
 	})
 }
 
+// parseScheduledAt parses a 'YYYY-MM-DD HH:MM' time or a quick phrase such as "in 2 hours"
+// or "tomorrow 9am" relative to now, in the configured timezone.
+func (r *Router) parseScheduledAt(value string, now time.Time) (time.Time, error) {
+	loc, err := r.config.GetTimezone()
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	scheduledAt, err := timezone.ParseSchedule(value, now.In(loc))
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid scheduled_at: %w. Use 'YYYY-MM-DD HH:MM' or a phrase like 'in 2 hours' or 'tomorrow 9am'", err)
+	}
+
+	return scheduledAt, nil
+}
+
 // @Router /posts/{id} [delete].
 func (r *Router) deletePost(c *fiber.Ctx) error {
 	id, err := c.ParamsInt("id")
@@ -274,6 +678,10 @@ func (r *Router) deletePost(c *fiber.Ctx) error {
 		})
 	}
 
+	if r.cronScheduler != nil {
+		r.cronScheduler.RemovePostTimers([]int{id})
+	}
+
 	return c.JSON(fiber.Map{
 		"success":    true,
 		"deleted_id": id,
@@ -298,7 +706,19 @@ func (r *Router) deleteMultiplePosts(c *fiber.Ctx) error {
 		})
 	}
 
+	// Posts that were found are deleted even when others were not, so stop their timers either way
 	err := r.scheduler.DeleteMultiplePosts(req.IDs)
+	if errors.Is(err, scheduler.ErrWaitingDependents) {
+		return c.Status(fiber.StatusConflict).JSON(fiber.Map{
+			"success": false,
+			"error":   err.Error(),
+		})
+	}
+
+	if r.cronScheduler != nil {
+		r.cronScheduler.RemovePostTimers(req.IDs)
+	}
+
 	if err != nil {
 		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
 			"success": false,
@@ -334,6 +754,20 @@ func (r *Router) publishPost(c *fiber.Ctx) error {
 	}
 
 	err = r.scheduler.PublishToLinkedIn(c.Context(), id, r.config)
+	if errors.Is(err, scheduler.ErrPublishingPaused) {
+		return c.Status(fiber.StatusConflict).JSON(fiber.Map{
+			"success": false,
+			"error":   err.Error(),
+		})
+	}
+
+	if errors.Is(err, scheduler.ErrPublishDeferred) {
+		return c.Status(fiber.StatusServiceUnavailable).JSON(fiber.Map{
+			"success": false,
+			"error":   err.Error(),
+		})
+	}
+
 	if err != nil {
 		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
 			"success": false,
@@ -348,15 +782,192 @@ func (r *Router) publishPost(c *fiber.Ctx) error {
 	})
 }
 
+// @Router /posts/{id}/retry [post].
+func (r *Router) retryPost(c *fiber.Ctx) error {
+	id, err := c.ParamsInt("id")
+	if err != nil || id <= 0 {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"success": false,
+			"error":   "Invalid post ID",
+		})
+	}
+
+	err = r.scheduler.RetryPost(c.Context(), id, r.config)
+
+	switch {
+	case errors.Is(err, scheduler.ErrPostNotFound):
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+			"success": false,
+			"error":   err.Error(),
+		})
+	case errors.Is(err, scheduler.ErrNotFailed), errors.Is(err, scheduler.ErrPublishingPaused):
+		return c.Status(fiber.StatusConflict).JSON(fiber.Map{
+			"success": false,
+			"error":   err.Error(),
+		})
+	case errors.Is(err, scheduler.ErrPublishDeferred):
+		return c.Status(fiber.StatusServiceUnavailable).JSON(fiber.Map{
+			"success": false,
+			"error":   err.Error(),
+		})
+	case err != nil:
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"success": false,
+			"error":   err.Error(),
+		})
+	}
+
+	return c.JSON(fiber.Map{
+		"success":      true,
+		"published_id": id,
+		"message":      "Post retried and published successfully",
+	})
+}
+
+// @Router /posts/{id}/mark-posted [post].
+func (r *Router) markPosted(c *fiber.Ctx) error {
+	id, err := c.ParamsInt("id")
+	if err != nil || id <= 0 {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"success": false,
+			"error":   "Invalid post ID",
+		})
+	}
+
+	posted, err := r.scheduler.MarkAsPosted(id)
+
+	switch {
+	case errors.Is(err, scheduler.ErrPostNotFound):
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+			"success": false,
+			"error":   err.Error(),
+		})
+	case errors.Is(err, scheduler.ErrCannotMarkPosted):
+		return c.Status(fiber.StatusConflict).JSON(fiber.Map{
+			"success": false,
+			"error":   err.Error(),
+		})
+	case err != nil:
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"success": false,
+			"error":   err.Error(),
+		})
+	}
+
+	// The post was published by hand, so its timer must not publish it again
+	if r.cronScheduler != nil {
+		r.cronScheduler.RemovePostTimers([]int{id})
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    posted,
+	})
+}
+
+// @Router /posts/{id}/schedule [post].
+func (r *Router) scheduleDraft(c *fiber.Ctx) error {
+	id, err := c.ParamsInt("id")
+	if err != nil || id <= 0 {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"success": false,
+			"error":   "Invalid post ID",
+		})
+	}
+
+	var req ScheduleDraftRequest
+	if len(c.Body()) > 0 {
+		if err := c.BodyParser(&req); err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"success": false,
+				"error":   "Invalid JSON payload",
+			})
+		}
+	}
+
+	var scheduledAt time.Time
+
+	if req.ScheduledAt != "" {
+		scheduledAt, err = r.parseFutureTime(req.ScheduledAt)
+		if err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"success": false,
+				"error":   err.Error(),
+			})
+		}
+	}
+
+	scheduled, err := r.scheduler.ScheduleDraft(id, scheduledAt, r.config)
+
+	switch {
+	case errors.Is(err, scheduler.ErrPostNotFound):
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+			"success": false,
+			"error":   err.Error(),
+		})
+	case errors.Is(err, scheduler.ErrNotDraft):
+		return c.Status(fiber.StatusConflict).JSON(fiber.Map{
+			"success": false,
+			"error":   err.Error(),
+		})
+	case errors.Is(err, scheduler.ErrNoScheduledTime), errors.Is(err, scheduler.ErrTooSoon):
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"success": false,
+			"error":   err.Error(),
+		})
+	case err != nil:
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"success": false,
+			"error":   err.Error(),
+		})
+	}
+
+	if r.cronScheduler != nil && r.cronScheduler.IsRunning() {
+		_ = r.cronScheduler.AddNewPost(&scheduled)
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    scheduled,
+	})
+}
+
 // @Router /posts/publish-due [post].
 func (r *Router) publishDuePosts(c *fiber.Ctx) error {
-	duePosts := r.scheduler.GetDuePosts(r.config)
+	if r.scheduler.IsPaused() {
+		return c.Status(fiber.StatusConflict).JSON(fiber.Map{
+			"success": false,
+			"error":   scheduler.ErrPublishingPaused.Error(),
+		})
+	}
+
+	duePosts, remaining := scheduler.LimitBatch(r.scheduler.GetDuePosts(r.config), r.config)
 	var published []int
 	var failed []int
+	deferred := []int{}
 
-	for _, post := range duePosts {
-		err := r.scheduler.PublishToLinkedIn(c.Context(), post.ID, r.config)
-		if err != nil {
+	for _, post := range remaining {
+		deferred = append(deferred, post.ID)
+	}
+
+	var lastErr error
+
+	for i, post := range duePosts {
+		// Space the publishes out, and stop when LinkedIn throttles for longer than the batch waits
+		if i > 0 {
+			if err := scheduler.WaitBetweenPublishes(c.Context(), lastErr, r.config); err != nil {
+				log.Printf("⏳ Batch publish stopped: %v", err)
+
+				for _, rest := range duePosts[i:] {
+					deferred = append(deferred, rest.ID)
+				}
+
+				break
+			}
+		}
+
+		lastErr = r.scheduler.PublishToLinkedIn(c.Context(), post.ID, r.config)
+		if lastErr != nil {
 			failed = append(failed, post.ID)
 		} else {
 			published = append(published, post.ID)
@@ -367,6 +978,7 @@ func (r *Router) publishDuePosts(c *fiber.Ctx) error {
 		"success":   true,
 		"published": published,
 		"failed":    failed,
+		"deferred":  deferred,
 		"message":   "Auto-publish completed",
 	})
 }
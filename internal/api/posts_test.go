@@ -0,0 +1,537 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"PostedIn/internal/config"
+	"PostedIn/internal/cron"
+	"PostedIn/internal/models"
+	"PostedIn/internal/scheduler"
+	"PostedIn/pkg/linkedin"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+func TestDeleteMultiplePostsWithWaitingDependent(t *testing.T) {
+	app, sched := newTestApp(t, nil)
+	cfg := &config.Config{Timezone: config.TimezoneConfig{Location: "UTC"}}
+
+	first, err := sched.Add(models.Post{Content: "first", ScheduledAt: time.Now().Add(time.Hour)}, cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := sched.Add(models.Post{Content: "follow-up", DependsOn: first.ID}, cfg); err != nil {
+		t.Fatal(err)
+	}
+
+	status, body := doRequest(t, app, http.MethodDelete, "/api/posts", `{"ids": [1]}`)
+	if status != http.StatusConflict {
+		t.Fatalf("status = %d, want 409 (body %s)", status, body)
+	}
+
+	if posts := sched.GetPosts(); len(posts) != 2 {
+		t.Errorf("%d post(s) left, want both kept", len(posts))
+	}
+}
+
+func TestGetPostsBoard(t *testing.T) {
+	app, sched := newTestApp(t, nil)
+	base := time.Date(2025, 6, 1, 9, 0, 0, 0, time.UTC)
+
+	sched.Posts = []models.Post{
+		{ID: 1, Status: models.StatusScheduled, ScheduledAt: base.Add(48 * time.Hour)},
+		{ID: 2, Status: models.StatusPosted, ScheduledAt: base},
+		{ID: 3, Status: models.StatusScheduled, ScheduledAt: base.Add(24 * time.Hour)},
+		{ID: 4, Status: models.StatusFailed, ScheduledAt: base},
+		{ID: 5, Status: models.StatusPosted, ScheduledAt: base.Add(time.Hour)},
+		{ID: 6, Status: models.StatusDraft, ScheduledAt: base},
+	}
+
+	status, body := doRequest(t, app, http.MethodGet, "/api/posts/board", "")
+	if status != http.StatusOK {
+		t.Fatalf("status = %d (body %s)", status, body)
+	}
+
+	var board struct {
+		Data struct {
+			Groups map[string][]models.Post `json:"groups"`
+			Counts map[string]int           `json:"counts"`
+			Total  int                      `json:"total"`
+		} `json:"data"`
+	}
+
+	if err := json.Unmarshal(body, &board); err != nil {
+		t.Fatalf("board is not JSON: %v", err)
+	}
+
+	want := map[string][]int{
+		models.StatusDraft:       {6},
+		models.StatusScheduled:   {3, 1}, // Soonest first
+		models.StatusWaiting:     {},
+		models.StatusNeedsReview: {},
+		models.StatusPosted:      {5, 2}, // Most recent first
+		models.StatusFailed:      {4},
+	}
+
+	for status, ids := range want {
+		group, ok := board.Data.Groups[status]
+		if !ok {
+			t.Errorf("board has no %s group", status)
+			continue
+		}
+
+		if board.Data.Counts[status] != len(ids) || len(group) != len(ids) {
+			t.Errorf("%s: count %d with %d posts, want %d", status, board.Data.Counts[status], len(group), len(ids))
+			continue
+		}
+
+		for i, id := range ids {
+			if group[i].ID != id {
+				t.Errorf("%s[%d] = post %d, want %d", status, i, group[i].ID, id)
+			}
+		}
+	}
+
+	if board.Data.Total != len(sched.Posts) {
+		t.Errorf("total = %d, want %d", board.Data.Total, len(sched.Posts))
+	}
+}
+
+func TestCreatePostEnforcesLeadTime(t *testing.T) {
+	cfg := &config.Config{Timezone: config.TimezoneConfig{Location: "UTC"}, Cron: config.CronConfig{MinLeadMinutes: 5}}
+	app, sched := newTestApp(t, cfg)
+
+	soon := time.Now().UTC().Add(2 * time.Minute).Format("2006-01-02 15:04")
+
+	status, body := doRequest(t, app, http.MethodPost, "/api/posts", `{"content": "too soon", "scheduled_at": "`+soon+`"}`)
+	if status != http.StatusBadRequest {
+		t.Fatalf("inside the lead time: status = %d, want 400 (body %s)", status, body)
+	}
+
+	if !strings.Contains(string(body), "earliest allowed") {
+		t.Errorf("body = %s, want the earliest allowed time", body)
+	}
+
+	later := time.Now().UTC().Add(10 * time.Minute).Format("2006-01-02 15:04")
+
+	if status, body := doRequest(t, app, http.MethodPost, "/api/posts", `{"content": "in time", "scheduled_at": "`+later+`"}`); status >= http.StatusBadRequest {
+		t.Fatalf("after the lead time: status = %d (body %s)", status, body)
+	}
+
+	if posts := sched.GetPosts(); len(posts) != 1 || posts[0].Content != "in time" {
+		t.Errorf("stored %+v, want only the post after the lead time", posts)
+	}
+}
+
+func TestCreatePostResolvesPhrase(t *testing.T) {
+	app, sched := newTestApp(t, nil)
+
+	before := time.Now()
+
+	status, body := doRequest(t, app, http.MethodPost, "/api/posts", `{"content": "phrase", "scheduled_at": "in 2 hours"}`)
+	if status != http.StatusCreated {
+		t.Fatalf("status = %d, want 201 (body %s)", status, body)
+	}
+
+	var response struct {
+		Data       models.Post `json:"data"`
+		ResolvedAt string      `json:"resolved_at"`
+	}
+
+	if err := json.Unmarshal(body, &response); err != nil {
+		t.Fatalf("response is not JSON: %v", err)
+	}
+
+	if got := response.Data.ScheduledAt.Sub(before); got < 2*time.Hour-time.Minute || got > 2*time.Hour+time.Minute {
+		t.Errorf("scheduled %v after the request, want 2 hours", got)
+	}
+
+	if want := response.Data.ScheduledAt.Format("2006-01-02 15:04 MST"); response.ResolvedAt != want {
+		t.Errorf("resolved_at = %q, want %q", response.ResolvedAt, want)
+	}
+
+	if status, body := doRequest(t, app, http.MethodPost, "/api/posts", `{"content": "strict", "scheduled_at": "2099-01-02 03:04"}`); status != http.StatusCreated {
+		t.Fatalf("strict format: status = %d (body %s)", status, body)
+	}
+
+	if status, body := doRequest(t, app, http.MethodPost, "/api/posts", `{"content": "nonsense", "scheduled_at": "whenever"}`); status != http.StatusBadRequest {
+		t.Fatalf("unknown phrase: status = %d, want 400 (body %s)", status, body)
+	}
+
+	if posts := sched.GetPosts(); len(posts) != 2 {
+		t.Errorf("stored %d posts, want the phrase and the strict one", len(posts))
+	}
+}
+
+func TestGetPostHistory(t *testing.T) {
+	app, sched := newTestApp(t, nil)
+	cfg := &config.Config{Timezone: config.TimezoneConfig{Location: "UTC"}}
+
+	post, err := sched.Add(models.Post{Content: "audited", ScheduledAt: time.Now().Add(time.Hour)}, cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := sched.MarkAsPosted(post.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	status, body := doRequest(t, app, http.MethodGet, "/api/posts/1/history", "")
+	if status != http.StatusOK {
+		t.Fatalf("status = %d (body %s)", status, body)
+	}
+
+	var history struct {
+		Data  []models.StatusChange `json:"data"`
+		Count int                   `json:"count"`
+	}
+
+	if err := json.Unmarshal(body, &history); err != nil {
+		t.Fatalf("history is not JSON: %v", err)
+	}
+
+	if history.Count != 2 || len(history.Data) != 2 {
+		t.Fatalf("history = %+v, want 2 entries", history)
+	}
+
+	if history.Data[0].Note != "created" || history.Data[1].Status != models.StatusPosted {
+		t.Errorf("history = %+v, want created then posted", history.Data)
+	}
+
+	if status, _ := doRequest(t, app, http.MethodGet, "/api/posts/99/history", ""); status != http.StatusNotFound {
+		t.Errorf("unknown post: status = %d, want 404", status)
+	}
+
+	if status, _ := doRequest(t, app, http.MethodGet, "/api/posts/abc/history", ""); status != http.StatusBadRequest {
+		t.Errorf("invalid ID: status = %d, want 400", status)
+	}
+}
+
+func TestPublishDuePostsHonorsCap(t *testing.T) {
+	cfg := &config.Config{
+		Timezone: config.TimezoneConfig{Location: "UTC"},
+		Cron:     config.CronConfig{MaxPublishPerRun: 2},
+		DryRun:   true,
+	}
+	app, sched := newTestApp(t, cfg)
+	sched.LoadSwitches(cfg)
+
+	now := time.Now().UTC()
+	posts := make([]models.Post, 0, 5)
+
+	for id := 1; id <= 5; id++ {
+		posts = append(posts, models.Post{
+			ID:          id,
+			Content:     fmt.Sprintf("due post %d", id),
+			Status:      models.StatusScheduled,
+			ScheduledAt: now.Add(-time.Duration(id) * time.Minute),
+		})
+	}
+
+	backup, err := json.Marshal(scheduler.Backup{Version: scheduler.BackupVersion, Posts: posts})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := sched.Restore(bytes.NewReader(backup), cfg); err != nil {
+		t.Fatalf("Restore: %v", err)
+	}
+
+	status, body := doRequest(t, app, http.MethodPost, "/api/posts/publish-due", "")
+	if status != http.StatusOK {
+		t.Fatalf("status = %d (body %s)", status, body)
+	}
+
+	var result struct {
+		Published []int `json:"published"`
+		Failed    []int `json:"failed"`
+		Deferred  []int `json:"deferred"`
+	}
+
+	if err := json.Unmarshal(body, &result); err != nil {
+		t.Fatalf("response is not JSON: %v", err)
+	}
+
+	// The most overdue posts go first
+	if len(result.Published) != 2 || result.Published[0] != 5 || result.Published[1] != 4 {
+		t.Errorf("published %v, want posts 5 and 4", result.Published)
+	}
+
+	if len(result.Deferred) != 3 || len(result.Failed) != 0 {
+		t.Errorf("deferred %v and failed %v, want the other 3 deferred", result.Deferred, result.Failed)
+	}
+
+	for _, id := range result.Deferred {
+		if post := findTestPost(t, sched, id); post.Status != models.StatusScheduled {
+			t.Errorf("deferred post %d status = %q, want it still scheduled", id, post.Status)
+		}
+	}
+}
+
+func TestPostContentLengthLimit(t *testing.T) {
+	app, sched := newTestApp(t, nil)
+
+	later := time.Now().UTC().Add(time.Hour).Format("2006-01-02 15:04")
+	atLimit := strings.Repeat("é", linkedin.MaxPostLength)
+	overLimit := atLimit + "!"
+
+	status, body := doRequest(t, app, http.MethodPost, "/api/posts", `{"content": "`+overLimit+`", "scheduled_at": "`+later+`"}`)
+	if status != http.StatusBadRequest {
+		t.Fatalf("over the limit: status = %d, want 400 (body %s)", status, body)
+	}
+
+	if !strings.Contains(string(body), "too long") {
+		t.Errorf("body = %s, want the content reported too long", body)
+	}
+
+	if status, body := doRequest(t, app, http.MethodPost, "/api/posts", `{"content": "`+atLimit+`", "scheduled_at": "`+later+`"}`); status != http.StatusCreated {
+		t.Fatalf("at the limit: status = %d, want 201 (body %s)", status, body)
+	}
+
+	status, body = doRequest(t, app, http.MethodPut, "/api/posts/1", `{"content": "`+overLimit+`"}`)
+	if status != http.StatusBadRequest {
+		t.Fatalf("update over the limit: status = %d, want 400 (body %s)", status, body)
+	}
+
+	if posts := sched.GetPosts(); len(posts) != 1 || posts[0].Content != atLimit {
+		t.Errorf("stored %d post(s), want only the one at the limit, unchanged", len(posts))
+	}
+}
+
+func TestGetPostsPagination(t *testing.T) {
+	app, sched := newTestApp(t, nil)
+	base := time.Date(2025, 6, 1, 9, 0, 0, 0, time.UTC)
+
+	for id := 1; id <= 5; id++ {
+		sched.Posts = append(sched.Posts, models.Post{ID: id, Status: models.StatusScheduled, ScheduledAt: base.Add(time.Duration(id) * time.Hour)})
+	}
+
+	tests := []struct {
+		query      string
+		wantIDs    string
+		wantLimit  int
+		wantOffset int
+	}{
+		{"", "[1 2 3 4 5]", MaxPostsPageSize, 0},
+		{"?limit=2", "[1 2]", 2, 0},
+		{"?limit=2&offset=2", "[3 4]", 2, 2},
+		{"?limit=2&offset=4", "[5]", 2, 4},
+		{"?offset=5", "[]", MaxPostsPageSize, 5},
+		{"?offset=99", "[]", MaxPostsPageSize, 99},
+		{"?limit=0", "[]", 0, 0},
+		{"?limit=5000", "[1 2 3 4 5]", MaxPostsPageSize, 0},
+	}
+
+	for _, tt := range tests {
+		status, body := doRequest(t, app, http.MethodGet, "/api/posts"+tt.query, "")
+		if status != http.StatusOK {
+			t.Fatalf("%q: status = %d (body %s)", tt.query, status, body)
+		}
+
+		var page struct {
+			Data   []models.Post `json:"data"`
+			Total  int           `json:"total"`
+			Limit  int           `json:"limit"`
+			Offset int           `json:"offset"`
+		}
+
+		if err := json.Unmarshal(body, &page); err != nil {
+			t.Fatalf("%q: page is not JSON: %v", tt.query, err)
+		}
+
+		ids := make([]int, 0, len(page.Data))
+		for _, post := range page.Data {
+			ids = append(ids, post.ID)
+		}
+
+		if got := fmt.Sprint(ids); got != tt.wantIDs {
+			t.Errorf("%q: ids = %s, want %s", tt.query, got, tt.wantIDs)
+		}
+
+		if page.Total != 5 || page.Limit != tt.wantLimit || page.Offset != tt.wantOffset {
+			t.Errorf("%q: total %d, limit %d, offset %d, want 5, %d, %d", tt.query, page.Total, page.Limit, page.Offset, tt.wantLimit, tt.wantOffset)
+		}
+	}
+
+	for _, query := range []string{"?limit=-1", "?offset=abc"} {
+		if status, body := doRequest(t, app, http.MethodGet, "/api/posts"+query, ""); status != http.StatusBadRequest {
+			t.Errorf("%q: status = %d, want 400 (body %s)", query, status, body)
+		}
+	}
+}
+
+func TestUpdatePostReschedulesTimer(t *testing.T) {
+	cfg := &config.Config{Timezone: config.TimezoneConfig{Location: "UTC"}, DryRun: true}
+
+	sched := scheduler.NewScheduler(filepath.Join(t.TempDir(), "posts.json"))
+	sched.LoadSwitches(cfg)
+
+	base := time.Now().UTC().Truncate(time.Minute)
+
+	// Due in a moment, so the timer armed for its old time would publish it (dry run)
+	soon := time.Now().Add(time.Second)
+
+	moved, err := sched.Add(models.Post{Content: "moved", ScheduledAt: soon}, cfg)
+	if err != nil {
+		t.Fatalf("Add: %v", err)
+	}
+
+	if _, err := sched.Add(models.Post{Content: "fixed", ScheduledAt: base.Add(2 * time.Hour)}, cfg); err != nil {
+		t.Fatalf("Add: %v", err)
+	}
+
+	cs := cron.NewScheduler(sched, cfg)
+	if err := cs.Start(); err != nil {
+		t.Fatalf("Start: %v", err)
+	}
+
+	t.Cleanup(cs.Stop)
+
+	app := fiber.New()
+	NewRouter(cfg, sched, cs).SetupRoutes(app)
+
+	if next := cs.GetNextRun(); !next.Equal(soon) {
+		t.Fatalf("next run = %v before the update, want %v", next, soon)
+	}
+
+	tests := []struct {
+		name     string
+		offset   time.Duration
+		wantNext time.Duration
+	}{
+		{"later", 3 * time.Hour, 2 * time.Hour},         // The other post comes first now
+		{"earlier", 30 * time.Minute, 30 * time.Minute}, // The moved post comes first again
+	}
+
+	for _, tt := range tests {
+		at := base.Add(tt.offset).Format("2006-01-02 15:04")
+
+		status, body := doRequest(t, app, http.MethodPut, fmt.Sprintf("/api/posts/%d", moved.ID), `{"scheduled_at": "`+at+`"}`)
+		if status != http.StatusOK {
+			t.Fatalf("%s: status = %d (body %s)", tt.name, status, body)
+		}
+
+		if next := cs.GetNextRun(); !next.Equal(base.Add(tt.wantNext)) {
+			t.Errorf("%s: next run = %v, want %v", tt.name, next, base.Add(tt.wantNext))
+		}
+	}
+
+	if id, next := cs.NextPost(); id != moved.ID || !next.Equal(base.Add(30*time.Minute)) {
+		t.Errorf("next timer = post %d at %v, want post %d re-armed for its new time", id, next, moved.ID)
+	}
+
+	time.Sleep(2 * time.Second)
+
+	for _, post := range sched.GetPosts() {
+		if post.ID == moved.ID && post.Status != models.StatusScheduled {
+			t.Errorf("status = %q after the old time passed, want the old timer removed", post.Status)
+		}
+	}
+}
+
+func TestUpdatePostRejectsPastTime(t *testing.T) {
+	cfg := &config.Config{Timezone: config.TimezoneConfig{Location: "UTC"}, Cron: config.CronConfig{MinLeadMinutes: 5}}
+	app, sched := newTestApp(t, cfg)
+
+	scheduledAt := time.Now().UTC().Add(time.Hour).Truncate(time.Minute)
+
+	post, err := sched.Add(models.Post{Content: "kept", ScheduledAt: scheduledAt}, cfg)
+	if err != nil {
+		t.Fatalf("Add: %v", err)
+	}
+
+	target := fmt.Sprintf("/api/posts/%d", post.ID)
+	past := time.Now().UTC().Add(-time.Hour).Format("2006-01-02 15:04")
+	soon := time.Now().UTC().Add(2 * time.Minute).Format("2006-01-02 15:04")
+
+	status, body := doRequest(t, app, http.MethodPut, target, `{"scheduled_at": "`+past+`"}`)
+	if status != http.StatusBadRequest {
+		t.Fatalf("past time: status = %d, want 400 (body %s)", status, body)
+	}
+
+	if !strings.Contains(string(body), "in the past") {
+		t.Errorf("body = %s, want the past time reported", body)
+	}
+
+	if status, body := doRequest(t, app, http.MethodPut, target, `{"scheduled_at": "`+soon+`"}`); status != http.StatusBadRequest {
+		t.Fatalf("inside the lead time: status = %d, want 400 (body %s)", status, body)
+	}
+
+	transaction := fmt.Sprintf(`{"operations": [{"op": "update", "id": %d, "post": {"scheduled_at": "%s"}}]}`, post.ID, past)
+	if status, body := doRequest(t, app, http.MethodPost, "/api/posts/transaction", transaction); status != http.StatusBadRequest {
+		t.Fatalf("past time in a transaction: status = %d, want 400 (body %s)", status, body)
+	}
+
+	if got := sched.GetPosts()[0].ScheduledAt; !got.Equal(scheduledAt) {
+		t.Errorf("scheduled at = %v, want %v kept", got, scheduledAt)
+	}
+
+	later := time.Now().UTC().Add(3 * time.Hour).Format("2006-01-02 15:04")
+	if status, body := doRequest(t, app, http.MethodPut, target, `{"scheduled_at": "`+later+`"}`); status != http.StatusOK {
+		t.Fatalf("future time: status = %d, want 200 (body %s)", status, body)
+	}
+}
+
+func TestUpdatePostValidatesEdits(t *testing.T) {
+	cfg := &config.Config{Timezone: config.TimezoneConfig{Location: "UTC"}, DryRun: true}
+	app, sched := newTestApp(t, cfg)
+	sched.LoadSwitches(cfg)
+
+	scheduled, err := sched.Add(models.Post{Content: "draft copy", ScheduledAt: time.Now().Add(time.Hour)}, cfg)
+	if err != nil {
+		t.Fatalf("Add: %v", err)
+	}
+
+	target := fmt.Sprintf("/api/posts/%d", scheduled.ID)
+	if status, body := doRequest(t, app, http.MethodPut, target, `{"content": "  \t "}`); status != http.StatusBadRequest {
+		t.Errorf("blank content: status = %d, want 400 (body %s)", status, body)
+	}
+
+	posted, err := sched.Add(models.Post{Content: "shipped", ScheduledAt: time.Now().Add(time.Hour)}, cfg)
+	if err != nil {
+		t.Fatalf("Add: %v", err)
+	}
+
+	if err := sched.PublishToLinkedIn(context.Background(), posted.ID, cfg); err != nil {
+		t.Fatalf("PublishToLinkedIn: %v", err)
+	}
+
+	target = fmt.Sprintf("/api/posts/%d", posted.ID)
+	if status, body := doRequest(t, app, http.MethodPut, target, `{"content": "rewritten"}`); status != http.StatusConflict {
+		t.Errorf("posted post: status = %d, want 409 (body %s)", status, body)
+	}
+
+	for _, post := range sched.GetPosts() {
+		if post.Content != "draft copy" && post.Content != "shipped" {
+			t.Errorf("post %d content = %q, want it unchanged", post.ID, post.Content)
+		}
+	}
+}
+
+func TestCreatePostVisibility(t *testing.T) {
+	app, sched := newTestApp(t, nil)
+
+	later := time.Now().UTC().Add(time.Hour).Format("2006-01-02 15:04")
+
+	status, body := doRequest(t, app, http.MethodPost, "/api/posts", `{"content": "internal update", "scheduled_at": "`+later+`", "visibility": "connections"}`)
+	if status != http.StatusCreated {
+		t.Fatalf("status = %d, want 201 (body %s)", status, body)
+	}
+
+	if status, body := doRequest(t, app, http.MethodPost, "/api/posts", `{"content": "secret", "scheduled_at": "`+later+`", "visibility": "friends"}`); status != http.StatusBadRequest {
+		t.Fatalf("unknown visibility: status = %d, want 400 (body %s)", status, body)
+	}
+
+	if posts := sched.GetPosts(); len(posts) != 1 || posts[0].Visibility != linkedin.VisibilityConnections {
+		t.Errorf("stored %+v, want one post limited to connections", posts)
+	}
+}
@@ -1,8 +1,14 @@
 package api
 
 import (
+	"errors"
+	"fmt"
+	"time"
+
+	"PostedIn/internal/auth"
 	"PostedIn/internal/config"
 	"PostedIn/internal/cron"
+	"PostedIn/internal/models"
 	"PostedIn/internal/scheduler"
 
 	"github.com/gofiber/fiber/v2"
@@ -15,17 +21,32 @@ type Router struct {
 	config        *config.Config
 	scheduler     *scheduler.Scheduler
 	cronScheduler *cron.Scheduler
+	registry      *cron.Registry
+	stopServer    func() error // Takes the HTTP server offline; nil when unsupported
+	authStates    *auth.StateStore
 }
 
 // NewRouter creates a new API router with dependencies.
 func NewRouter(cfg *config.Config, sched *scheduler.Scheduler, cronSched *cron.Scheduler) *Router {
+	registry := cron.NewRegistry()
+	if cronSched != nil {
+		registry.Register(cron.DefaultStore, cronSched)
+	}
+
 	return &Router{
 		config:        cfg,
 		scheduler:     sched,
 		cronScheduler: cronSched,
+		registry:      registry,
+		authStates:    auth.NewStateStore(auth.StateTTL),
 	}
 }
 
+// Registry returns the registry of schedulers whose next runs are aggregated by the API.
+func (r *Router) Registry() *cron.Registry {
+	return r.registry
+}
+
 // SetupRoutes configures all API routes.
 func (r *Router) SetupRoutes(app *fiber.App) {
 	// Add middleware
@@ -54,25 +75,119 @@ func (r *Router) SetupRoutes(app *fiber.App) {
 	// Scheduler routes
 	r.setupSchedulerRoutes(api)
 
+	// Calendar event routes
+	r.setupEventRoutes(api)
+
+	// HTTP server lifecycle
+	r.setupServerRoutes(api)
+
+	// Capabilities
+	api.Get("/capabilities", r.getCapabilities)
+
+	// Best-of reposts of top performers
+	api.Get("/best-of", r.getBestOf)
+	api.Post("/best-of", r.scheduleBestOf)
+
+	// Backup and restore of all posts
+	api.Get("/backup", r.getBackup)
+	api.Post("/restore", r.restoreBackup)
+
 	// OAuth callback routes (outside /api group for LinkedIn compatibility)
 	app.Get("/callback", r.handleCallback)
+	app.Get("/login", r.handleLogin)
 	app.Get("/", r.handleHome)
 
-	// Health check
+	// Post management UI
+	r.setupWebUI(app)
+
+	// Liveness and readiness probes
 	app.Get("/health", r.healthCheck)
+	app.Get("/ready", r.readinessCheck)
 }
 
-// Health check endpoint.
+// HealthResponse reports the server's state: the time, the auto-scheduler, the scheduled posts and the token.
+type HealthResponse struct {
+	Status         string `json:"status"`
+	Now            string `json:"now"` // Current time in the configured timezone
+	CronRunning    bool   `json:"cron_running"`
+	CronEnabled    bool   `json:"cron_enabled"`
+	ScheduledPosts int    `json:"scheduled_posts"`
+	TokenValid     bool   `json:"token_valid"`
+}
+
+// ReadinessResponse reports whether the server is ready to publish, with what keeps it from being ready.
+type ReadinessResponse struct {
+	Ready    bool     `json:"ready"`
+	Problems []string `json:"problems"`
+}
+
+// Health check endpoint, a liveness probe: it answers 200 whenever the server responds, along with its state.
 func (r *Router) healthCheck(c *fiber.Ctx) error {
+	now, err := r.config.Now()
+	if err != nil {
+		now = time.Now()
+	}
+
+	health := HealthResponse{
+		Status:      "healthy",
+		Now:         now.Format(time.RFC3339),
+		CronEnabled: r.config.Cron.Enabled,
+		CronRunning: r.cronScheduler != nil && r.cronScheduler.IsRunning(),
+		TokenValid:  r.checkToken() == nil,
+	}
+
+	for _, post := range r.scheduler.GetPosts() {
+		if post.Status == models.StatusScheduled {
+			health.ScheduledPosts++
+		}
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"service": "linkedin-post-scheduler-api",
+		"data":    health,
+	})
+}
+
+// Readiness check endpoint: 200 once the config is valid and a valid LinkedIn token is present, 503
+// until then. ?require_token=false skips the token, so the sign-in page can be reached through a
+// load balancer that only routes to ready servers.
+func (r *Router) readinessCheck(c *fiber.Ctx) error {
+	readiness := ReadinessResponse{Ready: true, Problems: []string{}}
+
+	if err := r.config.Validate(config.ConfigPath()); err != nil {
+		readiness.Problems = append(readiness.Problems, err.Error())
+	}
+
+	if c.QueryBool("require_token", true) {
+		if err := r.checkToken(); err != nil {
+			readiness.Problems = append(readiness.Problems, err.Error())
+		}
+	}
+
+	if len(readiness.Problems) > 0 {
+		readiness.Ready = false
+		c.Status(fiber.StatusServiceUnavailable)
+	}
+
 	return c.JSON(fiber.Map{
-		"success":   true,
-		"status":    "healthy",
-		"timestamp": fiber.Map{"now": "server_running"},
-		"service":   "linkedin-post-scheduler-api",
+		"success": true,
+		"data":    readiness,
 	})
 }
 
-// @title LinkedIn Post Scheduler API
-// @version 1.0
-// @description REST API for scheduling and publishing LinkedIn posts.
-// @BasePath /api
+// checkToken reports why the default account's token cannot publish: missing, unreadable or expired.
+func (r *Router) checkToken() error {
+	token, err := config.LoadToken(r.config.Storage.TokenFile)
+
+	switch {
+	case errors.Is(err, config.ErrTokenNotFound) || (err == nil && token == nil):
+		return fmt.Errorf("no LinkedIn token, sign in first")
+	case err != nil:
+		return fmt.Errorf("LinkedIn token unusable: %w", err)
+	case !token.Valid():
+		return fmt.Errorf("LinkedIn token expired, sign in again")
+	}
+
+	return nil
+}
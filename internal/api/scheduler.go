@@ -3,6 +3,8 @@ package api
 import (
 	"time"
 
+	"PostedIn/internal/scheduler"
+
 	"github.com/gofiber/fiber/v2"
 )
 
@@ -13,6 +15,16 @@ type SchedulerStatusResponse struct {
 	Mode    string      `json:"mode,omitempty"`
 	Entries interface{} `json:"entries,omitempty"`
 	NextRun *time.Time  `json:"next_run,omitempty"`
+	Paused  bool        `json:"paused"`
+	DryRun  bool        `json:"dry_run"`
+	// Timezone is the location the cron scheduler runs in; TimezoneWarning is set when it differs from the config.
+	Timezone        string `json:"timezone,omitempty"`
+	TimezoneWarning string `json:"timezone_warning,omitempty"`
+}
+
+// DryRunRequest represents the request payload for switching dry-run publishing.
+type DryRunRequest struct {
+	Enabled bool `json:"enabled"`
 }
 
 // setupSchedulerRoutes configures all scheduler-related routes.
@@ -20,8 +32,13 @@ func (r *Router) setupSchedulerRoutes(api fiber.Router) {
 	scheduler := api.Group("/scheduler")
 
 	scheduler.Get("/status", r.getSchedulerStatus)
+	scheduler.Get("/next", r.getNextRun)
 	scheduler.Post("/start", r.startScheduler)
 	scheduler.Post("/stop", r.stopScheduler)
+	scheduler.Post("/pause", r.pausePublishing)
+	scheduler.Post("/resume", r.resumePublishing)
+	scheduler.Post("/dry-run", r.setDryRun)
+	scheduler.Get("/slo", r.getSLOs)
 }
 
 // @Router /scheduler/status [get].
@@ -30,6 +47,8 @@ func (r *Router) getSchedulerStatus(c *fiber.Ctx) error {
 		response := SchedulerStatusResponse{
 			Running: false,
 			Enabled: false,
+			Paused:  r.scheduler.IsPaused(),
+			DryRun:  r.scheduler.IsDryRun(),
 		}
 		return c.JSON(fiber.Map{
 			"success": true,
@@ -43,6 +62,8 @@ func (r *Router) getSchedulerStatus(c *fiber.Ctx) error {
 	response := SchedulerStatusResponse{
 		Running: false,
 		Enabled: false,
+		Paused:  r.scheduler.IsPaused(),
+		DryRun:  r.scheduler.IsDryRun(),
 	}
 
 	if running, ok := status["running"].(bool); ok {
@@ -65,6 +86,11 @@ func (r *Router) getSchedulerStatus(c *fiber.Ctx) error {
 		response.NextRun = &nextRun
 	}
 
+	response.Timezone = r.cronScheduler.CurrentTimezone().String()
+	if err := r.cronScheduler.VerifyTimezone(); err != nil {
+		response.TimezoneWarning = err.Error()
+	}
+
 	return c.JSON(fiber.Map{
 		"success": true,
 		"data":    response,
@@ -109,3 +135,96 @@ func (r *Router) stopScheduler(c *fiber.Ctx) error {
 		"message": "Scheduler stopped successfully",
 	})
 }
+
+// @Router /scheduler/pause [post].
+func (r *Router) pausePublishing(c *fiber.Ctx) error {
+	if err := r.scheduler.SetPaused(r.config, true); err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"success": false,
+			"error":   err.Error(),
+		})
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"message": "Publishing paused",
+	})
+}
+
+// @Router /scheduler/resume [post].
+func (r *Router) resumePublishing(c *fiber.Ctx) error {
+	if err := r.scheduler.SetPaused(r.config, false); err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"success": false,
+			"error":   err.Error(),
+		})
+	}
+
+	// Publish posts that came due while paused
+	if r.cronScheduler != nil {
+		r.cronScheduler.PublishHeld()
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"message": "Publishing resumed",
+	})
+}
+
+// @Router /scheduler/dry-run [post].
+func (r *Router) setDryRun(c *fiber.Ctx) error {
+	var req DryRunRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"success": false,
+			"error":   "Invalid JSON payload",
+		})
+	}
+
+	if err := r.scheduler.SetDryRun(r.config, req.Enabled); err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"success": false,
+			"error":   err.Error(),
+		})
+	}
+
+	message := "Dry run disabled, publishes go to LinkedIn"
+	if req.Enabled {
+		message = "Dry run enabled, publishes are logged and not sent to LinkedIn"
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"message": message,
+	})
+}
+
+// @Router /scheduler/next [get].
+func (r *Router) getNextRun(c *fiber.Ctx) error {
+	next, ok := r.registry.Next()
+	if !ok {
+		return c.JSON(fiber.Map{
+			"success": true,
+			"data":    nil,
+			"message": "No posts are armed for publishing",
+		})
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    next,
+	})
+}
+
+// @Router /scheduler/slo [get].
+func (r *Router) getSLOs(c *fiber.Ctx) error {
+	now, err := r.config.Now()
+	if err != nil {
+		now = time.Now()
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    scheduler.ComputeSLOs(r.scheduler.GetPosts(), r.config.SLO, now),
+	})
+}
@@ -0,0 +1,109 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"PostedIn/internal/config"
+	"PostedIn/internal/cron"
+	"PostedIn/internal/models"
+	"PostedIn/internal/scheduler"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+func TestPauseAndResumePublishing(t *testing.T) {
+	useTempConfig(t)
+
+	app, sched := newTestApp(t, nil)
+
+	if status, body := doRequest(t, app, http.MethodPost, "/api/scheduler/pause", ""); status != http.StatusOK {
+		t.Fatalf("pause: status = %d (body %s)", status, body)
+	}
+
+	if !sched.IsPaused() {
+		t.Fatal("the scheduler is not paused after POST /api/scheduler/pause")
+	}
+
+	if status, _ := doRequest(t, app, http.MethodPost, "/api/posts/publish-due", ""); status != http.StatusConflict {
+		t.Errorf("publish-due while paused: status = %d, want 409", status)
+	}
+
+	_, body := doRequest(t, app, http.MethodGet, "/api/scheduler/status", "")
+
+	var status struct {
+		Data SchedulerStatusResponse `json:"data"`
+	}
+
+	if err := json.Unmarshal(body, &status); err != nil || !status.Data.Paused {
+		t.Errorf("status does not report the pause: %s", body)
+	}
+
+	if code, body := doRequest(t, app, http.MethodPost, "/api/scheduler/resume", ""); code != http.StatusOK {
+		t.Fatalf("resume: status = %d (body %s)", code, body)
+	}
+
+	if code, body := doRequest(t, app, http.MethodPost, "/api/posts/publish-due", ""); code != http.StatusOK {
+		t.Errorf("publish-due after resume: status = %d (body %s)", code, body)
+	}
+}
+
+func TestGetNextRunAcrossStores(t *testing.T) {
+	cfg := &config.Config{Timezone: config.TimezoneConfig{Location: "UTC"}}
+	app := fiber.New()
+	router := NewRouter(cfg, scheduler.NewScheduler(filepath.Join(t.TempDir(), "posts.json")), nil)
+	router.SetupRoutes(app)
+
+	_, body := doRequest(t, app, http.MethodGet, "/api/scheduler/next", "")
+
+	var empty struct {
+		Data *cron.NextRun `json:"data"`
+	}
+
+	if err := json.Unmarshal(body, &empty); err != nil || empty.Data != nil {
+		t.Fatalf("next without stores = %s, want no data", body)
+	}
+
+	var want models.Post
+
+	for store, delay := range map[string]time.Duration{"personal": 3 * time.Hour, "company": time.Hour} {
+		sched := scheduler.NewScheduler(filepath.Join(t.TempDir(), "posts.json"))
+
+		post, err := sched.Add(models.Post{Content: store, ScheduledAt: time.Now().Add(delay)}, cfg)
+		if err != nil {
+			t.Fatalf("Add: %v", err)
+		}
+
+		if store == "company" {
+			want = post
+		}
+
+		cs := cron.NewScheduler(sched, cfg)
+		if err := cs.Start(); err != nil {
+			t.Fatalf("Start: %v", err)
+		}
+
+		t.Cleanup(cs.Stop)
+		router.Registry().Register(store, cs)
+	}
+
+	status, body := doRequest(t, app, http.MethodGet, "/api/scheduler/next", "")
+	if status != http.StatusOK {
+		t.Fatalf("status = %d (body %s)", status, body)
+	}
+
+	var next struct {
+		Data cron.NextRun `json:"data"`
+	}
+
+	if err := json.Unmarshal(body, &next); err != nil {
+		t.Fatalf("response is not JSON: %v", err)
+	}
+
+	if next.Data.Store != "company" || next.Data.PostID != want.ID {
+		t.Errorf("next = %+v, want company post %d", next.Data, want.ID)
+	}
+}
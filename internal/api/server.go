@@ -0,0 +1,40 @@
+package api
+
+import (
+	"log"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// SetServerStopper sets the function POST /api/server/stop uses to take the HTTP server offline.
+func (r *Router) SetServerStopper(stop func() error) {
+	r.stopServer = stop
+}
+
+// setupServerRoutes configures the HTTP server lifecycle routes.
+func (r *Router) setupServerRoutes(api fiber.Router) {
+	api.Post("/server/stop", r.stopHTTPServer)
+}
+
+// @Router /server/stop [post].
+func (r *Router) stopHTTPServer(c *fiber.Ctx) error {
+	if r.stopServer == nil {
+		return c.Status(fiber.StatusNotImplemented).JSON(fiber.Map{
+			"success": false,
+			"error":   "Stopping the HTTP server is not supported by this server",
+		})
+	}
+
+	// Stop after this response is sent; shutdown waits for in-flight requests, including this one
+	stop := r.stopServer
+	go func() {
+		if err := stop(); err != nil {
+			log.Printf("❌ %v", err)
+		}
+	}()
+
+	return c.Status(fiber.StatusAccepted).JSON(fiber.Map{
+		"success": true,
+		"message": "HTTP server is stopping; auto-publishing continues. Send SIGUSR1 to the process to start it again",
+	})
+}
@@ -0,0 +1,111 @@
+package api
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"PostedIn/internal/scheduler"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// Bulk tag modes.
+const (
+	tagModeAdd    = "add"
+	tagModeRemove = "remove"
+)
+
+// TagRequest adds or removes tags on every post matching the filter.
+type TagRequest struct {
+	Filter TagFilter `json:"filter"`
+	Tags   []string  `json:"tags"`
+	Mode   string    `json:"mode,omitempty"` // "add" (default) or "remove"
+}
+
+// TagFilter selects posts by status, scheduled time range (inclusive) and content search.
+type TagFilter struct {
+	Status string `json:"status,omitempty"`
+	From   string `json:"from,omitempty"`
+	To     string `json:"to,omitempty"`
+	Search string `json:"search,omitempty"`
+}
+
+// @Router /posts/tag [post].
+func (r *Router) tagPosts(c *fiber.Ctx) error {
+	var req TagRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"success": false,
+			"error":   "Invalid JSON payload",
+		})
+	}
+
+	if req.Mode == "" {
+		req.Mode = tagModeAdd
+	}
+
+	if req.Mode != tagModeAdd && req.Mode != tagModeRemove {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"success": false,
+			"error":   fmt.Sprintf("mode must be %q or %q", tagModeAdd, tagModeRemove),
+		})
+	}
+
+	filter, err := r.parsePostFilter(req.Filter, "filter.")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"success": false,
+			"error":   err.Error(),
+		})
+	}
+
+	affected, err := r.scheduler.TagPosts(filter, req.Tags, req.Mode == tagModeRemove)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"success": false,
+			"error":   err.Error(),
+		})
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data": fiber.Map{
+			"affected": affected,
+		},
+	})
+}
+
+// parsePostFilter converts a request filter, reading from and to like scheduled_at. Errors name
+// the fields with prefix, e.g. "filter." for a filter nested in a request body.
+func (r *Router) parsePostFilter(req TagFilter, prefix string) (scheduler.PostFilter, error) {
+	if req.Status != "" && !slices.Contains(boardStatuses, req.Status) {
+		return scheduler.PostFilter{}, fmt.Errorf("%sstatus must be one of: %s", prefix, strings.Join(boardStatuses, ", "))
+	}
+
+	filter := scheduler.PostFilter{Status: req.Status, Search: req.Search}
+
+	now, err := r.config.Now()
+	if err != nil {
+		now = time.Now()
+	}
+
+	if req.From != "" {
+		if filter.From, err = r.parseScheduledAt(req.From, now); err != nil {
+			return scheduler.PostFilter{}, fmt.Errorf("%sfrom: %w", prefix, err)
+		}
+	}
+
+	if req.To != "" {
+		if filter.To, err = r.parseScheduledAt(req.To, now); err != nil {
+			return scheduler.PostFilter{}, fmt.Errorf("%sto: %w", prefix, err)
+		}
+	}
+
+	if !filter.From.IsZero() && !filter.To.IsZero() && filter.To.Before(filter.From) {
+		return scheduler.PostFilter{}, fmt.Errorf("%[1]sto is before %[1]sfrom", prefix)
+	}
+
+	return filter, nil
+}
@@ -0,0 +1,83 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"slices"
+	"testing"
+	"time"
+
+	"PostedIn/internal/config"
+	"PostedIn/internal/models"
+	"PostedIn/internal/scheduler"
+)
+
+func TestTagPostsEndpoint(t *testing.T) {
+	cfg := &config.Config{Timezone: config.TimezoneConfig{Location: "UTC"}}
+	app, sched := newTestApp(t, cfg)
+
+	at := time.Date(2099, 3, 10, 9, 0, 0, 0, time.UTC)
+	backup := scheduler.Backup{Version: scheduler.BackupVersion, Posts: []models.Post{
+		{ID: 1, Content: "Webinar next week", ScheduledAt: at, Status: models.StatusScheduled},
+		{ID: 2, Content: "Webinar replay", ScheduledAt: at.AddDate(0, 0, 7), Status: models.StatusScheduled},
+		{ID: 3, Content: "Webinar draft", ScheduledAt: at, Status: models.StatusDraft},
+	}}
+
+	data, err := json.Marshal(backup)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := sched.Restore(bytes.NewReader(data), cfg); err != nil {
+		t.Fatalf("Restore: %v", err)
+	}
+
+	body := `{"filter": {"status": "scheduled", "from": "2099-03-01 00:00", "to": "2099-03-12 00:00", "search": "webinar"}, "tags": ["Events"]}`
+
+	status, resp := doRequest(t, app, http.MethodPost, "/api/posts/tag", body)
+	if status != http.StatusOK {
+		t.Fatalf("status = %d (body %s)", status, resp)
+	}
+
+	var result struct {
+		Data struct {
+			Affected int `json:"affected"`
+		} `json:"data"`
+	}
+
+	if err := json.Unmarshal(resp, &result); err != nil {
+		t.Fatal(err)
+	}
+
+	if result.Data.Affected != 1 {
+		t.Errorf("affected = %d, want only post 1", result.Data.Affected)
+	}
+
+	for _, post := range sched.GetPosts() {
+		tagged := slices.Equal(post.Tags, []string{"events"})
+		if tagged != (post.ID == 1) {
+			t.Errorf("post %d tags = %v", post.ID, post.Tags)
+		}
+	}
+
+	status, resp = doRequest(t, app, http.MethodPost, "/api/posts/tag", `{"filter": {}, "tags": ["events"], "mode": "remove"}`)
+	if status != http.StatusOK {
+		t.Fatalf("remove: status = %d (body %s)", status, resp)
+	}
+
+	if post := findTestPost(t, sched, 1); len(post.Tags) != 0 {
+		t.Errorf("post 1 tags after remove = %v, want none", post.Tags)
+	}
+
+	for _, invalid := range []string{
+		`{"filter": {}, "tags": ["x"], "mode": "toggle"}`,
+		`{"filter": {"status": "archived"}, "tags": ["x"]}`,
+		`{"filter": {"from": "2099-03-12 00:00", "to": "2099-03-01 00:00"}, "tags": ["x"]}`,
+		`{"filter": {}, "tags": []}`,
+	} {
+		if status, resp := doRequest(t, app, http.MethodPost, "/api/posts/tag", invalid); status != http.StatusBadRequest {
+			t.Errorf("%s: status = %d, want 400 (body %s)", invalid, status, resp)
+		}
+	}
+}
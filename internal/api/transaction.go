@@ -0,0 +1,139 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"PostedIn/internal/models"
+	"PostedIn/internal/scheduler"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// Transaction operation kinds.
+const (
+	opCreate = "create"
+	opUpdate = "update"
+	opDelete = "delete"
+)
+
+// TransactionRequest holds operations applied in order and all-or-nothing.
+type TransactionRequest struct {
+	Operations []TransactionOperation `json:"operations"`
+}
+
+// TransactionOperation is one step of a transaction: create uses Post, update uses ID and
+// Post (content and/or scheduled_at), delete uses ID.
+type TransactionOperation struct {
+	Op   string       `json:"op"`
+	ID   int          `json:"id,omitempty"`
+	Post *PostRequest `json:"post,omitempty"`
+}
+
+// @Router /posts/transaction [post].
+func (r *Router) postsTransaction(c *fiber.Ctx) error {
+	var req TransactionRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"success": false,
+			"error":   "Invalid JSON payload",
+		})
+	}
+
+	if len(req.Operations) == 0 {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"success": false,
+			"error":   "operations are required",
+		})
+	}
+
+	tx := r.scheduler.Begin()
+	defer tx.Rollback()
+
+	var (
+		changed []models.Post
+		deleted = []int{}
+	)
+
+	for i, op := range req.Operations {
+		post, err := r.applyOperation(tx, op)
+		if err != nil {
+			// Nothing was committed, so the deferred rollback discards every operation
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"success": false,
+				"error":   fmt.Sprintf("operation %d (%s): %v", i+1, op.Op, err),
+			})
+		}
+
+		if op.Op == opDelete {
+			deleted = append(deleted, op.ID)
+		} else {
+			changed = append(changed, post)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"success": false,
+			"error":   err.Error(),
+		})
+	}
+
+	// Timers only change after the commit succeeded, so a failed transaction never touches them
+	if r.cronScheduler != nil && r.cronScheduler.IsRunning() {
+		r.cronScheduler.RemovePostTimers(deleted)
+
+		for i := range changed {
+			if changed[i].Status == models.StatusScheduled {
+				_ = r.cronScheduler.AddNewPost(&changed[i])
+			}
+		}
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data": fiber.Map{
+			"posts":   changed,
+			"deleted": deleted,
+		},
+	})
+}
+
+// applyOperation applies one transaction operation to the staged posts.
+func (r *Router) applyOperation(tx *scheduler.Tx, op TransactionOperation) (models.Post, error) {
+	switch op.Op {
+	case opCreate:
+		if op.Post == nil {
+			return models.Post{}, errors.New("post is required")
+		}
+
+		post, err := r.preparePost(*op.Post)
+		if err != nil {
+			return models.Post{}, err
+		}
+
+		return tx.Add(post, r.config)
+	case opUpdate:
+		if op.Post == nil {
+			return models.Post{}, errors.New("post is required")
+		}
+
+		var scheduledAt time.Time
+
+		if op.Post.ScheduledAt != "" {
+			var err error
+
+			scheduledAt, err = r.parseFutureTime(op.Post.ScheduledAt)
+			if err != nil {
+				return models.Post{}, err
+			}
+		}
+
+		return tx.UpdatePost(op.ID, op.Post.Content, scheduledAt, r.config)
+	case opDelete:
+		return models.Post{}, tx.DeletePost(op.ID)
+	default:
+		return models.Post{}, fmt.Errorf("unknown op %q, use %s, %s or %s", op.Op, opCreate, opUpdate, opDelete)
+	}
+}
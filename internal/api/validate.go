@@ -0,0 +1,136 @@
+package api
+
+import (
+	"time"
+
+	"PostedIn/internal/models"
+	"PostedIn/internal/scheduler"
+	"PostedIn/internal/transform"
+	"PostedIn/pkg/linkedin"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// Validation check groups, in the order createPost runs them.
+const (
+	checkSchedule   = "schedule"
+	checkContent    = "content"
+	checkDependency = "dependency"
+)
+
+// ValidationResult is the outcome of validating a post request without creating it.
+type ValidationResult struct {
+	Valid       bool              `json:"valid"`
+	Checks      []ValidationCheck `json:"checks"`
+	Post        *models.Post      `json:"post,omitempty"`         // The post create would store, when valid
+	ScheduledAt string            `json:"scheduled_at,omitempty"` // Its publish time in the configured timezone
+	Hashtags    []string          `json:"hashtags"`
+	Mentions    []string          `json:"mentions"`
+}
+
+// ValidationCheck holds the errors and warnings of one group of create-time validators.
+type ValidationCheck struct {
+	Check    string   `json:"check"`
+	Errors   []string `json:"errors"`
+	Warnings []string `json:"warnings"`
+}
+
+// @Router /posts/validate [post].
+func (r *Router) validatePost(c *fiber.Ctx) error {
+	var req PostRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"success": false,
+			"error":   "Invalid JSON payload",
+		})
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    r.validatePostRequest(req),
+	})
+}
+
+// validatePostRequest runs the validators of createPost without storing the post. Unlike create,
+// which stops at the first error, every group runs so all issues are reported at once.
+func (r *Router) validatePostRequest(req PostRequest) ValidationResult {
+	schedule := ValidationCheck{Check: checkSchedule, Errors: []string{}, Warnings: []string{}}
+	content := ValidationCheck{Check: checkContent, Errors: []string{}, Warnings: []string{}}
+	dependency := ValidationCheck{Check: checkDependency, Errors: []string{}, Warnings: []string{}}
+
+	if req.ContentFile != "" {
+		if req.Content != "" {
+			content.Errors = append(content.Errors, "content and content_file are mutually exclusive")
+		} else if text, err := r.readContentFile(req.ContentFile); err != nil {
+			content.Errors = append(content.Errors, err.Error())
+		} else {
+			req.Content = text
+		}
+	}
+
+	if req.Recipe != "" {
+		if applied, err := r.applyRecipe(req); err != nil {
+			content.Errors = append(content.Errors, err.Error())
+		} else {
+			req = applied
+		}
+	}
+
+	scheduledAt, err := r.validateAndParsePostRequest(req)
+	if err != nil {
+		schedule.Errors = append(schedule.Errors, err.Error())
+	} else if !scheduledAt.IsZero() {
+		if warning := scheduler.TokenExpiryWarning(scheduledAt, req.Account, r.config); warning != "" {
+			schedule.Warnings = append(schedule.Warnings, warning)
+		}
+	}
+
+	_, sanitizeWarnings := transform.SanitizeContent(req.Content)
+	content.Warnings = append(content.Warnings, sanitizeWarnings...)
+
+	if warning := r.scheduler.DuplicateWarning(req.Content, r.config); warning != "" {
+		content.Warnings = append(content.Warnings, warning)
+	}
+
+	post := buildPost(req, scheduledAt)
+	if err := scheduler.NormalizePost(&post, r.config); err != nil {
+		content.Errors = append(content.Errors, err.Error())
+	}
+
+	tags := linkedin.ScanContent(post.Content)
+	content.Warnings = append(content.Warnings, tags.Warnings...)
+
+	if err := r.scheduler.CheckDependency(post); err != nil {
+		dependency.Errors = append(dependency.Errors, err.Error())
+	}
+
+	checks := []ValidationCheck{schedule, content, dependency}
+	result := ValidationResult{Valid: true, Checks: checks, Hashtags: tags.Hashtags, Mentions: tags.Mentions}
+
+	for _, check := range checks {
+		if len(check.Errors) > 0 {
+			result.Valid = false
+		}
+	}
+
+	if result.Valid {
+		result.Post = &post
+		result.ScheduledAt = r.formatScheduledAt(post.ScheduledAt)
+	}
+
+	return result
+}
+
+// formatScheduledAt formats a publish time like resolved_at, in the configured timezone; a zero
+// time, as for a post waiting on another, formats as empty.
+func (r *Router) formatScheduledAt(scheduledAt time.Time) string {
+	if scheduledAt.IsZero() {
+		return ""
+	}
+
+	if loc, err := r.config.GetTimezone(); err == nil {
+		scheduledAt = scheduledAt.In(loc)
+	}
+
+	return scheduledAt.Format("2006-01-02 15:04 MST")
+}
@@ -0,0 +1,121 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestValidateMatchesCreate(t *testing.T) {
+	app, sched := newTestApp(t, nil)
+
+	bodies := []string{
+		`{"content": "too late", "scheduled_at": "2000-01-01 09:00"}`,
+		`{"content": "no idea when", "scheduled_at": "whenever"}`,
+		`{"content": "after a missing post", "depends_on": 99}`,
+		`{"scheduled_at": "2099-01-01 09:00"}`,
+	}
+
+	for _, body := range bodies {
+		status, data := doRequest(t, app, http.MethodPost, "/api/posts/validate", body)
+		if status != http.StatusOK {
+			t.Fatalf("validate %s: status = %d (body %s)", body, status, data)
+		}
+
+		var validation struct {
+			Data ValidationResult `json:"data"`
+		}
+
+		if err := json.Unmarshal(data, &validation); err != nil {
+			t.Fatalf("validate %s: %v", body, err)
+		}
+
+		result := validation.Data
+
+		var errs []string
+		for _, check := range result.Checks {
+			errs = append(errs, check.Errors...)
+		}
+
+		if result.Valid || len(errs) == 0 || result.Post != nil {
+			t.Errorf("validate %s = %+v, want it invalid with errors and no post", body, result)
+			continue
+		}
+
+		status, data = doRequest(t, app, http.MethodPost, "/api/posts", body)
+		if status != http.StatusBadRequest {
+			t.Errorf("create %s: status = %d, want 400", body, status)
+			continue
+		}
+
+		var created struct {
+			Error string `json:"error"`
+		}
+
+		if err := json.Unmarshal(data, &created); err != nil {
+			t.Fatalf("create %s: %v", body, err)
+		}
+
+		if created.Error != errs[0] {
+			t.Errorf("create %s failed with %q, validate reported %q first", body, created.Error, errs[0])
+		}
+	}
+
+	if posts := sched.GetPosts(); len(posts) != 0 {
+		t.Errorf("stored %d posts, want none", len(posts))
+	}
+}
+
+func TestValidateHasNoSideEffects(t *testing.T) {
+	app, sched := newTestApp(t, nil)
+
+	when := time.Now().UTC().Add(48 * time.Hour).Format("2006-01-02 15:04")
+	body := `{"content": "Shipping today #golang", "scheduled_at": "` + when + `"}`
+
+	if status, data := doRequest(t, app, http.MethodPost, "/api/posts", body); status != http.StatusCreated {
+		t.Fatalf("create: status = %d (body %s)", status, data)
+	}
+
+	if _, err := sched.MarkAsPosted(1); err != nil {
+		t.Fatalf("MarkAsPosted: %v", err)
+	}
+
+	status, data := doRequest(t, app, http.MethodPost, "/api/posts/validate", body)
+	if status != http.StatusOK {
+		t.Fatalf("validate: status = %d (body %s)", status, data)
+	}
+
+	var validation struct {
+		Data ValidationResult `json:"data"`
+	}
+
+	if err := json.Unmarshal(data, &validation); err != nil {
+		t.Fatal(err)
+	}
+
+	result := validation.Data
+	if !result.Valid || result.Post == nil || result.ScheduledAt != when+" UTC" {
+		t.Fatalf("validate = %+v, want a valid post scheduled at %s", result, when)
+	}
+
+	if len(result.Hashtags) != 1 || !strings.Contains(result.Hashtags[0], "golang") {
+		t.Errorf("hashtags = %v, want golang", result.Hashtags)
+	}
+
+	var warnings []string
+	for _, check := range result.Checks {
+		if check.Check == checkContent {
+			warnings = check.Warnings
+		}
+	}
+
+	if len(warnings) == 0 {
+		t.Error("validating a repeat of a published post gave no duplicate warning")
+	}
+
+	if posts := sched.GetPosts(); len(posts) != 1 {
+		t.Errorf("stored %d posts after validating, want only the created one", len(posts))
+	}
+}
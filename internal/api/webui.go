@@ -0,0 +1,26 @@
+package api
+
+import (
+	"embed"
+	"io/fs"
+	"net/http"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/middleware/filesystem"
+)
+
+//go:embed webui
+var webUIFiles embed.FS
+
+// setupWebUI serves the embedded post management page at /app.
+func (r *Router) setupWebUI(app *fiber.App) {
+	assets, err := fs.Sub(webUIFiles, "webui")
+	if err != nil {
+		panic(err)
+	}
+
+	app.Use("/app", filesystem.New(filesystem.Config{
+		Root:  http.FS(assets),
+		Index: "index.html",
+	}))
+}
@@ -0,0 +1,179 @@
+// Minimal post manager built on the /api endpoints.
+(function () {
+    'use strict';
+
+    const form = document.getElementById('post-form');
+    const postID = document.getElementById('post-id');
+    const content = document.getElementById('content');
+    const scheduledAt = document.getElementById('scheduled-at');
+    const submit = document.getElementById('submit');
+    const cancel = document.getElementById('cancel');
+    const formTitle = document.getElementById('form-title');
+    const message = document.getElementById('message');
+    const postsBody = document.getElementById('posts');
+    const statusBox = document.getElementById('status');
+
+    async function api(method, path, body) {
+        const options = { method: method, headers: {} };
+        if (body !== undefined) {
+            options.headers['Content-Type'] = 'application/json';
+            options.body = JSON.stringify(body);
+        }
+
+        const response = await fetch('/api' + path, options);
+        const payload = await response.json();
+        if (!response.ok || !payload.success) {
+            throw new Error(payload.error || response.statusText);
+        }
+
+        return payload.data;
+    }
+
+    function showMessage(text, isError) {
+        message.textContent = text;
+        message.className = isError ? 'message error' : 'message';
+        message.hidden = false;
+    }
+
+    // The API expects 'YYYY-MM-DD HH:MM' in the configured timezone.
+    function toAPITime(value) {
+        return value.replace('T', ' ').slice(0, 16);
+    }
+
+    // Times are shown as stored, i.e. in the configured timezone.
+    function formatTime(value) {
+        if (!value || value.startsWith('0001-')) {
+            return '';
+        }
+
+        return value.slice(0, 16).replace('T', ' ');
+    }
+
+    function resetForm() {
+        form.reset();
+        postID.value = '';
+        formTitle.textContent = 'Schedule a post';
+        submit.textContent = 'Schedule';
+        cancel.hidden = true;
+    }
+
+    function editPost(post) {
+        postID.value = post.id;
+        content.value = post.content;
+        scheduledAt.value = post.scheduled_at.slice(0, 16);
+        formTitle.textContent = 'Edit post ' + post.id;
+        submit.textContent = 'Save';
+        cancel.hidden = false;
+        content.focus();
+    }
+
+    async function deletePost(post) {
+        if (!confirm('Delete post ' + post.id + '?')) {
+            return;
+        }
+
+        try {
+            await api('DELETE', '/posts/' + post.id);
+            showMessage('Post ' + post.id + ' deleted.');
+            await loadPosts();
+        } catch (err) {
+            showMessage(err.message, true);
+        }
+    }
+
+    function button(label, className, onClick) {
+        const b = document.createElement('button');
+        b.type = 'button';
+        b.textContent = label;
+        b.className = className;
+        b.addEventListener('click', onClick);
+
+        return b;
+    }
+
+    function renderPosts(posts) {
+        postsBody.replaceChildren();
+
+        if (!posts || posts.length === 0) {
+            const row = postsBody.insertRow();
+            const cell = row.insertCell();
+            cell.colSpan = 5;
+            cell.textContent = 'No posts scheduled.';
+
+            return;
+        }
+
+        posts.forEach(function (post) {
+            const row = postsBody.insertRow();
+            row.insertCell().textContent = post.id;
+            row.insertCell().textContent = post.status;
+            row.insertCell().textContent = formatTime(post.scheduled_at);
+
+            const text = row.insertCell();
+            text.className = 'content';
+            text.textContent = post.content;
+
+            const actions = row.insertCell();
+            actions.className = 'row-actions';
+            if (post.status === 'scheduled') {
+                actions.append(button('Edit', 'secondary', function () { editPost(post); }), ' ');
+            }
+            actions.append(button('Delete', 'danger', function () { deletePost(post); }));
+        });
+    }
+
+    async function loadPosts() {
+        try {
+            renderPosts(await api('GET', '/posts'));
+        } catch (err) {
+            showMessage('Failed to load posts: ' + err.message, true);
+        }
+    }
+
+    async function loadStatus() {
+        try {
+            const status = await api('GET', '/scheduler/status');
+            let text = status.running ? 'Auto-scheduler running' : 'Auto-scheduler stopped';
+            if (status.next_run) {
+                text += ' - next post ' + formatTime(status.next_run);
+            }
+            if (status.paused) {
+                text += ' - publishing paused';
+            }
+            statusBox.textContent = text;
+            statusBox.className = status.running ? 'status running' : 'status';
+        } catch (err) {
+            statusBox.textContent = 'Scheduler status unavailable';
+        }
+    }
+
+    form.addEventListener('submit', async function (event) {
+        event.preventDefault();
+
+        const body = { content: content.value, scheduled_at: toAPITime(scheduledAt.value) };
+
+        try {
+            if (postID.value) {
+                await api('PUT', '/posts/' + postID.value, body);
+                showMessage('Post ' + postID.value + ' updated.');
+            } else {
+                const post = await api('POST', '/posts', body);
+                showMessage('Post ' + post.id + ' scheduled.');
+            }
+
+            resetForm();
+            await Promise.all([loadPosts(), loadStatus()]);
+        } catch (err) {
+            showMessage(err.message, true);
+        }
+    });
+
+    cancel.addEventListener('click', resetForm);
+    document.getElementById('refresh').addEventListener('click', function () {
+        loadPosts();
+        loadStatus();
+    });
+
+    loadPosts();
+    loadStatus();
+})();
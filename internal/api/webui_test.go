@@ -0,0 +1,42 @@
+package api
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestWebUIServesPage(t *testing.T) {
+	app, _ := newTestApp(t, nil)
+
+	for _, target := range []string{"/app", "/app/"} {
+		status, body := doRequest(t, app, http.MethodGet, target, "")
+		if status != http.StatusOK {
+			t.Fatalf("GET %s status = %d", target, status)
+		}
+
+		page := string(body)
+		if !strings.Contains(page, "/app/app.js") || !strings.Contains(page, "/app/style.css") {
+			t.Errorf("GET %s does not reference the embedded assets", target)
+		}
+	}
+}
+
+func TestWebUIServesAssets(t *testing.T) {
+	app, _ := newTestApp(t, nil)
+
+	for _, target := range []string{"/app/app.js", "/app/style.css"} {
+		status, body := doRequest(t, app, http.MethodGet, target, "")
+		if status != http.StatusOK {
+			t.Fatalf("GET %s status = %d", target, status)
+		}
+
+		if len(body) == 0 {
+			t.Errorf("GET %s returned an empty asset", target)
+		}
+	}
+
+	if status, _ := doRequest(t, app, http.MethodGet, "/app/missing.js", ""); status != http.StatusNotFound {
+		t.Errorf("GET /app/missing.js status = %d, want %d", status, http.StatusNotFound)
+	}
+}
@@ -0,0 +1,101 @@
+// Package assets caches the LinkedIn URNs of uploaded media so repeated attachments are not re-uploaded.
+package assets
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+// cacheFilePerm keeps the cache private like the posts and token files.
+const cacheFilePerm = 0o600
+
+// mu serializes read-modify-write cycles of cache files across concurrent publishes.
+var mu sync.Mutex
+
+// Entry is an uploaded asset.
+type Entry struct {
+	URN        string    `json:"urn"`
+	UploadedAt time.Time `json:"uploaded_at"`
+}
+
+// Key identifies an upload by owner and file content, so a renamed copy of the same file
+// still hits and an edited file at the same path does not.
+func Key(owner, path string) (string, error) {
+	file, err := os.Open(filepath.Clean(path))
+	if err != nil {
+		return "", fmt.Errorf("failed to open %s: %w", path, err)
+	}
+
+	defer func() {
+		_ = file.Close()
+	}()
+
+	hash := sha256.New()
+	if _, err := io.Copy(hash, file); err != nil {
+		return "", fmt.Errorf("failed to hash %s: %w", path, err)
+	}
+
+	return owner + "|" + hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+// Lookup returns the URN cached under key when it was uploaded less than ttl before now.
+func Lookup(cacheFile, key string, ttl time.Duration, now time.Time) (string, bool) {
+	mu.Lock()
+	defer mu.Unlock()
+
+	entry, ok := load(cacheFile)[key]
+	if !ok || now.Sub(entry.UploadedAt) >= ttl {
+		return "", false
+	}
+
+	return entry.URN, true
+}
+
+// Store records an upload under key, dropping entries older than ttl.
+func Store(cacheFile, key, urn string, ttl time.Duration, now time.Time) error {
+	mu.Lock()
+	defer mu.Unlock()
+
+	entries := load(cacheFile)
+	for k, entry := range entries {
+		if now.Sub(entry.UploadedAt) >= ttl {
+			delete(entries, k)
+		}
+	}
+
+	entries[key] = Entry{URN: urn, UploadedAt: now}
+
+	data, err := json.MarshalIndent(entries, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal asset cache: %w", err)
+	}
+
+	if err := os.WriteFile(cacheFile, data, cacheFilePerm); err != nil {
+		return fmt.Errorf("failed to write asset cache: %w", err)
+	}
+
+	return nil
+}
+
+// load reads the cache file; a missing or unreadable cache starts empty, costing only a re-upload.
+func load(cacheFile string) map[string]Entry {
+	entries := make(map[string]Entry)
+
+	data, err := os.ReadFile(cacheFile)
+	if err != nil {
+		return entries
+	}
+
+	if err := json.Unmarshal(data, &entries); err != nil {
+		return make(map[string]Entry)
+	}
+
+	return entries
+}
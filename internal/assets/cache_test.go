@@ -0,0 +1,85 @@
+package assets
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func writeAsset(t *testing.T, dir, name, data string) string {
+	t.Helper()
+
+	path := filepath.Join(dir, name)
+	if err := os.WriteFile(path, []byte(data), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	return path
+}
+
+func TestKeyFollowsContent(t *testing.T) {
+	dir := t.TempDir()
+	original := writeAsset(t, dir, "banner.png", "pixels")
+	copied := writeAsset(t, dir, "banner-copy.png", "pixels")
+
+	key, err := Key("urn:li:person:a", original)
+	if err != nil {
+		t.Fatalf("Key: %v", err)
+	}
+
+	if copyKey, _ := Key("urn:li:person:a", copied); copyKey != key {
+		t.Error("a renamed copy of the file got a different key")
+	}
+
+	if otherOwner, _ := Key("urn:li:organization:1", original); otherOwner == key {
+		t.Error("another owner got the same key")
+	}
+
+	writeAsset(t, dir, "banner.png", "edited pixels")
+
+	if edited, _ := Key("urn:li:person:a", original); edited == key {
+		t.Error("the edited file kept its key")
+	}
+
+	if _, err := Key("urn:li:person:a", filepath.Join(dir, "missing.png")); err == nil {
+		t.Error("Key accepted a missing file")
+	}
+}
+
+func TestLookupAndStore(t *testing.T) {
+	cacheFile := filepath.Join(t.TempDir(), "asset_cache.json")
+	now := time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC)
+
+	if _, ok := Lookup(cacheFile, "k", time.Hour, now); ok {
+		t.Fatal("Lookup hit in a missing cache")
+	}
+
+	if err := Store(cacheFile, "old", "urn:li:image:old", time.Hour, now.Add(-2*time.Hour)); err != nil {
+		t.Fatalf("Store: %v", err)
+	}
+
+	if err := Store(cacheFile, "k", "urn:li:image:1", time.Hour, now); err != nil {
+		t.Fatalf("Store: %v", err)
+	}
+
+	if urn, ok := Lookup(cacheFile, "k", time.Hour, now.Add(59*time.Minute)); !ok || urn != "urn:li:image:1" {
+		t.Errorf("Lookup within the lifetime = %q, %v, want the stored URN", urn, ok)
+	}
+
+	if _, ok := Lookup(cacheFile, "k", time.Hour, now.Add(time.Hour)); ok {
+		t.Error("Lookup hit after the lifetime ended")
+	}
+
+	if entries := load(cacheFile); len(entries) != 1 {
+		t.Errorf("cache holds %d entries, want the expired one dropped", len(entries))
+	}
+
+	if err := os.WriteFile(cacheFile, []byte("corrupt"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, ok := Lookup(cacheFile, "k", time.Hour, now); ok {
+		t.Error("Lookup hit in a corrupt cache")
+	}
+}
@@ -6,7 +6,6 @@ import (
 	"fmt"
 	"log"
 	"net/http"
-	"net/url"
 	"time"
 
 	"PostedIn/internal/config"
@@ -22,41 +21,69 @@ const (
 
 // Server handles OAuth authentication flow with LinkedIn.
 type Server struct {
-	client *linkedin.Client
-	config *config.Config
-	done   chan *linkedin.Client
-	server *http.Server
+	client  *linkedin.Client
+	config  *config.Config // The account's view of base
+	base    *config.Config
+	account string
+	states  *StateStore
+	done    chan *linkedin.Client
+	server  *http.Server
 }
 
-// NewServer creates a new OAuth authentication server.
+// NewServer creates a new OAuth authentication server for the default account.
 func NewServer(cfg *config.Config) *Server {
+	return newServer(cfg, cfg, config.DefaultAccount)
+}
+
+// NewServerForAccount creates a new OAuth authentication server that stores the token and
+// identity of the named account.
+func NewServerForAccount(cfg *config.Config, account string) (*Server, error) {
+	view, err := cfg.ForAccount(account)
+	if err != nil {
+		return nil, err
+	}
+
+	return newServer(cfg, view, account), nil
+}
+
+func newServer(base, view *config.Config, account string) *Server {
 	linkedinConfig := linkedin.NewConfig(
-		cfg.LinkedIn.ClientID,
-		cfg.LinkedIn.ClientSecret,
-		cfg.LinkedIn.RedirectURL,
+		view.LinkedIn.ClientID,
+		view.LinkedIn.ClientSecret,
+		view.LinkedIn.RedirectURL,
 	)
+	linkedinConfig.Scopes = view.OAuthScopes()
 
 	return &Server{
-		client: linkedin.NewClient(linkedinConfig),
-		config: cfg,
-		done:   make(chan *linkedin.Client, 1),
+		client:  linkedin.NewClient(linkedinConfig),
+		config:  view,
+		base:    base,
+		account: account,
+		states:  NewStateStore(authTimeout),
+		done:    make(chan *linkedin.Client, 1),
 	}
 }
 
 // StartOAuth begins the OAuth authentication flow and returns an authenticated client.
 func (a *Server) StartOAuth() (*linkedin.Client, error) {
-	// Parse redirect URL to get port
-	redirectURL, err := url.Parse(a.config.LinkedIn.RedirectURL)
+	// Resolve the listen address, which may differ from the advertised redirect URL
+	bindAddr, err := a.config.CallbackBindAddress()
+	if err != nil {
+		return nil, err
+	}
+
+	state, err := a.states.Issue()
 	if err != nil {
-		return nil, fmt.Errorf("invalid redirect URL: %w", err)
+		return nil, err
 	}
 
 	mux := http.NewServeMux()
 	mux.HandleFunc("/callback", a.handleCallback)
+	mux.HandleFunc("/login", a.handleLogin)
 	mux.HandleFunc("/", a.handleHome)
 
 	a.server = &http.Server{
-		Addr:              redirectURL.Host,
+		Addr:              bindAddr,
 		Handler:           mux,
 		ReadHeaderTimeout: readTimeout,
 		ReadTimeout:       writeTimeout,
@@ -71,7 +98,7 @@ func (a *Server) StartOAuth() (*linkedin.Client, error) {
 	}()
 
 	// Generate auth URL
-	authURL := a.client.GetAuthURL("linkedin-auth-state")
+	authURL := a.client.GetAuthURL(state)
 
 	fmt.Println("🔗 LinkedIn Authentication Required")
 	fmt.Println("===================================")
@@ -89,6 +116,18 @@ func (a *Server) StartOAuth() (*linkedin.Client, error) {
 	}
 }
 
+// handleLogin issues a state and redirects to LinkedIn's authorization page.
+func (a *Server) handleLogin(w http.ResponseWriter, r *http.Request) {
+	state, err := a.states.Issue()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	http.Redirect(w, r, a.client.GetAuthURL(state), http.StatusFound)
+}
+
+// handleHome offers to sign in through handleLogin, so rendering it issues no state.
 func (a *Server) handleHome(w http.ResponseWriter, _ *http.Request) {
 	html := `
 <!DOCTYPE html>
@@ -106,7 +145,7 @@ func (a *Server) handleHome(w http.ResponseWriter, _ *http.Request) {
     <div class="container">
         <h1>🔗 LinkedIn Post Scheduler</h1>
         <p>Click the button below to authenticate with LinkedIn</p>
-        <a href="` + a.client.GetAuthURL("linkedin-auth-state") + `" class="button">Authenticate with LinkedIn</a>
+        <a href="/login" class="button">Authenticate with LinkedIn</a>
     </div>
 </body>
 </html>`
@@ -122,7 +161,7 @@ func (a *Server) handleCallback(w http.ResponseWriter, r *http.Request) {
 	code := r.URL.Query().Get("code")
 	state := r.URL.Query().Get("state")
 
-	if state != "linkedin-auth-state" {
+	if !a.states.Consume(state) {
 		http.Error(w, "Invalid state parameter", http.StatusBadRequest)
 		return
 	}
@@ -155,12 +194,15 @@ func (a *Server) handleCallback(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	// Save user ID to config
-	if id, ok := profile["id"].(string); ok {
-		a.config.LinkedIn.UserID = id
-		if err := config.SaveConfig(a.config); err != nil {
-			log.Printf("Failed to save config: %v", err)
-		}
+	// Save user ID to config; the author URN is cached by the first successful publish
+	if err := a.config.ApplyProfile(profile); err != nil {
+		log.Printf("User ID detection failed: %v", err)
+	}
+
+	a.base.StoreAccountIdentity(a.account, a.config)
+
+	if err := config.SaveConfig(a.base); err != nil {
+		log.Printf("Failed to save config: %v", err)
 	}
 
 	// Success page
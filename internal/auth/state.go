@@ -0,0 +1,96 @@
+package auth
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// StateTTL is how long an OAuth state stays valid, i.e. how long a user has to finish signing in.
+const StateTTL = 10 * time.Minute
+
+// MaxStates caps how many states a store holds; issuing one more evicts the oldest, so repeated
+// sign-in attempts cannot grow the store without bound.
+const MaxStates = 100
+
+const stateBytes = 32
+
+// StateStore issues random OAuth state values and accepts each one back once before it expires,
+// so a callback must come from an authorization this process started.
+type StateStore struct {
+	mu     sync.Mutex
+	ttl    time.Duration
+	states map[string]time.Time // Expiry by state
+}
+
+// NewStateStore creates a state store whose states expire after ttl.
+func NewStateStore(ttl time.Duration) *StateStore {
+	return &StateStore{
+		ttl:    ttl,
+		states: make(map[string]time.Time),
+	}
+}
+
+// Issue returns a new random state to pass to GetAuthURL. Call it when a sign-in starts, not when
+// rendering a page that merely offers one, as every state is held until it expires or is evicted.
+func (s *StateStore) Issue() (string, error) {
+	state, err := NewState()
+	if err != nil {
+		return "", err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+
+	var (
+		oldest       string
+		oldestExpiry time.Time
+	)
+
+	for issued, expiry := range s.states {
+		switch {
+		case now.After(expiry):
+			delete(s.states, issued)
+		case oldest == "" || expiry.Before(oldestExpiry):
+			oldest, oldestExpiry = issued, expiry
+		}
+	}
+
+	// Every state lives as long, so the one expiring first is the oldest
+	if len(s.states) >= MaxStates {
+		delete(s.states, oldest)
+	}
+
+	s.states[state] = now.Add(s.ttl)
+
+	return state, nil
+}
+
+// Consume reports whether state was issued by the store and has not expired, and invalidates it.
+func (s *StateStore) Consume(state string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	expiry, ok := s.states[state]
+	if !ok {
+		return false
+	}
+
+	delete(s.states, state)
+
+	return !time.Now().After(expiry)
+}
+
+// NewState returns a cryptographically random OAuth state value.
+func NewState() (string, error) {
+	buf := make([]byte, stateBytes)
+	if _, err := rand.Read(buf); err != nil {
+		return "", fmt.Errorf("failed to generate OAuth state: %w", err)
+	}
+
+	return base64.RawURLEncoding.EncodeToString(buf), nil
+}
@@ -0,0 +1,57 @@
+package auth
+
+import (
+	"testing"
+	"time"
+)
+
+func TestStateStoreConsumesOnce(t *testing.T) {
+	store := NewStateStore(StateTTL)
+
+	state, err := store.Issue()
+	if err != nil {
+		t.Fatalf("Issue: %v", err)
+	}
+
+	if !store.Consume(state) {
+		t.Fatal("issued state was rejected")
+	}
+
+	if store.Consume(state) {
+		t.Error("state was accepted twice")
+	}
+
+	if store.Consume("forged") {
+		t.Error("a state the store never issued was accepted")
+	}
+}
+
+func TestStateStoreEvictsOldest(t *testing.T) {
+	store := NewStateStore(StateTTL)
+
+	issued := make([]string, 0, MaxStates+1)
+
+	for range MaxStates + 1 {
+		state, err := store.Issue()
+		if err != nil {
+			t.Fatalf("Issue: %v", err)
+		}
+
+		issued = append(issued, state)
+
+		// Distinct expiries make the oldest state unambiguous
+		time.Sleep(time.Microsecond)
+	}
+
+	if len(store.states) != MaxStates {
+		t.Errorf("store holds %d states, want at most %d", len(store.states), MaxStates)
+	}
+
+	if store.Consume(issued[0]) {
+		t.Error("the oldest state was not evicted")
+	}
+
+	if !store.Consume(issued[MaxStates]) || !store.Consume(issued[1]) {
+		t.Error("a newer state was evicted")
+	}
+}
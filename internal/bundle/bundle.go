@@ -0,0 +1,451 @@
+// Package bundle packages the complete application state into a single archive for moving it
+// between machines, and restores it with validation.
+package bundle
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"PostedIn/internal/config"
+	"PostedIn/internal/models"
+)
+
+// SchemaVersion is the version of the bundle layout written by Export. Import accepts bundles up to it.
+const SchemaVersion = 1
+
+const (
+	manifestName = "manifest.json"
+	maxFileSize  = 64 << 20 // Larger entries are rejected rather than read into memory
+	filePerm     = 0o600
+	dirPerm      = 0o700
+)
+
+// Names of the state files inside a bundle.
+const (
+	ConfigFile       = "config.json"
+	TokenFile        = "token.json"
+	PostsFile        = "posts.json"
+	AssetCacheFile   = "asset_cache.json"
+	SheetsCursorFile = "sheets_cursor.json"
+)
+
+// secretFiles are encrypted when the bundle is exported with a passphrase.
+var secretFiles = map[string]bool{
+	ConfigFile: true,
+	TokenFile:  true,
+}
+
+// ErrPassphraseRequired is returned when importing a bundle with encrypted secrets without a passphrase.
+var ErrPassphraseRequired = errors.New("bundle secrets are encrypted, a passphrase is required")
+
+// Manifest describes the contents of a bundle.
+type Manifest struct {
+	SchemaVersion int       `json:"schema_version"`
+	CreatedAt     time.Time `json:"created_at"`
+	Files         []File    `json:"files"`
+	// Salt and Iterations derive the key of encrypted files from the passphrase.
+	Salt       []byte `json:"salt,omitempty"`
+	Iterations int    `json:"iterations,omitempty"`
+}
+
+// File is a state file stored in a bundle.
+type File struct {
+	Name      string `json:"name"`
+	SHA256    string `json:"sha256"` // Of the plain contents
+	Encrypted bool   `json:"encrypted,omitempty"`
+}
+
+// Paths locates the state files on a machine.
+type Paths struct {
+	Config       string
+	Token        string
+	Posts        string
+	AssetCache   string
+	SheetsCursor string
+}
+
+// PathsFor returns where the app keeps its state under cfg, given the config and posts files in use.
+func PathsFor(cfg *config.Config, configPath, postsFile string) Paths {
+	assetCache, _ := cfg.Storage.AssetCache()
+
+	token := cfg.Storage.TokenFile
+	if token == "" {
+		token = config.TokenFile
+	}
+
+	return Paths{
+		Config:       configPath,
+		Token:        token,
+		Posts:        postsFile,
+		AssetCache:   assetCache,
+		SheetsCursor: cfg.Sheets.Cursor(),
+	}
+}
+
+// byName maps bundle file names to their paths on this machine.
+func (p Paths) byName() map[string]string {
+	return map[string]string{
+		ConfigFile:       p.Config,
+		TokenFile:        p.Token,
+		PostsFile:        p.Posts,
+		AssetCacheFile:   p.AssetCache,
+		SheetsCursorFile: p.SheetsCursor,
+	}
+}
+
+// bundleOrder is the order files are written in, so bundles of the same state are laid out alike.
+var bundleOrder = []string{ConfigFile, TokenFile, PostsFile, AssetCacheFile, SheetsCursorFile}
+
+// Export writes the state files found at paths to w as a gzipped tar archive. The config is required;
+// other files are skipped when missing. A non-empty passphrase encrypts the config and token, which hold the secrets.
+func Export(w io.Writer, paths Paths, passphrase string) (Manifest, error) {
+	manifest := Manifest{SchemaVersion: SchemaVersion, CreatedAt: time.Now().UTC()}
+
+	var key []byte
+
+	if passphrase != "" {
+		var err error
+
+		manifest.Salt, manifest.Iterations, key, err = newKey(passphrase)
+		if err != nil {
+			return Manifest{}, err
+		}
+	}
+
+	contents := make(map[string][]byte)
+	locations := paths.byName()
+
+	for _, name := range bundleOrder {
+		data, err := os.ReadFile(locations[name])
+		if errors.Is(err, os.ErrNotExist) && name != ConfigFile {
+			continue
+		}
+
+		if err != nil {
+			return Manifest{}, fmt.Errorf("failed to read %s: %w", locations[name], err)
+		}
+
+		file := File{Name: name, SHA256: checksum(data)}
+
+		if key != nil && secretFiles[name] {
+			data, err = encrypt(key, data)
+			if err != nil {
+				return Manifest{}, fmt.Errorf("failed to encrypt %s: %w", name, err)
+			}
+
+			file.Encrypted = true
+		}
+
+		contents[name] = data
+		manifest.Files = append(manifest.Files, file)
+	}
+
+	if err := writeArchive(w, manifest, contents); err != nil {
+		return Manifest{}, err
+	}
+
+	return manifest, nil
+}
+
+// writeArchive writes the manifest followed by the files it lists.
+func writeArchive(w io.Writer, manifest Manifest, contents map[string][]byte) error {
+	manifestData, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal manifest: %w", err)
+	}
+
+	gz := gzip.NewWriter(w)
+	tw := tar.NewWriter(gz)
+
+	writeEntry := func(name string, data []byte) error {
+		header := &tar.Header{
+			Name:    name,
+			Mode:    filePerm,
+			Size:    int64(len(data)),
+			ModTime: manifest.CreatedAt,
+		}
+
+		if err := tw.WriteHeader(header); err != nil {
+			return fmt.Errorf("failed to write %s: %w", name, err)
+		}
+
+		if _, err := tw.Write(data); err != nil {
+			return fmt.Errorf("failed to write %s: %w", name, err)
+		}
+
+		return nil
+	}
+
+	if err := writeEntry(manifestName, manifestData); err != nil {
+		return err
+	}
+
+	for _, file := range manifest.Files {
+		if err := writeEntry(file.Name, contents[file.Name]); err != nil {
+			return err
+		}
+	}
+
+	if err := tw.Close(); err != nil {
+		return fmt.Errorf("failed to finish bundle: %w", err)
+	}
+
+	if err := gz.Close(); err != nil {
+		return fmt.Errorf("failed to finish bundle: %w", err)
+	}
+
+	return nil
+}
+
+// ImportOptions controls how a bundle is restored.
+type ImportOptions struct {
+	ConfigPath string // Where to restore the config
+	PostsFile  string // Where to restore the posts
+	Passphrase string // Decrypts the secrets of an encrypted bundle
+	Force      bool   // Overwrite existing state files
+}
+
+// Import validates the bundle read from r and restores its files. The token, asset cache and
+// sheets cursor are restored to the paths the bundled config names. Nothing is written unless every
+// file passes validation, and existing files are only replaced with Force.
+func Import(r io.Reader, opts ImportOptions) (Manifest, error) {
+	manifest, contents, err := readArchive(r, opts.Passphrase)
+	if err != nil {
+		return Manifest{}, err
+	}
+
+	cfg, err := validate(contents)
+	if err != nil {
+		return Manifest{}, err
+	}
+
+	locations := PathsFor(cfg, opts.ConfigPath, opts.PostsFile).byName()
+
+	if !opts.Force {
+		var existing []string
+
+		for _, file := range manifest.Files {
+			if _, err := os.Stat(locations[file.Name]); err == nil {
+				existing = append(existing, locations[file.Name])
+			}
+		}
+
+		if len(existing) > 0 {
+			return Manifest{}, fmt.Errorf("state files already exist (%s); import with --force to replace them", strings.Join(existing, ", "))
+		}
+	}
+
+	for _, file := range manifest.Files {
+		if err := writeFile(locations[file.Name], contents[file.Name]); err != nil {
+			return Manifest{}, err
+		}
+	}
+
+	return manifest, nil
+}
+
+// readArchive reads the manifest and the files it lists, decrypting and verifying each one.
+func readArchive(r io.Reader, passphrase string) (Manifest, map[string][]byte, error) {
+	gz, err := gzip.NewReader(r)
+	if err != nil {
+		return Manifest{}, nil, fmt.Errorf("not a bundle: %w", err)
+	}
+
+	defer func() { _ = gz.Close() }()
+
+	entries := make(map[string][]byte)
+	tr := tar.NewReader(gz)
+
+	for {
+		header, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		if err != nil {
+			return Manifest{}, nil, fmt.Errorf("failed to read bundle: %w", err)
+		}
+
+		if header.Typeflag != tar.TypeReg {
+			continue
+		}
+
+		if header.Size > maxFileSize {
+			return Manifest{}, nil, fmt.Errorf("bundle entry %s is too large (%d bytes)", header.Name, header.Size)
+		}
+
+		data, err := io.ReadAll(io.LimitReader(tr, maxFileSize))
+		if err != nil {
+			return Manifest{}, nil, fmt.Errorf("failed to read %s from bundle: %w", header.Name, err)
+		}
+
+		entries[header.Name] = data
+	}
+
+	manifestData, ok := entries[manifestName]
+	if !ok {
+		return Manifest{}, nil, fmt.Errorf("bundle has no %s", manifestName)
+	}
+
+	var manifest Manifest
+	if err := json.Unmarshal(manifestData, &manifest); err != nil {
+		return Manifest{}, nil, fmt.Errorf("failed to parse %s: %w", manifestName, err)
+	}
+
+	switch {
+	case manifest.SchemaVersion < 1:
+		return Manifest{}, nil, fmt.Errorf("bundle has no schema version")
+	case manifest.SchemaVersion > SchemaVersion:
+		return Manifest{}, nil, fmt.Errorf("bundle schema version %d is newer than the supported version %d; upgrade PostedIn to import it",
+			manifest.SchemaVersion, SchemaVersion)
+	}
+
+	var key []byte
+
+	contents := make(map[string][]byte, len(manifest.Files))
+
+	for _, file := range manifest.Files {
+		if !slices.Contains(bundleOrder, file.Name) {
+			return Manifest{}, nil, fmt.Errorf("bundle lists unknown file %q", file.Name)
+		}
+
+		data, ok := entries[file.Name]
+		if !ok {
+			return Manifest{}, nil, fmt.Errorf("bundle is missing %s", file.Name)
+		}
+
+		if file.Encrypted {
+			if key == nil {
+				if passphrase == "" {
+					return Manifest{}, nil, ErrPassphraseRequired
+				}
+
+				key, err = deriveKey(passphrase, manifest.Salt, manifest.Iterations)
+				if err != nil {
+					return Manifest{}, nil, err
+				}
+			}
+
+			data, err = decrypt(key, data)
+			if err != nil {
+				return Manifest{}, nil, fmt.Errorf("failed to decrypt %s (wrong passphrase?): %w", file.Name, err)
+			}
+		}
+
+		if checksum(data) != file.SHA256 {
+			return Manifest{}, nil, fmt.Errorf("%s does not match its checksum; the bundle is corrupt", file.Name)
+		}
+
+		contents[file.Name] = data
+	}
+
+	return manifest, contents, nil
+}
+
+// validate checks that every bundled file parses and returns the bundled config.
+func validate(contents map[string][]byte) (*config.Config, error) {
+	data, ok := contents[ConfigFile]
+	if !ok {
+		return nil, fmt.Errorf("bundle has no %s", ConfigFile)
+	}
+
+	var cfg config.Config
+	if err := json.Unmarshal(data, &cfg); err != nil {
+		return nil, fmt.Errorf("failed to parse bundled %s: %w", ConfigFile, err)
+	}
+
+	if err := cfg.Validate("bundled " + ConfigFile); err != nil {
+		return nil, err
+	}
+
+	if data, ok := contents[TokenFile]; ok {
+		if _, err := config.ParseToken(data); err != nil {
+			return nil, fmt.Errorf("failed to parse bundled %s: %w", TokenFile, err)
+		}
+	}
+
+	if data, ok := contents[PostsFile]; ok {
+		if err := validatePosts(data); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, name := range []string{AssetCacheFile, SheetsCursorFile} {
+		if data, ok := contents[name]; ok && !json.Valid(data) {
+			return nil, fmt.Errorf("bundled %s is not valid JSON", name)
+		}
+	}
+
+	return &cfg, nil
+}
+
+// validatePosts checks that the posts parse and have unique IDs.
+func validatePosts(data []byte) error {
+	var posts []models.Post
+	if err := json.Unmarshal(data, &posts); err != nil {
+		return fmt.Errorf("failed to parse bundled %s: %w", PostsFile, err)
+	}
+
+	seen := make(map[int]bool, len(posts))
+
+	for _, post := range posts {
+		if seen[post.ID] {
+			return fmt.Errorf("bundled %s has duplicate post ID %d", PostsFile, post.ID)
+		}
+
+		seen[post.ID] = true
+	}
+
+	return nil
+}
+
+// writeFile writes data to path through a temporary file, so a failed import never leaves a truncated file.
+func writeFile(path string, data []byte) error {
+	if err := os.MkdirAll(filepath.Dir(path), dirPerm); err != nil {
+		return fmt.Errorf("failed to create directory for %s: %w", path, err)
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".bundle-*")
+	if err != nil {
+		return fmt.Errorf("failed to create temp file for %s: %w", path, err)
+	}
+
+	tmpName := tmp.Name()
+
+	defer func() {
+		_ = os.Remove(tmpName) // No-op once renamed
+	}()
+
+	if _, err := tmp.Write(data); err != nil {
+		_ = tmp.Close() // Write error takes precedence
+		return fmt.Errorf("failed to write %s: %w", path, err)
+	}
+
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("failed to write %s: %w", path, err)
+	}
+
+	if err := os.Chmod(tmpName, filePerm); err != nil {
+		return fmt.Errorf("failed to set permissions of %s: %w", path, err)
+	}
+
+	return os.Rename(tmpName, path)
+}
+
+// checksum returns the hex SHA-256 of data.
+func checksum(data []byte) string {
+	sum := sha256.Sum256(data)
+
+	return hex.EncodeToString(sum[:])
+}
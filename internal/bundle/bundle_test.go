@@ -0,0 +1,248 @@
+package bundle
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"io"
+	"os"
+	"strings"
+	"testing"
+	"time"
+)
+
+// state is a complete set of state files, keyed by bundle file name. The config keeps the other
+// files next to it, so the same relative paths are valid on both machines.
+var state = map[string]string{
+	ConfigFile: `{
+		"linkedin": {"client_id": "id", "client_secret": "very-secret"},
+		"timezone": {"location": "UTC"},
+		"storage": {"token_file": "token.json", "asset_cache_file": "cache/assets.json"},
+		"sheets": {"cursor_file": "cursor.json"}
+	}`,
+	TokenFile:        `{"access_token": "secret-token", "token_type": "Bearer"}`,
+	PostsFile:        `[{"id": 1, "content": "hello", "status": "scheduled"}, {"id": 2, "content": "world", "status": "posted"}]`,
+	AssetCacheFile:   `{"entries": {}}`,
+	SheetsCursorFile: `{"range": "Posts!A:B", "row": 4}`,
+}
+
+// statePaths maps bundle file names to the paths state uses relative to the working directory.
+var statePaths = map[string]string{
+	ConfigFile:       "config.json",
+	TokenFile:        "token.json",
+	PostsFile:        "posts.json",
+	AssetCacheFile:   "cache/assets.json",
+	SheetsCursorFile: "cursor.json",
+}
+
+// exportState writes state into a fresh working directory and exports it.
+func exportState(t *testing.T, passphrase string) []byte {
+	t.Helper()
+
+	t.Chdir(t.TempDir())
+
+	for name, data := range state {
+		if err := writeFile(statePaths[name], []byte(data)); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	paths := Paths{Config: "config.json", Token: "token.json", Posts: "posts.json", AssetCache: "cache/assets.json", SheetsCursor: "cursor.json"}
+
+	var buf bytes.Buffer
+
+	manifest, err := Export(&buf, paths, passphrase)
+	if err != nil {
+		t.Fatalf("Export: %v", err)
+	}
+
+	if manifest.SchemaVersion != SchemaVersion || len(manifest.Files) != len(state) {
+		t.Fatalf("manifest = %+v, want every state file at version %d", manifest, SchemaVersion)
+	}
+
+	return buf.Bytes()
+}
+
+// importOptions restores into a fresh working directory.
+func importOptions(t *testing.T) ImportOptions {
+	t.Helper()
+
+	t.Chdir(t.TempDir())
+
+	return ImportOptions{ConfigPath: "config.json", PostsFile: "posts.json"}
+}
+
+func assertState(t *testing.T) {
+	t.Helper()
+
+	for name, want := range state {
+		got, err := os.ReadFile(statePaths[name])
+		if err != nil {
+			t.Errorf("%s was not restored: %v", name, err)
+			continue
+		}
+
+		if string(got) != want {
+			t.Errorf("%s = %s, want %s", name, got, want)
+		}
+	}
+}
+
+func TestRoundTrip(t *testing.T) {
+	data := exportState(t, "")
+
+	if _, err := Import(bytes.NewReader(data), importOptions(t)); err != nil {
+		t.Fatalf("Import: %v", err)
+	}
+
+	assertState(t)
+}
+
+func TestRoundTripEncrypted(t *testing.T) {
+	data := exportState(t, "correct horse")
+
+	// The secrets are not readable from the archive
+	if entries := readEntries(t, data); strings.Contains(string(entries[ConfigFile]), "very-secret") || strings.Contains(string(entries[TokenFile]), "secret-token") {
+		t.Fatal("secrets are stored in plain text in an encrypted bundle")
+	}
+
+	opts := importOptions(t)
+
+	if _, err := Import(bytes.NewReader(data), opts); !errors.Is(err, ErrPassphraseRequired) {
+		t.Fatalf("Import without passphrase = %v, want ErrPassphraseRequired", err)
+	}
+
+	opts.Passphrase = "wrong"
+	if _, err := Import(bytes.NewReader(data), opts); err == nil {
+		t.Fatal("Import accepted a wrong passphrase")
+	}
+
+	if _, err := os.Stat("config.json"); !os.IsNotExist(err) {
+		t.Fatalf("a failed import wrote the config (stat: %v)", err)
+	}
+
+	opts.Passphrase = "correct horse"
+	if _, err := Import(bytes.NewReader(data), opts); err != nil {
+		t.Fatalf("Import: %v", err)
+	}
+
+	assertState(t)
+}
+
+func TestImportKeepsExistingState(t *testing.T) {
+	data := exportState(t, "")
+	opts := importOptions(t)
+
+	if err := writeFile("posts.json", []byte("[]")); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := Import(bytes.NewReader(data), opts); err == nil || !strings.Contains(err.Error(), "--force") {
+		t.Fatalf("Import over existing state = %v, want it refused", err)
+	}
+
+	if got, _ := os.ReadFile("posts.json"); string(got) != "[]" {
+		t.Fatalf("posts.json = %s, want it untouched", got)
+	}
+
+	opts.Force = true
+	if _, err := Import(bytes.NewReader(data), opts); err != nil {
+		t.Fatalf("Import with force: %v", err)
+	}
+
+	assertState(t)
+}
+
+func TestImportRejectsInvalidBundles(t *testing.T) {
+	valid := map[string][]byte{ConfigFile: []byte(state[ConfigFile])}
+
+	tests := []struct {
+		name     string
+		manifest Manifest
+		contents map[string][]byte
+		want     string
+	}{
+		{
+			name:     "newer schema",
+			manifest: Manifest{SchemaVersion: SchemaVersion + 1, Files: []File{{Name: ConfigFile, SHA256: checksum(valid[ConfigFile])}}},
+			contents: valid,
+			want:     "newer than the supported version",
+		},
+		{
+			name:     "corrupt file",
+			manifest: Manifest{SchemaVersion: SchemaVersion, Files: []File{{Name: ConfigFile, SHA256: checksum([]byte("other"))}}},
+			contents: valid,
+			want:     "checksum",
+		},
+		{
+			name:     "unknown file",
+			manifest: Manifest{SchemaVersion: SchemaVersion, Files: []File{{Name: "../evil", SHA256: checksum(nil)}}},
+			contents: map[string][]byte{"../evil": nil},
+			want:     "unknown file",
+		},
+		{
+			name: "duplicate post IDs",
+			manifest: Manifest{SchemaVersion: SchemaVersion, Files: []File{
+				{Name: ConfigFile, SHA256: checksum(valid[ConfigFile])},
+				{Name: PostsFile, SHA256: checksum([]byte(`[{"id": 1}, {"id": 1}]`))},
+			}},
+			contents: map[string][]byte{ConfigFile: valid[ConfigFile], PostsFile: []byte(`[{"id": 1}, {"id": 1}]`)},
+			want:     "duplicate post ID",
+		},
+		{
+			name:     "config without credentials",
+			manifest: Manifest{SchemaVersion: SchemaVersion, Files: []File{{Name: ConfigFile, SHA256: checksum([]byte(`{}`))}}},
+			contents: map[string][]byte{ConfigFile: []byte(`{}`)},
+			want:     "client_id",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+
+			tt.manifest.CreatedAt = time.Now().UTC()
+			if err := writeArchive(&buf, tt.manifest, tt.contents); err != nil {
+				t.Fatal(err)
+			}
+
+			_, err := Import(&buf, importOptions(t))
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Fatalf("Import = %v, want an error mentioning %q", err, tt.want)
+			}
+
+			if entries, _ := os.ReadDir("."); len(entries) != 0 {
+				t.Errorf("a rejected bundle wrote %d files", len(entries))
+			}
+		})
+	}
+}
+
+// readEntries returns the raw entries of a bundle archive.
+func readEntries(t *testing.T, data []byte) map[string][]byte {
+	t.Helper()
+
+	gz, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	entries := make(map[string][]byte)
+	tr := tar.NewReader(gz)
+
+	for {
+		header, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			return entries
+		}
+
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if entries[header.Name], err = io.ReadAll(tr); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
@@ -0,0 +1,85 @@
+package bundle
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/pbkdf2"
+	"crypto/rand"
+	"crypto/sha256"
+	"fmt"
+)
+
+const (
+	keyIterations = 600_000 // OWASP's recommendation for PBKDF2-HMAC-SHA256
+	keyLength     = 32      // AES-256
+	saltLength    = 16
+)
+
+// newKey derives an encryption key from the passphrase with a fresh random salt.
+func newKey(passphrase string) ([]byte, int, []byte, error) {
+	salt := make([]byte, saltLength)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, 0, nil, fmt.Errorf("failed to generate salt: %w", err)
+	}
+
+	key, err := deriveKey(passphrase, salt, keyIterations)
+	if err != nil {
+		return nil, 0, nil, err
+	}
+
+	return salt, keyIterations, key, nil
+}
+
+// deriveKey derives the encryption key of a bundle from the passphrase and the manifest's salt and iterations.
+func deriveKey(passphrase string, salt []byte, iterations int) ([]byte, error) {
+	if len(salt) == 0 || iterations <= 0 {
+		return nil, fmt.Errorf("bundle manifest has no key derivation parameters")
+	}
+
+	key, err := pbkdf2.Key(sha256.New, passphrase, salt, iterations, keyLength)
+	if err != nil {
+		return nil, fmt.Errorf("failed to derive key: %w", err)
+	}
+
+	return key, nil
+}
+
+// encrypt seals data with AES-GCM, prefixing the random nonce.
+func encrypt(key, data []byte) ([]byte, error) {
+	gcm, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, fmt.Errorf("failed to generate nonce: %w", err)
+	}
+
+	return gcm.Seal(nonce, nonce, data, nil), nil
+}
+
+// decrypt opens data sealed by encrypt.
+func decrypt(key, data []byte) ([]byte, error) {
+	gcm, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(data) < gcm.NonceSize() {
+		return nil, fmt.Errorf("encrypted data is truncated")
+	}
+
+	nonce, sealed := data[:gcm.NonceSize()], data[gcm.NonceSize():]
+
+	return gcm.Open(nil, nonce, sealed, nil)
+}
+
+func newGCM(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create cipher: %w", err)
+	}
+
+	return cipher.NewGCM(block)
+}
@@ -0,0 +1,174 @@
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"PostedIn/internal/bundle"
+	"PostedIn/internal/config"
+)
+
+// bundlePassphraseEnv holds the passphrase encrypting a bundle's secrets, kept out of the command line and shell history.
+const bundlePassphraseEnv = "POSTEDIN_BUNDLE_PASSPHRASE"
+
+func runBundleCommand(args []string) int {
+	if len(args) == 0 {
+		printUsage()
+		return 2
+	}
+
+	switch args[0] {
+	case "export":
+		return runBundleExport(args[1:])
+	case "import":
+		return runBundleImport(args[1:])
+	default:
+		printUsage()
+		return 2
+	}
+}
+
+func runBundleExport(args []string) int {
+	out, encrypt := "", false
+
+	for i := 0; i < len(args); i++ {
+		switch {
+		case args[i] == "--out" && i+1 < len(args):
+			i++
+			out = args[i]
+		case args[i] == "--encrypt":
+			encrypt = true
+		default:
+			printUsage()
+			return 2
+		}
+	}
+
+	if out == "" {
+		printUsage()
+		return 2
+	}
+
+	passphrase := os.Getenv(bundlePassphraseEnv)
+	if encrypt && passphrase == "" {
+		fmt.Fprintf(os.Stderr, "❌ --encrypt needs the passphrase in $%s\n", bundlePassphraseEnv)
+		return 1
+	}
+
+	if !encrypt {
+		passphrase = ""
+	}
+
+	cfg, err := config.LoadConfig()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "❌ %v\n", err)
+		return 1
+	}
+
+	f, err := os.OpenFile(filepath.Clean(out), os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0o600)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "❌ %v\n", err)
+		return 1
+	}
+
+	manifest, err := bundle.Export(f, bundle.PathsFor(cfg, config.ConfigPath(), cfg.Storage.PostsPath()), passphrase)
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+
+	if err != nil {
+		_ = os.Remove(out) // Do not leave a partial bundle behind
+		fmt.Fprintf(os.Stderr, "❌ %v\n", err)
+
+		return 1
+	}
+
+	fmt.Printf("✅ Exported %s to %s (schema version %d)\n", bundleFileNames(manifest), out, manifest.SchemaVersion)
+
+	if !encrypt {
+		fmt.Println("⚠️ The bundle holds your LinkedIn secrets and token in plain text; keep it private or use --encrypt")
+	}
+
+	return 0
+}
+
+func runBundleImport(args []string) int {
+	var file string
+
+	opts := bundle.ImportOptions{
+		ConfigPath: config.ConfigPath(),
+		PostsFile:  bundlePostsFile(),
+		Passphrase: os.Getenv(bundlePassphraseEnv),
+	}
+
+	for _, arg := range args {
+		switch {
+		case arg == "--force":
+			opts.Force = true
+		case file == "" && !strings.HasPrefix(arg, "--"):
+			file = arg
+		default:
+			printUsage()
+			return 2
+		}
+	}
+
+	if file == "" {
+		printUsage()
+		return 2
+	}
+
+	f, err := os.Open(filepath.Clean(file))
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "❌ %v\n", err)
+		return 1
+	}
+
+	defer func() {
+		_ = f.Close()
+	}()
+
+	manifest, err := bundle.Import(f, opts)
+	if errors.Is(err, bundle.ErrPassphraseRequired) {
+		fmt.Fprintf(os.Stderr, "❌ %v; set $%s\n", err, bundlePassphraseEnv)
+		return 1
+	}
+
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "❌ %v\n", err)
+		return 1
+	}
+
+	fmt.Printf("✅ Restored %s from a bundle created %s\n", bundleFileNames(manifest), manifest.CreatedAt.Local().Format("2006-01-02 15:04 MST"))
+
+	return 0
+}
+
+// bundleFileNames lists the files of a bundle for display.
+func bundleFileNames(manifest bundle.Manifest) string {
+	names := make([]string, 0, len(manifest.Files))
+	for _, file := range manifest.Files {
+		names = append(names, file.Name)
+	}
+
+	return strings.Join(names, ", ")
+}
+
+// bundlePostsFile returns where an imported bundle's posts go: the configured posts file when a
+// config exists, otherwise $POSTEDIN_POSTS_FILE or the default.
+func bundlePostsFile() string {
+	if _, err := os.Stat(config.ConfigPath()); err == nil {
+		if cfg, err := config.LoadConfig(); err == nil {
+			return cfg.Storage.PostsPath()
+		}
+	}
+
+	if path := os.Getenv(config.PostsFileEnv); path != "" {
+		return path
+	}
+
+	return config.DefaultPostsFile
+}
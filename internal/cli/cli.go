@@ -4,8 +4,10 @@ package cli
 import (
 	"bufio"
 	"context"
+	"errors"
 	"fmt"
 	"os"
+	"path/filepath"
 	"strconv"
 	"strings"
 	"time"
@@ -14,8 +16,11 @@ import (
 	"PostedIn/internal/config"
 	"PostedIn/internal/cron"
 	"PostedIn/internal/debug"
+	"PostedIn/internal/importer"
 	"PostedIn/internal/models"
 	"PostedIn/internal/scheduler"
+	"PostedIn/internal/transform"
+	"PostedIn/pkg/linkedin"
 )
 
 const (
@@ -28,14 +33,21 @@ const (
 type CLI struct {
 	scheduler     *scheduler.Scheduler
 	cronScheduler *cron.Scheduler
+	registry      *cron.Registry
 	reader        *bufio.Reader
 }
 
 // NewCLI creates a new command-line interface instance.
 func NewCLI(scheduler *scheduler.Scheduler, cronScheduler *cron.Scheduler) *CLI {
+	registry := cron.NewRegistry()
+	if cronScheduler != nil {
+		registry.Register(cron.DefaultStore, cronScheduler)
+	}
+
 	return &CLI{
 		scheduler:     scheduler,
 		cronScheduler: cronScheduler,
+		registry:      registry,
 		reader:        bufio.NewReader(os.Stdin),
 	}
 }
@@ -47,7 +59,7 @@ func (c *CLI) Run() {
 
 	for {
 		c.showMenu()
-		choice := c.getInput("Select an option (1-11): ")
+		choice := c.getInput("Select an option (1-17): ")
 
 		switch choice {
 		case "1":
@@ -71,11 +83,23 @@ func (c *CLI) Run() {
 		case "10":
 			c.showCronStatus()
 		case "11":
+			c.showDashboard()
+		case "12":
+			c.editPost()
+		case "13":
+			c.retryFailedPost()
+		case "14":
+			c.importPostsCSV()
+		case "15":
+			c.exportPosts()
+		case "16":
+			c.searchPosts()
+		case "17":
 			fmt.Println("Goodbye!")
 			c.cleanupAndExit()
 			return
 		default:
-			fmt.Println("Invalid option. Please select 1-11.")
+			fmt.Println("Invalid option. Please select 1-17.")
 		}
 	}
 }
@@ -106,7 +130,21 @@ func (c *CLI) showMenu() {
 	fmt.Println("8. Debug LinkedIn authentication")
 	fmt.Printf("9. Configure timezone (%s)\n", timezoneDisplay)
 	fmt.Println("10. Check auto-scheduler status")
-	fmt.Println("11. Exit")
+	fmt.Println("11. Today dashboard")
+	fmt.Println("12. Edit a post")
+	fmt.Println("13. Retry a failed post")
+	fmt.Println("14. Import posts from CSV")
+	fmt.Println("15. Export posts to CSV or JSON")
+	fmt.Println("16. Search posts")
+	fmt.Println("17. Exit")
+
+	if c.scheduler.IsPaused() {
+		fmt.Println("⏸️ Publishing is PAUSED (run 'config set paused false' and restart to resume)")
+	}
+
+	if c.scheduler.IsDryRun() {
+		fmt.Println("🧪 DRY RUN: publishes are logged, not sent to LinkedIn (run 'config set dry_run false' and restart to publish for real)")
+	}
 
 	// Show cron status if running
 	if c.cronScheduler != nil && c.cronScheduler.IsRunning() {
@@ -137,27 +175,100 @@ func (c *CLI) schedulePost() {
 		return
 	}
 
-	dateStr := c.getInput("Enter date (YYYY-MM-DD): ")
-	timeStr := c.getInput("Enter time (HH:MM): ")
-
-	scheduledAt, err := cfg.ParseTimeInTimezone(dateStr, timeStr)
-	if err != nil {
-		fmt.Println("Invalid date/time format. Please use YYYY-MM-DD and HH:MM")
+	if err := linkedin.ValidateContent(content); err != nil {
+		fmt.Printf("❌ %v\n", err)
 		return
 	}
 
-	// Check against timezone-aware current time
-	now, err := cfg.Now()
-	if err != nil {
-		now = time.Now()
+	printContentTags(content)
+
+	post := models.Post{
+		Content:  content,
+		PostType: models.PostTypeText,
 	}
 
-	if scheduledAt.Before(now) {
-		fmt.Println("Cannot schedule posts in the past.")
+	response := strings.ToLower(c.getInput("Attach a poll to this post? (y/N): "))
+	if response == "y" || response == "yes" {
+		post.Poll = c.readPoll()
+		if post.Poll == nil {
+			return
+		}
+
+		post.PostType = models.PostTypePoll
+	} else {
+		response = strings.ToLower(c.getInput("Attach an image from a local file? (y/N): "))
+		if response == "y" || response == "yes" {
+			post.ImagePath = c.getInput("Image file path: ")
+			if _, err := linkedin.ValidateImageFile(post.ImagePath); err != nil {
+				fmt.Printf("❌ Invalid image: %v\n", err)
+				return
+			}
+
+			post.ImageAltText = c.getInput("Alt text (optional): ")
+		} else if !c.readVideo(&post) {
+			return
+		}
+	}
+
+	response = strings.ToLower(c.getInput("Add content in other languages? (y/N): "))
+	if response == "y" || response == "yes" {
+		c.readVariants(&post, cfg)
+	}
+
+	if len(cfg.Accounts) > 0 {
+		if account := c.readAccount(cfg); account != config.DefaultAccount {
+			post.Account = account
+		}
+	}
+
+	if account, err := cfg.ForAccount(post.Account); err == nil && account.LinkedIn.OrganizationURN != "" {
+		response = strings.ToLower(c.getInput(fmt.Sprintf("Post as the company page %s? (y/N): ", account.LinkedIn.OrganizationURN)))
+		if response == "y" || response == "yes" {
+			post.AuthorType = models.AuthorOrganization
+		}
+	}
+
+	if visibility := c.getInput(fmt.Sprintf("Visibility (%s; empty for the default): ", strings.Join(linkedin.Visibilities, ", "))); visibility != "" {
+		normalized, err := linkedin.NormalizeVisibility(visibility)
+		if err != nil {
+			fmt.Printf("❌ %v\n", err)
+			return
+		}
+
+		post.Visibility = normalized
+	}
+
+	response = strings.ToLower(c.getInput("Save as a draft without scheduling it? (y/N): "))
+	if response == "y" || response == "yes" {
+		if _, err := c.scheduler.AddDraft(post, cfg); err != nil {
+			fmt.Printf("Error saving draft: %v\n", err)
+			return
+		}
+
+		fmt.Println("📝 Draft saved. Schedule it later with 'Edit a post'.")
+
 		return
 	}
 
-	err = c.scheduler.AddPost(content, scheduledAt, cfg)
+	publishNow := false
+
+	response = strings.ToLower(c.getInput("Schedule relative to another post's publish time? (y/N): "))
+	if response == "y" || response == "yes" {
+		if !c.readDependency(&post) {
+			return
+		}
+	} else {
+		scheduledAt, immediate, ok := c.readScheduleTime(cfg)
+		if !ok {
+			return
+		}
+
+		post.ScheduledAt = scheduledAt
+		publishNow = immediate
+		post.Recurrence = c.getInput("Repeat (daily, weekly, monthly or a cron expression; empty for once): ")
+	}
+
+	created, err := c.scheduler.Add(post, cfg)
 	if err != nil {
 		fmt.Printf("Error scheduling post: %v\n", err)
 		return
@@ -165,6 +276,22 @@ func (c *CLI) schedulePost() {
 
 	fmt.Println("✅ Post scheduled successfully!")
 
+	if warning := scheduler.TokenExpiryWarning(created.ScheduledAt, created.Account, cfg); warning != "" {
+		fmt.Printf("⚠️ %s\n", warning)
+	}
+
+	if warning := c.scheduler.DuplicateWarning(created.Content, cfg); warning != "" {
+		fmt.Printf("⚠️ Possible repost: %s\n", warning)
+	}
+
+	if publishNow {
+		if err := c.scheduler.PublishToLinkedIn(context.Background(), created.ID, cfg); err != nil {
+			fmt.Printf("Failed to publish: %v\n", err)
+		}
+
+		return
+	}
+
 	// Auto-start cron scheduler if not already running
 	c.ensureCronRunning()
 
@@ -193,6 +320,181 @@ func (c *CLI) schedulePost() {
 	}
 }
 
+// printContentTags echoes the hashtags and mentions found in content and warns about malformed ones.
+func printContentTags(content string) {
+	tags := linkedin.ScanContent(content)
+
+	if len(tags.Hashtags) > 0 {
+		fmt.Printf("Hashtags: %s\n", strings.Join(tags.Hashtags, " "))
+	}
+
+	if len(tags.Mentions) > 0 {
+		fmt.Printf("Mentions: %s\n", strings.Join(tags.Mentions, ", "))
+	}
+
+	for _, warning := range tags.Warnings {
+		fmt.Printf("⚠️ %s\n", warning)
+	}
+}
+
+// readVideo offers to attach a local MP4 and validates it; it returns false if the video is invalid.
+func (c *CLI) readVideo(post *models.Post) bool {
+	response := strings.ToLower(c.getInput("Attach a video (MP4) from a local file? (y/N): "))
+	if response != "y" && response != "yes" {
+		return true
+	}
+
+	post.VideoPath = c.getInput("Video file path: ")
+
+	duration, err := linkedin.ValidateVideoFile(post.VideoPath)
+	if err != nil {
+		fmt.Printf("❌ Invalid video: %v\n", err)
+		return false
+	}
+
+	fmt.Printf("🎬 Video length: %v\n", duration.Round(time.Second))
+
+	post.VideoTitle = c.getInput("Video title (optional): ")
+	post.PostType = models.PostTypeVideo
+
+	return true
+}
+
+// readScheduleTime prompts for a date and time and rejects times in the past. A time inside
+// the minimum lead time is rejected unless the user confirms publishing immediately instead.
+func (c *CLI) readScheduleTime(cfg *config.Config) (scheduledAt time.Time, publishNow, ok bool) {
+	dateStr := c.getInput("Enter date (YYYY-MM-DD): ")
+	timeStr := c.getInput("Enter time (HH:MM): ")
+
+	scheduledAt, err := cfg.ParseTimeInTimezone(dateStr, timeStr)
+	if err != nil {
+		fmt.Println("Invalid date/time format. Please use YYYY-MM-DD and HH:MM")
+		return time.Time{}, false, false
+	}
+
+	// Check against timezone-aware current time
+	now, err := cfg.Now()
+	if err != nil {
+		now = time.Now()
+	}
+
+	if scheduledAt.Before(now) {
+		fmt.Println("Cannot schedule posts in the past.")
+		return time.Time{}, false, false
+	}
+
+	if err := scheduler.CheckLeadTime(scheduledAt, cfg); err != nil {
+		fmt.Printf("❌ %v\n", err)
+
+		response := strings.ToLower(c.getInput("Publish immediately instead? (y/N): "))
+		if response != "y" && response != "yes" {
+			return time.Time{}, false, false
+		}
+
+		return now, true, true
+	}
+
+	return scheduledAt, false, true
+}
+
+// readDependency prompts for the post to follow and the delay after it publishes.
+func (c *CLI) readDependency(post *models.Post) bool {
+	id, err := strconv.Atoi(c.getInput("Publish after post ID: "))
+	if err != nil || id <= 0 {
+		fmt.Println("Invalid post ID.")
+		return false
+	}
+
+	offset := 0
+
+	offsetStr := c.getInput("Minutes after that post publishes (default 0): ")
+	if offsetStr != "" {
+		offset, err = strconv.Atoi(offsetStr)
+		if err != nil || offset < 0 {
+			fmt.Println("Invalid offset.")
+			return false
+		}
+	}
+
+	post.DependsOn = id
+	post.OffsetMinutes = offset
+
+	return true
+}
+
+// readVariants prompts for the primary language, translated variants and which language to publish.
+func (c *CLI) readVariants(post *models.Post, cfg *config.Config) {
+	defaultLanguage := cfg.Content.DefaultLanguage
+	if defaultLanguage == "" {
+		defaultLanguage = transform.DefaultLanguage
+	}
+
+	post.Language = c.getInput(fmt.Sprintf("Language of the content above (default %s): ", defaultLanguage))
+	post.Variants = make(map[string]string)
+
+	for {
+		lang := c.getInput("Variant language code (leave empty to finish): ")
+		if lang == "" {
+			break
+		}
+
+		post.Variants[lang] = c.getInput(fmt.Sprintf("Content for %s: ", lang))
+	}
+
+	post.TargetLanguage = c.getInput(fmt.Sprintf("Publish which language? (code, '%s' for every variant, empty for primary): ", transform.AllLanguages))
+}
+
+// readPoll prompts for a poll question, its options and duration, validating the result.
+func (c *CLI) readPoll() *models.Poll {
+	question := c.getInput("Enter poll question: ")
+
+	fmt.Printf("Enter %d-%d options (leave empty to finish):\n", linkedin.MinPollOptions, linkedin.MaxPollOptions)
+
+	var options []string
+
+	for len(options) < linkedin.MaxPollOptions {
+		option := c.getInput(fmt.Sprintf("Option %d: ", len(options)+1))
+		if option == "" {
+			break
+		}
+
+		options = append(options, option)
+	}
+
+	fmt.Println("Poll duration:")
+
+	for i, d := range linkedin.PollDurations {
+		fmt.Printf("%d. %s\n", i+1, d)
+	}
+
+	duration := linkedin.DefaultPollDuration
+
+	choiceStr := c.getInput(fmt.Sprintf("Select a duration (default %s): ", linkedin.DefaultPollDuration))
+	if choiceStr != "" {
+		choice, err := strconv.Atoi(choiceStr)
+		if err != nil || choice < 1 || choice > len(linkedin.PollDurations) {
+			fmt.Println("❌ Invalid duration selection.")
+			return nil
+		}
+
+		duration = linkedin.PollDurations[choice-1]
+	}
+
+	if err := linkedin.ValidatePoll(question, options, duration); err != nil {
+		fmt.Printf("❌ Invalid poll: %v\n", err)
+		return nil
+	}
+
+	fmt.Println("Results follow-up, posted when the poll closes; use {question}, {results}, {winner} and {voters}.")
+
+	return &models.Poll{
+		Question:        question,
+		Options:         options,
+		Duration:        duration,
+		ResultsTemplate: c.getInput("Results template (leave empty for no follow-up): "),
+	}
+}
+
 func (c *CLI) listPosts() {
 	cfg, err := config.LoadConfig()
 	if err != nil {
@@ -200,18 +502,13 @@ func (c *CLI) listPosts() {
 		return
 	}
 
-	posts := c.scheduler.GetPosts()
-	if len(posts) == 0 {
+	// Read posts, in-flight publishes and the time together so labels reflect one moment
+	snapshot := c.scheduler.Snapshot(cfg)
+	if len(snapshot.Posts) == 0 {
 		fmt.Println("No posts scheduled.")
 		return
 	}
 
-	// Get timezone-aware current time
-	now, err := cfg.Now()
-	if err != nil {
-		now = time.Now()
-	}
-
 	// Get timezone for display
 	loc, err := cfg.GetTimezone()
 	if err != nil {
@@ -220,20 +517,135 @@ func (c *CLI) listPosts() {
 
 	fmt.Println("\nScheduled Posts:")
 	fmt.Println("================")
+	c.printPosts(snapshot, snapshot.Posts, loc, cfg)
+}
+
+// printPosts prints the details of each post, labelled from the snapshot it was read with.
+func (c *CLI) printPosts(snapshot scheduler.Snapshot, posts []models.Post, loc *time.Location, cfg *config.Config) {
 	for _, post := range posts {
-		status := post.Status
-		if post.Status == statusScheduled && !post.ScheduledAt.After(now) {
-			status = "ready to post"
+		status := snapshot.Label(post)
+
+		switch {
+		case post.Status == models.StatusWaiting:
+			fmt.Printf("ID: %d | Status: %s | Scheduled: %d min after post %d publishes\n",
+				post.ID, status, post.OffsetMinutes, post.DependsOn)
+		case post.ScheduledAt.IsZero():
+			fmt.Printf("ID: %d | Status: %s | Scheduled: not yet\n", post.ID, status)
+		default:
+			fmt.Printf("ID: %d | Status: %s | Scheduled: %s\n",
+				post.ID, status, post.ScheduledAt.In(loc).Format("2006-01-02 15:04 MST"))
+		}
+		if post.Status == statusScheduled {
+			if err := scheduler.VerifyScheduleIntegrity(post, cfg); err != nil {
+				fmt.Printf("⚠️ Schedule check: %v\n", err)
+			}
 		}
-
-		fmt.Printf("ID: %d | Status: %s | Scheduled: %s\n",
-			post.ID, status, post.ScheduledAt.In(loc).Format("2006-01-02 15:04 MST"))
 		const maxContentLength = 80
 		fmt.Printf("Content: %s\n", c.truncateString(post.Content, maxContentLength))
+		if post.IsPoll() {
+			fmt.Printf("Poll: %s [%s]\n", post.Poll.Question, strings.Join(post.Poll.Options, " / "))
+		}
+		if post.ResultsOf != 0 {
+			fmt.Printf("Results of poll: %d\n", post.ResultsOf)
+		}
+		if post.ImagePath != "" {
+			fmt.Printf("Image: %s\n", post.ImagePath)
+		}
+		if post.IsVideo() {
+			fmt.Printf("Video: %s\n", post.VideoPath)
+		}
+		if post.Audience != "" {
+			fmt.Printf("Audience: %s\n", post.Audience)
+		}
+		if post.Account != "" {
+			fmt.Printf("Account: %s\n", post.Account)
+		}
+		if post.IsOrganization() {
+			fmt.Printf("Author: %s\n", post.OrganizationURN)
+		}
+		if post.Visibility != "" {
+			fmt.Printf("Visibility: %s\n", post.Visibility)
+		}
+
+		if len(post.Tags) > 0 {
+			fmt.Printf("Tags: %s\n", strings.Join(post.Tags, ", "))
+		}
+
+		if post.Event != "" {
+			fmt.Printf("Event: %s (%+d min)\n", post.Event, post.EventOffsetMinutes)
+		}
+		if post.Recurrence != "" {
+			fmt.Printf("Repeats: %s\n", post.Recurrence)
+		}
+		if len(post.Variants) > 0 {
+			target := post.TargetLanguage
+			if target == "" {
+				target = post.Language
+			}
+			fmt.Printf("Languages: %s + %d variant(s), publishing: %s\n", post.Language, len(post.Variants), target)
+		}
+		// A single successful attempt is the norm and not worth a line
+		if post.Attempts > 1 || (post.Attempts == 1 && post.Status != models.StatusPosted) {
+			fmt.Printf("Attempts: %d\n", post.Attempts)
+		}
+		if post.LastError != "" {
+			fmt.Printf("Last error: %s\n", post.LastError)
+		}
 		fmt.Println("---")
 	}
 }
 
+func (c *CLI) searchPosts() {
+	cfg, err := config.LoadConfig()
+	if err != nil {
+		fmt.Printf("Error loading config: %v\n", err)
+		return
+	}
+
+	loc, err := cfg.GetTimezone()
+	if err != nil {
+		loc = time.UTC
+	}
+
+	filter := scheduler.PostFilter{
+		Search: c.getInput("Text to search for (leave empty for any): "),
+		Status: strings.ToLower(c.getInput("Status (scheduled, draft, waiting, needs_review, posted, failed; empty for any): ")),
+	}
+
+	// The date range covers whole days in the configured timezone
+	if from := c.getInput("From date (YYYY-MM-DD, empty for no limit): "); from != "" {
+		day, err := time.ParseInLocation("2006-01-02", from, loc)
+		if err != nil {
+			fmt.Println("Invalid date format. Please use YYYY-MM-DD")
+			return
+		}
+
+		filter.From = day
+	}
+
+	if to := c.getInput("To date (YYYY-MM-DD, empty for no limit): "); to != "" {
+		day, err := time.ParseInLocation("2006-01-02", to, loc)
+		if err != nil {
+			fmt.Println("Invalid date format. Please use YYYY-MM-DD")
+			return
+		}
+
+		filter.To = day.AddDate(0, 0, 1).Add(-time.Nanosecond)
+	}
+
+	snapshot := c.scheduler.Snapshot(cfg)
+
+	matched := filter.Apply(snapshot.Posts)
+	if len(matched) == 0 {
+		fmt.Println("No posts match.")
+		return
+	}
+
+	fmt.Printf("\n%d matching post(s):\n", len(matched))
+	fmt.Println("================")
+	c.printPosts(snapshot, matched, loc, cfg)
+}
+
 func (c *CLI) checkDuePosts() {
 	cfg, err := config.LoadConfig()
 	if err != nil {
@@ -255,7 +667,7 @@ func (c *CLI) checkDuePosts() {
 		response = strings.ToLower(response)
 
 		if response == "y" || response == "yes" {
-			err := c.scheduler.MarkAsPosted(post.ID)
+			_, err := c.scheduler.MarkAsPosted(post.ID)
 			if err != nil {
 				fmt.Printf("Error marking post as posted: %v\n", err)
 			} else {
@@ -265,18 +677,270 @@ func (c *CLI) checkDuePosts() {
 	}
 }
 
+// showDashboard summarizes overdue, due, upcoming and failed posts plus auth status on one screen,
+// then offers quick actions.
+func (c *CLI) showDashboard() {
+	cfg, err := config.LoadConfig()
+	if err != nil {
+		fmt.Printf("Error loading config: %v\n", err)
+		return
+	}
+
+	now, err := cfg.Now()
+	if err != nil {
+		now = time.Now()
+	}
+
+	dashboard := scheduler.BuildDashboard(c.scheduler.GetPosts(), now)
+
+	fmt.Printf("\n📋 Today (%s)\n", now.Format("Mon 2006-01-02 15:04 MST"))
+	fmt.Println("====================")
+
+	c.printDashboardGroup("🔴 Overdue", dashboard.Overdue, now.Location())
+	c.printDashboardGroup("🟠 Due now", dashboard.DueNow, now.Location())
+	c.printDashboardGroup("🟢 Next 24 hours", dashboard.Upcoming, now.Location())
+	c.printDashboardGroup("❌ Failed or needs review", dashboard.Attention, now.Location())
+
+	token, err := config.LoadToken(cfg.Storage.TokenFile)
+	switch {
+	case err != nil || token == nil:
+		fmt.Println("\n🔑 LinkedIn: not authenticated (option 5)")
+	case token.RefreshToken == "" && !token.Expiry.IsZero() && token.Expiry.Before(now):
+		fmt.Println("\n🔑 LinkedIn: token expired, re-authenticate (option 5)")
+	case !token.Expiry.IsZero():
+		fmt.Printf("\n🔑 LinkedIn: authenticated (token expires %s)\n", token.Expiry.In(now.Location()).Format("2006-01-02 15:04 MST"))
+	default:
+		fmt.Println("\n🔑 LinkedIn: authenticated")
+	}
+
+	if c.scheduler.IsPaused() {
+		fmt.Println("⏸️ Publishing is PAUSED")
+	}
+
+	pending := len(dashboard.Overdue) + len(dashboard.DueNow)
+	if pending == 0 {
+		return
+	}
+
+	response := strings.ToLower(c.getInput(fmt.Sprintf("\nPublish the %d overdue/due post(s) now? (y/N): ", pending)))
+	if response == "y" || response == "yes" {
+		c.autoPublishDue()
+	}
+}
+
+// printDashboardGroup prints one dashboard section with a short line per post.
+func (c *CLI) printDashboardGroup(title string, posts []models.Post, loc *time.Location) {
+	const maxPreviewLength = 50
+
+	fmt.Printf("\n%s (%d)\n", title, len(posts))
+
+	for _, post := range posts {
+		when := "waiting on post " + strconv.Itoa(post.DependsOn)
+		if !post.ScheduledAt.IsZero() {
+			when = post.ScheduledAt.In(loc).Format("01-02 15:04")
+		}
+
+		fmt.Printf("  #%d %s [%s] %s\n", post.ID, when, post.Status, c.truncateString(post.Content, maxPreviewLength))
+	}
+}
+
 func (c *CLI) deletePost() {
-	idStr := c.getInput("Enter post ID to delete: ")
+	fmt.Println("\nDelete Posts")
+	fmt.Println("============")
+	fmt.Println("Enter one or more post IDs to delete:")
+	fmt.Println("- Single post: 5")
+	fmt.Println("- Multiple posts: 1,3,5 or 1 3 5")
+	fmt.Println()
+
+	ids, err := parseIDs(c.getInput("Enter post ID(s): "))
+	if err != nil {
+		fmt.Printf("Invalid ID format: %v\n", err)
+		return
+	}
 
-	id, err := strconv.Atoi(idStr)
+	if len(ids) == 1 {
+		if err := c.scheduler.DeletePost(ids[0]); err != nil {
+			fmt.Printf("Error: %v\n", err)
+			return
+		}
+
+		c.removeTimers(ids)
+
+		return
+	}
+
+	fmt.Printf("You are about to delete %d posts with IDs: %v\n", len(ids), ids)
+
+	response := strings.ToLower(c.getInput("Are you sure? (y/N): "))
+	if response != "y" && response != "yes" {
+		fmt.Println("Deletion cancelled.")
+		return
+	}
+
+	// Posts that were found are deleted even when others were not
+	err = c.scheduler.DeleteMultiplePosts(ids)
+	if !errors.Is(err, scheduler.ErrWaitingDependents) {
+		c.removeTimers(ids)
+	}
+
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return
+	}
+
+	fmt.Printf("✅ Successfully deleted %d post(s).\n", len(ids))
+}
+
+// removeTimers stops the auto-publish timers of deleted posts so they cannot fire.
+func (c *CLI) removeTimers(ids []int) {
+	if c.cronScheduler != nil {
+		c.cronScheduler.RemovePostTimers(ids)
+	}
+}
+
+// parseIDs parses post IDs separated by commas and/or spaces.
+func parseIDs(input string) ([]int, error) {
+	fields := strings.FieldsFunc(input, func(r rune) bool { return r == ',' || r == ' ' })
+	if len(fields) == 0 {
+		return nil, fmt.Errorf("no post IDs given")
+	}
+
+	ids := make([]int, 0, len(fields))
+
+	for _, field := range fields {
+		id, err := strconv.Atoi(field)
+		if err != nil || id <= 0 {
+			return nil, fmt.Errorf("%q is not a post ID", field)
+		}
+
+		ids = append(ids, id)
+	}
+
+	return ids, nil
+}
+
+// editPost overwrites the content and/or scheduled time of a post that has not been published yet
+// and re-arms its timer when the time changed.
+func (c *CLI) editPost() {
+	cfg, err := config.LoadConfig()
+	if err != nil {
+		fmt.Printf("Error loading config: %v\n", err)
+		return
+	}
+
+	id, err := strconv.Atoi(c.getInput("Enter post ID to edit: "))
 	if err != nil {
 		fmt.Println("Invalid ID format.")
 		return
 	}
 
-	err = c.scheduler.DeletePost(id)
+	var post *models.Post
+
+	for _, p := range c.scheduler.GetPosts() {
+		if p.ID == id {
+			post = &p
+			break
+		}
+	}
+
+	if post == nil {
+		fmt.Printf("Post %d not found.\n", id)
+		return
+	}
+
+	if post.Status == statusPosted {
+		fmt.Printf("Post %d is already posted and cannot be edited.\n", id)
+		return
+	}
+
+	loc, err := cfg.GetTimezone()
 	if err != nil {
-		fmt.Printf("Error: %v\n", err)
+		loc = time.UTC
+	}
+
+	fmt.Printf("Current content: %s\n", post.Content)
+
+	switch {
+	case post.Status == models.StatusWaiting:
+		fmt.Printf("Scheduled: %d min after post %d publishes\n", post.OffsetMinutes, post.DependsOn)
+	case post.ScheduledAt.IsZero():
+		fmt.Println("Scheduled: not yet")
+	default:
+		fmt.Printf("Scheduled: %s\n", post.ScheduledAt.In(loc).Format("2006-01-02 15:04 MST"))
+	}
+
+	content := c.getInput("New content (leave empty to keep): ")
+	if err := linkedin.ValidateContent(content); err != nil {
+		fmt.Printf("❌ %v\n", err)
+		return
+	}
+
+	if content != "" {
+		printContentTags(content)
+	}
+
+	var (
+		scheduledAt time.Time
+		publishNow  bool
+	)
+
+	draft := post.Status == models.StatusDraft
+
+	// A waiting post gets its time from the post it depends on
+	if post.Status != models.StatusWaiting {
+		prompt := "Change the scheduled time? (y/N): "
+		if draft {
+			prompt = "Schedule this draft for publishing? (y/N): "
+		}
+
+		response := strings.ToLower(c.getInput(prompt))
+		if response == "y" || response == "yes" {
+			var ok bool
+
+			scheduledAt, publishNow, ok = c.readScheduleTime(cfg)
+			if !ok {
+				return
+			}
+		}
+	}
+
+	if content == "" && scheduledAt.IsZero() {
+		fmt.Println("Nothing changed.")
+		return
+	}
+
+	updated, err := c.scheduler.UpdatePost(id, content, scheduledAt, cfg)
+	if err != nil {
+		fmt.Printf("Error updating post: %v\n", err)
+		return
+	}
+
+	// Giving a draft a time schedules it
+	if draft && !scheduledAt.IsZero() {
+		updated, err = c.scheduler.ScheduleDraft(id, scheduledAt, cfg)
+		if err != nil {
+			fmt.Printf("Error scheduling draft: %v\n", err)
+			return
+		}
+	}
+
+	fmt.Printf("✅ Post %d updated\n", id)
+
+	if publishNow {
+		if err := c.scheduler.PublishToLinkedIn(context.Background(), id, cfg); err != nil {
+			fmt.Printf("Failed to publish: %v\n", err)
+		}
+
+		return
+	}
+
+	// Replace the timer armed for the old time so the new time takes effect
+	if !scheduledAt.IsZero() && updated.Status == statusScheduled && c.cronScheduler != nil && c.cronScheduler.IsRunning() {
+		c.cronScheduler.RemovePostTimers([]int{id})
+
+		if err := c.cronScheduler.AddNewPost(&updated); err != nil {
+			fmt.Printf("⚠️ Failed to reschedule the auto-publish timer: %v\n", err)
+		}
 	}
 }
 
@@ -288,7 +952,17 @@ func (c *CLI) authenticateLinkedIn() {
 		return
 	}
 
-	authServer := auth.NewServer(cfg)
+	account := config.DefaultAccount
+	if len(cfg.Accounts) > 0 {
+		account = c.readAccount(cfg)
+	}
+
+	authServer, err := auth.NewServerForAccount(cfg, account)
+	if err != nil {
+		fmt.Printf("❌ %v\n", err)
+		return
+	}
+
 	_, err = authServer.StartOAuth()
 	if err != nil {
 		fmt.Printf("Authentication failed: %v\n", err)
@@ -298,6 +972,17 @@ func (c *CLI) authenticateLinkedIn() {
 	fmt.Println("✅ Successfully authenticated with LinkedIn!")
 }
 
+// readAccount asks which configured LinkedIn account to use; an empty answer picks the default.
+func (c *CLI) readAccount(cfg *config.Config) string {
+	account := c.getInput(fmt.Sprintf("Account (%s; empty for %s): ",
+		strings.Join(cfg.AccountNames(), ", "), config.DefaultAccount))
+	if account == "" {
+		return config.DefaultAccount
+	}
+
+	return account
+}
+
 func (c *CLI) publishToLinkedIn() {
 	cfg, err := config.LoadConfig()
 	if err != nil {
@@ -320,6 +1005,148 @@ func (c *CLI) publishToLinkedIn() {
 	}
 }
 
+func (c *CLI) retryFailedPost() {
+	cfg, err := config.LoadConfig()
+	if err != nil {
+		fmt.Printf("Error loading config: %v\n", err)
+		return
+	}
+
+	id, err := strconv.Atoi(c.getInput("Enter ID of the failed post to retry: "))
+	if err != nil {
+		fmt.Println("Invalid ID format.")
+		return
+	}
+
+	err = c.scheduler.RetryPost(context.Background(), id, cfg)
+	if errors.Is(err, scheduler.ErrPublishDeferred) || errors.Is(err, scheduler.ErrPublishingPaused) {
+		fmt.Printf("⏳ Post %d is scheduled again but not published yet: %v\n", id, err)
+		return
+	}
+
+	if err != nil {
+		fmt.Printf("❌ Failed to retry post %d: %v\n", id, err)
+	}
+}
+
+func (c *CLI) importPostsCSV() {
+	cfg, err := config.LoadConfig()
+	if err != nil {
+		fmt.Printf("Error loading config: %v\n", err)
+		return
+	}
+
+	path := c.getInput("CSV file path: ")
+
+	format := c.getInput(fmt.Sprintf("Format (%s, default generic): ", strings.Join(importer.Formats(), ", ")))
+	if format == "" {
+		format = "generic"
+	}
+
+	mapper, err := importer.MapperFor(format)
+	if err != nil {
+		fmt.Printf("❌ %v\n", err)
+		return
+	}
+
+	loc, err := cfg.GetTimezone()
+	if err != nil {
+		loc = time.UTC
+	}
+
+	f, err := os.Open(filepath.Clean(path))
+	if err != nil {
+		fmt.Printf("❌ %v\n", err)
+		return
+	}
+
+	defer func() {
+		_ = f.Close()
+	}()
+
+	result, err := c.scheduler.ImportCSV(f, mapper, loc, cfg)
+	if err != nil {
+		fmt.Printf("❌ Import failed: %v\n", err)
+		return
+	}
+
+	printImportResult(result)
+
+	if len(result.Imported) == 0 {
+		return
+	}
+
+	c.ensureCronRunning()
+
+	if c.cronScheduler != nil && c.cronScheduler.IsRunning() {
+		for i := range result.Imported {
+			if err := c.cronScheduler.AddNewPost(&result.Imported[i]); err != nil {
+				fmt.Printf("⚠️ Failed to schedule cron job for post %d: %v\n", result.Imported[i].ID, err)
+			}
+		}
+	}
+}
+
+func (c *CLI) exportPosts() {
+	cfg, err := config.LoadConfig()
+	if err != nil {
+		fmt.Printf("Error loading config: %v\n", err)
+		return
+	}
+
+	format := strings.ToLower(c.getInput("Format (csv or json, default json): "))
+	if format == "" {
+		format = scheduler.ExportJSONFormat
+	}
+
+	if format != scheduler.ExportCSVFormat && format != scheduler.ExportJSONFormat {
+		fmt.Println("Invalid format. Please enter csv or json.")
+		return
+	}
+
+	loc, err := cfg.GetTimezone()
+	if err != nil {
+		loc = time.UTC
+	}
+
+	from := c.getInput("From date (YYYY-MM-DD, empty for no start): ")
+	to := c.getInput("To date (YYYY-MM-DD, empty for no end): ")
+
+	var by string
+	if from != "" || to != "" {
+		by = strings.ToLower(c.getInput("Filter on scheduled or published time (default scheduled): "))
+	}
+
+	rng, err := scheduler.ParseExportRange(from, to, by, loc)
+	if err != nil {
+		fmt.Printf("❌ %v\n", err)
+		return
+	}
+
+	path := c.getInput(fmt.Sprintf("Output file (default posts-export.%s): ", format))
+	if path == "" {
+		path = "posts-export." + format
+	}
+
+	f, err := os.Create(filepath.Clean(path))
+	if err != nil {
+		fmt.Printf("❌ %v\n", err)
+		return
+	}
+
+	exported, err := c.scheduler.Export(f, format, rng)
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+
+	if err != nil {
+		fmt.Printf("❌ Export failed: %v\n", err)
+		return
+	}
+
+	fmt.Printf("📤 Exported %d post(s) to %s\n", exported, path)
+}
+
 func (c *CLI) autoPublishDue() {
 	cfg, err := config.LoadConfig()
 	if err != nil {
@@ -327,22 +1154,38 @@ func (c *CLI) autoPublishDue() {
 		return
 	}
 
-	duePosts := c.scheduler.GetDuePosts(cfg)
+	duePosts, remaining := scheduler.LimitBatch(c.scheduler.GetDuePosts(cfg), cfg)
 	if len(duePosts) == 0 {
 		fmt.Println("No posts are due for publishing.")
 		return
 	}
 
-	fmt.Printf("Found %d posts ready to publish.\n", len(duePosts))
+	fmt.Printf("Found %d posts ready to publish.\n", len(duePosts)+len(remaining))
 
-	for _, post := range duePosts {
+	if len(remaining) > 0 {
+		fmt.Printf("Publishing %d now (cron.max_publish_per_run); %d will wait for the next run.\n", len(duePosts), len(remaining))
+	}
+
+	var lastErr error
+
+	for i, post := range duePosts {
 		const maxPreviewLength = 60
-		fmt.Printf("\nPublishing post %d: %s\n", post.ID, c.truncateString(post.Content, maxPreviewLength))
 
 		ctx := context.Background()
-		err := c.scheduler.PublishToLinkedIn(ctx, post.ID, cfg)
-		if err != nil {
-			fmt.Printf("❌ Failed to publish post %d: %v\n", post.ID, err)
+
+		// Space the publishes out, and stop when LinkedIn throttles for longer than the batch waits
+		if i > 0 {
+			if err := scheduler.WaitBetweenPublishes(ctx, lastErr, cfg); err != nil {
+				fmt.Printf("⏳ %v; %d post(s) wait for the next run\n", err, len(duePosts)-i)
+				break
+			}
+		}
+
+		fmt.Printf("\nPublishing post %d: %s\n", post.ID, c.truncateString(post.Content, maxPreviewLength))
+
+		lastErr = c.scheduler.PublishToLinkedIn(ctx, post.ID, cfg)
+		if lastErr != nil {
+			fmt.Printf("❌ Failed to publish post %d: %v\n", post.ID, lastErr)
 			continue
 		}
 
@@ -350,6 +1193,10 @@ func (c *CLI) autoPublishDue() {
 	}
 
 	fmt.Println("\nAuto-publish completed!")
+
+	if len(remaining) > 0 {
+		fmt.Printf("⏳ %d due post(s) deferred to the next run\n", len(remaining))
+	}
 }
 
 func (c *CLI) debugLinkedInAuth() {
@@ -610,6 +1457,14 @@ func (c *CLI) showCronStatus() {
 	}
 	fmt.Printf("Current time: %s\n", currentTime.Format("2006-01-02 15:04:05 MST"))
 
+	if next, ok := c.registry.Next(); ok {
+		fmt.Printf("Next to publish: post %d (%s) at %s\n", next.PostID, next.Store, next.At.Format("2006-01-02 15:04:05 MST"))
+
+		if next.Account != "" {
+			fmt.Printf("Next account: %s\n", next.Account)
+		}
+	}
+
 	if running, ok := status["running"].(bool); ok && running {
 		fmt.Printf("Active jobs: %v\n", status["entries"])
 
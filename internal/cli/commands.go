@@ -0,0 +1,648 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"PostedIn/internal/config"
+	"PostedIn/internal/cron"
+	"PostedIn/internal/debug"
+	"PostedIn/internal/email"
+	"PostedIn/internal/importer"
+	"PostedIn/internal/scheduler"
+	"PostedIn/internal/sheets"
+)
+
+// RunCommand executes a non-interactive subcommand and returns the process exit code.
+func RunCommand(args []string) int {
+	if len(args) == 0 {
+		printUsage()
+		return 2
+	}
+
+	switch args[0] {
+	case "config":
+		return runConfigCommand(args[1:])
+	case "sheets":
+		return runSheetsCommand(args[1:])
+	case "email":
+		return runEmailCommand(args[1:])
+	case "doctor":
+		return runDoctorCommand(args[1:])
+	case "import":
+		return runImportCommand(args[1:])
+	case "daemon":
+		return runDaemonCommand(args[1:])
+	case "apply-recipe":
+		return runApplyRecipeCommand(args[1:])
+	case "templates":
+		return runTemplatesCommand(args[1:])
+	case "best-of":
+		return runBestOfCommand(args[1:])
+	case "bundle":
+		return runBundleCommand(args[1:])
+	case "backup":
+		return runBackupCommand(args[1:])
+	case "restore":
+		return runRestoreCommand(args[1:])
+	case "help", "-h", "--help":
+		printUsage()
+		return 0
+	default:
+		fmt.Fprintf(os.Stderr, "Unknown command: %s\n", args[0])
+		printUsage()
+
+		return 2
+	}
+}
+
+func printUsage() {
+	fmt.Println("Usage: scheduler [--config <file>] [command]")
+	fmt.Println()
+	fmt.Println("Without a command the interactive menu is started.")
+	fmt.Println("The config file is --config, then $POSTEDIN_CONFIG, then ./internal/config/config.json.")
+	fmt.Println()
+	fmt.Println("Commands:")
+	fmt.Println("  config get <key>          Show a config value (secrets are masked)")
+	fmt.Println("  config set <key> <value>  Validate and save a config value")
+	fmt.Println("  config list               Show all config keys and values")
+	fmt.Println("  sheets sync               Import new rows from the configured Google Sheet")
+	fmt.Println("  email poll                Schedule posts from new emails in the configured IMAP mailbox")
+	fmt.Println("  doctor [--offline] [--fix-permissions]")
+	fmt.Println("                            Check configuration, LinkedIn app credentials, file permissions and scheduled posts")
+	fmt.Println("  import <file.csv> [--format buffer|hootsuite|generic] [--timezone <zone>]")
+	fmt.Println("                            Import posts from another scheduler's CSV export")
+	fmt.Println("  daemon [--log-file <file>] Run the auto-scheduler headless until SIGINT/SIGTERM (log file - is stderr)")
+	fmt.Println("  apply-recipe <name> [--var key=value]... [--at <time>]")
+	fmt.Println("                            Schedule a post from a recipe configured under recipes")
+	fmt.Println("  templates test [--var key=value]...")
+	fmt.Println("                            Render every recipe template with its sample_vars and report failures")
+	fmt.Println("  best-of [--refresh] [--schedule]")
+	fmt.Println("                            Suggest reposts of top performers; --refresh fetches analytics, --schedule adds them")
+	fmt.Println("  bundle export --out <file.tar.gz> [--encrypt]")
+	fmt.Println("                            Package config, token, posts and caches for another machine")
+	fmt.Println("  bundle import <file.tar.gz> [--force]")
+	fmt.Println("                            Validate and restore a bundle; --force replaces existing files")
+	fmt.Println("  backup [--out <file.json>] Write a snapshot of every post (default: stdout)")
+	fmt.Println("  restore <file.json>       Add the posts of a backup; nothing is restored if any post is invalid or its ID is in use")
+}
+
+func runConfigCommand(args []string) int {
+	if len(args) == 0 {
+		printUsage()
+		return 2
+	}
+
+	cfg, err := config.ReadConfig()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "❌ %v\n", err)
+		fmt.Fprintln(os.Stderr, "💡 Run the scheduler once to create a default config file")
+
+		return 1
+	}
+
+	switch {
+	case args[0] == "get" && len(args) == 2:
+		value, err := cfg.Get(args[1])
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "❌ %v\n", err)
+			return 1
+		}
+
+		fmt.Println(value)
+	case args[0] == "set" && len(args) >= 3:
+		key, value := args[1], strings.Join(args[2:], " ")
+
+		if err := cfg.Set(key, value); err != nil {
+			fmt.Fprintf(os.Stderr, "❌ %v\n", err)
+			return 1
+		}
+
+		if err := config.SaveConfig(cfg); err != nil {
+			fmt.Fprintf(os.Stderr, "❌ Failed to save config: %v\n", err)
+			return 1
+		}
+
+		saved, _ := cfg.Get(key)
+		fmt.Printf("✅ %s = %s\n", key, saved)
+	case args[0] == "list" && len(args) == 1:
+		for _, key := range config.Keys() {
+			value, _ := cfg.Get(key)
+			fmt.Printf("%s = %s\n", key, value)
+		}
+	default:
+		printUsage()
+		return 2
+	}
+
+	return 0
+}
+
+func runSheetsCommand(args []string) int {
+	if len(args) != 1 || args[0] != "sync" {
+		printUsage()
+		return 2
+	}
+
+	cfg, err := config.LoadConfig()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "❌ %v\n", err)
+		return 1
+	}
+
+	s, err := scheduler.NewSchedulerFromConfig(cfg)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "❌ %v\n", err)
+		return 1
+	}
+
+	syncer, err := sheets.NewSyncerFromConfig(cfg, s)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "❌ %v\n", err)
+		return 1
+	}
+
+	if syncer == nil {
+		fmt.Fprintln(os.Stderr, "❌ Google Sheets sync is disabled; set sheets.enabled to true")
+		return 1
+	}
+
+	created, err := syncer.Sync(context.Background())
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "❌ %v\n", err)
+		return 1
+	}
+
+	fmt.Printf("✅ Imported %d post(s) from Google Sheets\n", created)
+
+	return 0
+}
+
+func runEmailCommand(args []string) int {
+	if len(args) != 1 || args[0] != "poll" {
+		printUsage()
+		return 2
+	}
+
+	cfg, err := config.LoadConfig()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "❌ %v\n", err)
+		return 1
+	}
+
+	s, err := scheduler.NewSchedulerFromConfig(cfg)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "❌ %v\n", err)
+		return 1
+	}
+
+	poller, err := email.NewPollerFromConfig(cfg, s)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "❌ %v\n", err)
+		return 1
+	}
+
+	if poller == nil {
+		fmt.Fprintln(os.Stderr, "❌ Email ingestion is disabled; set email.enabled to true")
+		return 1
+	}
+
+	created, err := poller.Poll(context.Background())
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "❌ %v\n", err)
+		return 1
+	}
+
+	fmt.Printf("✅ Scheduled %d post(s) from email\n", created)
+
+	return 0
+}
+
+func runDoctorCommand(args []string) int {
+	opts := debug.Options{Online: true}
+
+	for _, arg := range args {
+		switch arg {
+		case "--offline":
+			opts.Online = false
+		case "--fix-permissions":
+			opts.FixPermissions = true
+		default:
+			fmt.Fprintf(os.Stderr, "❌ unknown doctor flag %q\n", arg)
+			return 1
+		}
+	}
+
+	cfg, err := config.LoadConfig()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "❌ %v\n", err)
+		return 1
+	}
+
+	opts.PostsFile = cfg.Storage.DataPath()
+
+	sched, err := scheduler.NewSchedulerFromConfig(cfg)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "❌ %v\n", err)
+		return 1
+	}
+
+	// A cron built from this config shows the location the auto-scheduler would run in
+	opts.CronTimezone = cron.NewScheduler(sched, cfg).CurrentTimezone()
+
+	if debug.RunDiagnostics(cfg, sched.GetPosts(), opts) > 0 {
+		return 1
+	}
+
+	return 0
+}
+
+func runImportCommand(args []string) int {
+	var file string
+
+	format, zone := "generic", ""
+
+	for i := 0; i < len(args); i++ {
+		switch {
+		case args[i] == "--format" && i+1 < len(args):
+			i++
+			format = args[i]
+		case args[i] == "--timezone" && i+1 < len(args):
+			i++
+			zone = args[i]
+		case file == "" && !strings.HasPrefix(args[i], "--"):
+			file = args[i]
+		default:
+			printUsage()
+			return 2
+		}
+	}
+
+	if file == "" {
+		printUsage()
+		return 2
+	}
+
+	mapper, err := importer.MapperFor(format)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "❌ %v\n", err)
+		return 2
+	}
+
+	cfg, err := config.LoadConfig()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "❌ %v\n", err)
+		return 1
+	}
+
+	// Times in the file are read in its own timezone, defaulting to the configured one
+	loc, err := cfg.GetTimezone()
+	if zone != "" {
+		loc, err = time.LoadLocation(zone)
+	}
+
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "❌ Invalid timezone: %v\n", err)
+		return 1
+	}
+
+	f, err := os.Open(filepath.Clean(file))
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "❌ %v\n", err)
+		return 1
+	}
+
+	defer func() {
+		_ = f.Close()
+	}()
+
+	s, err := scheduler.NewSchedulerFromConfig(cfg)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "❌ %v\n", err)
+		return 1
+	}
+
+	result, err := s.ImportCSV(f, mapper, loc, cfg)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "❌ %v\n", err)
+		return 1
+	}
+
+	printImportResult(result)
+
+	return 0
+}
+
+// printImportResult lists the scheduled posts and the rows that were skipped.
+func printImportResult(result scheduler.ImportResult) {
+	for _, post := range result.Imported {
+		fmt.Printf("✅ Post %d scheduled for %s\n", post.ID, post.ScheduledAt.Format("2006-01-02 15:04 MST"))
+	}
+
+	for _, skipped := range result.Skipped {
+		fmt.Printf("⚠️ Row %d not imported: %s\n", skipped.Row, skipped.Reason)
+	}
+
+	fmt.Printf("📥 Imported %d post(s), skipped %d row(s)\n", len(result.Imported), len(result.Skipped))
+}
+
+func runApplyRecipeCommand(args []string) int {
+	var name, at string
+
+	vars := make(map[string]string)
+
+	for i := 0; i < len(args); i++ {
+		switch {
+		case args[i] == "--var" && i+1 < len(args):
+			i++
+
+			key, value, ok := strings.Cut(args[i], "=")
+			if !ok || key == "" {
+				fmt.Fprintf(os.Stderr, "❌ --var must be key=value, got %q\n", args[i])
+				return 2
+			}
+
+			vars[key] = value
+		case args[i] == "--at" && i+1 < len(args):
+			i++
+			at = args[i]
+		case name == "" && !strings.HasPrefix(args[i], "--"):
+			name = args[i]
+		default:
+			printUsage()
+			return 2
+		}
+	}
+
+	if name == "" {
+		printUsage()
+		return 2
+	}
+
+	cfg, err := config.LoadConfig()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "❌ %v\n", err)
+		return 1
+	}
+
+	post, err := scheduler.BuildRecipePost(cfg, name, vars, at)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "❌ %v\n", err)
+		return 1
+	}
+
+	s, err := scheduler.NewSchedulerFromConfig(cfg)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "❌ %v\n", err)
+		return 1
+	}
+
+	added, err := s.Add(post, cfg)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "❌ Failed to add post: %v\n", err)
+		return 1
+	}
+
+	fmt.Printf("✅ Post %d scheduled for %s from recipe %q\n", added.ID, added.ScheduledAt.Format("2006-01-02 15:04 MST"), name)
+
+	if warning := s.DuplicateWarning(added.Content, cfg); warning != "" {
+		fmt.Printf("⚠️ Possible repost: %s\n", warning)
+	}
+
+	return 0
+}
+
+func runTemplatesCommand(args []string) int {
+	if len(args) == 0 || args[0] != "test" {
+		printUsage()
+		return 2
+	}
+
+	vars := make(map[string]string)
+
+	for i := 1; i < len(args); i++ {
+		if args[i] != "--var" || i+1 >= len(args) {
+			printUsage()
+			return 2
+		}
+
+		i++
+
+		key, value, ok := strings.Cut(args[i], "=")
+		if !ok || key == "" {
+			fmt.Fprintf(os.Stderr, "❌ --var must be key=value, got %q\n", args[i])
+			return 2
+		}
+
+		vars[key] = value
+	}
+
+	// Read without validation so a template that does not parse is reported instead of failing the load
+	cfg, err := config.ReadConfig()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "❌ %v\n", err)
+		return 1
+	}
+
+	results := scheduler.TestRecipes(cfg, vars)
+	if len(results) == 0 {
+		fmt.Println("ℹ️ No recipes configured")
+		return 0
+	}
+
+	failed := 0
+
+	for _, result := range results {
+		switch {
+		case result.Error != "":
+			failed++
+			fmt.Printf("❌ %s: %s\n", result.Name, result.Error)
+		case len(result.Unresolved) > 0:
+			failed++
+			fmt.Printf("❌ %s: unresolved variables %s\n", result.Name, strings.Join(result.Unresolved, ", "))
+		default:
+			fmt.Printf("✅ %s\n", result.Name)
+		}
+	}
+
+	fmt.Printf("🧪 %d of %d template(s) passed\n", len(results)-failed, len(results))
+
+	if failed > 0 {
+		return 1
+	}
+
+	return 0
+}
+
+func runBestOfCommand(args []string) int {
+	var refresh, schedule bool
+
+	for _, arg := range args {
+		switch arg {
+		case "--refresh":
+			refresh = true
+		case "--schedule":
+			schedule = true
+		default:
+			printUsage()
+			return 2
+		}
+	}
+
+	cfg, err := config.LoadConfig()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "❌ %v\n", err)
+		return 1
+	}
+
+	s, err := scheduler.NewSchedulerFromConfig(cfg)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "❌ %v\n", err)
+		return 1
+	}
+
+	if refresh {
+		updated, err := s.RefreshStats(context.Background(), cfg, scheduler.LinkedInStats(cfg))
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "⚠️ Failed to refresh some post analytics: %v\n", err)
+		}
+
+		fmt.Printf("📊 Refreshed analytics of %d post(s)\n", updated)
+	}
+
+	if schedule {
+		reposts, err := s.ScheduleBestOf(cfg)
+		for _, repost := range reposts {
+			fmt.Printf("✅ Post %d reposts post %d at %s\n", repost.ID, repost.RepostOf, repost.ScheduledAt.Format("2006-01-02 15:04 MST"))
+		}
+
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "❌ %v\n", err)
+			return 1
+		}
+
+		if len(reposts) == 0 {
+			fmt.Println("ℹ️ No repost scheduled: no eligible post or no open slot")
+		}
+
+		return 0
+	}
+
+	suggestions := s.SuggestBestOf(cfg)
+	if len(suggestions) == 0 {
+		fmt.Println("ℹ️ No post is eligible for a repost")
+		return 0
+	}
+
+	for _, suggestion := range suggestions {
+		slot := "no open slot"
+		if suggestion.SlotAt != nil {
+			slot = suggestion.SlotAt.Format("2006-01-02 15:04 MST")
+		}
+
+		fmt.Printf("🏆 Post %d (%s %d): %s\n", suggestion.Post.ID, cfg.BestOf.RankMetric(), suggestion.Score, slot)
+	}
+
+	return 0
+}
+
+func runBackupCommand(args []string) int {
+	var out string
+
+	for i := 0; i < len(args); i++ {
+		switch {
+		case args[i] == "--out" && i+1 < len(args):
+			i++
+			out = args[i]
+		default:
+			printUsage()
+			return 2
+		}
+	}
+
+	cfg, err := config.LoadConfig()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "❌ %v\n", err)
+		return 1
+	}
+
+	s, err := scheduler.NewSchedulerFromConfig(cfg)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "❌ %v\n", err)
+		return 1
+	}
+
+	if out == "" {
+		if err := s.Backup(os.Stdout, cfg); err != nil {
+			fmt.Fprintf(os.Stderr, "❌ %v\n", err)
+			return 1
+		}
+
+		return 0
+	}
+
+	f, err := os.OpenFile(filepath.Clean(out), os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0o600)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "❌ %v\n", err)
+		return 1
+	}
+
+	err = s.Backup(f, cfg)
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+
+	if err != nil {
+		_ = os.Remove(out) // Do not leave a partial backup behind
+		fmt.Fprintf(os.Stderr, "❌ %v\n", err)
+
+		return 1
+	}
+
+	fmt.Printf("✅ Backed up %d post(s) to %s\n", len(s.GetPosts()), out)
+
+	return 0
+}
+
+func runRestoreCommand(args []string) int {
+	if len(args) != 1 || strings.HasPrefix(args[0], "--") {
+		printUsage()
+		return 2
+	}
+
+	cfg, err := config.LoadConfig()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "❌ %v\n", err)
+		return 1
+	}
+
+	f, err := os.Open(filepath.Clean(args[0]))
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "❌ %v\n", err)
+		return 1
+	}
+
+	defer func() {
+		_ = f.Close()
+	}()
+
+	s, err := scheduler.NewSchedulerFromConfig(cfg)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "❌ %v\n", err)
+		return 1
+	}
+
+	restored, err := s.Restore(f, cfg)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "❌ %v\n", err)
+		return 1
+	}
+
+	fmt.Printf("✅ Restored %d post(s) from %s\n", len(restored), args[0])
+
+	return 0
+}
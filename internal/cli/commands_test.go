@@ -0,0 +1,56 @@
+package cli
+
+import (
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestBackupRestoreCommands(t *testing.T) {
+	useDaemonDir(t)
+
+	if code := RunCommand([]string{"backup", "--out", "backup.json"}); code != 0 {
+		t.Fatalf("backup exit code = %d", code)
+	}
+
+	want := loadStatuses(t)
+
+	if err := os.WriteFile("posts.json", []byte("[]"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	if code := RunCommand([]string{"restore", "backup.json"}); code != 0 {
+		t.Fatalf("restore exit code = %d", code)
+	}
+
+	got := loadStatuses(t)
+	if len(got) != len(want) || got[1] != want[1] || got[2] != want[2] {
+		t.Errorf("restored statuses = %v, want %v", got, want)
+	}
+
+	// A backup with an invalid post leaves the stored posts untouched
+	backup, err := os.ReadFile("backup.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	invalid := strings.Replace(string(backup), `"status": "scheduled"`, `"status": "queued"`, 1)
+	invalid = strings.Replace(invalid, `"id": 1,`, `"id": 7,`, 1)
+
+	if err := os.WriteFile("invalid.json", []byte(invalid), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	before, err := os.ReadFile("posts.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if code := RunCommand([]string{"restore", "invalid.json"}); code != 1 {
+		t.Errorf("invalid restore exit code = %d, want 1", code)
+	}
+
+	if after, _ := os.ReadFile("posts.json"); string(after) != string(before) {
+		t.Errorf("invalid restore changed the posts file:\n%s", after)
+	}
+}
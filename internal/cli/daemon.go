@@ -0,0 +1,120 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"syscall"
+
+	"PostedIn/internal/config"
+	"PostedIn/internal/cron"
+	"PostedIn/internal/models"
+	"PostedIn/internal/notify"
+	"PostedIn/internal/scheduler"
+)
+
+const (
+	defaultDaemonLogFile = "scheduler-daemon.log"
+	daemonLogPerm        = 0o600
+)
+
+func runDaemonCommand(args []string) int {
+	logFile := defaultDaemonLogFile
+
+	switch {
+	case len(args) == 0:
+	case len(args) == 2 && args[0] == "--log-file":
+		logFile = args[1]
+	default:
+		printUsage()
+		return 2
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	if err := runDaemon(ctx, logFile); err != nil {
+		fmt.Fprintf(os.Stderr, "❌ %v\n", err)
+		return 1
+	}
+
+	return 0
+}
+
+// runDaemon runs the auto-scheduler headless, without the menu or an HTTP server, until ctx is
+// cancelled. It logs to logFile ("-" for stderr), catches up posts that became overdue while it
+// was not running, then arms timers for every scheduled post and idles.
+func runDaemon(ctx context.Context, logFile string) error {
+	if logFile != "-" {
+		f, err := os.OpenFile(filepath.Clean(logFile), os.O_CREATE|os.O_WRONLY|os.O_APPEND, daemonLogPerm)
+		if err != nil {
+			return fmt.Errorf("failed to open log file: %w", err)
+		}
+
+		defer func() {
+			_ = f.Close()
+		}()
+
+		previous := log.Writer()
+		log.SetOutput(f)
+
+		defer log.SetOutput(previous)
+	}
+
+	cfg, err := config.LoadConfig()
+	if err != nil {
+		log.Printf("❌ Failed to load config: %v", err)
+		return err
+	}
+
+	if !cfg.Cron.Enabled {
+		log.Println("❌ cron.enabled is false, the daemon has nothing to run")
+		return fmt.Errorf("auto-scheduling is disabled; set cron.enabled to true")
+	}
+
+	log.Printf("🚀 Scheduler daemon starting (config %s, pid %d)", config.ConfigPath(), os.Getpid())
+
+	sched, err := scheduler.NewSchedulerFromConfig(cfg)
+	if err != nil {
+		log.Printf("❌ Failed to open post storage: %v", err)
+		return err
+	}
+
+	sched.LoadSwitches(cfg) // Honor the persisted kill switch before anything can publish
+
+	notifications := notify.NewQueueFromConfig(cfg)
+	if notifications != nil {
+		notifications.Start()
+		sched.OnPublished(func(post models.Post, err error) {
+			notifications.Enqueue(notify.NewPublishEvent(post, err))
+		})
+	}
+
+	cronScheduler := cron.NewScheduler(sched, cfg)
+	if err := cronScheduler.Start(); err != nil {
+		log.Printf("⚠️ Some posts could not be scheduled: %v", err)
+	}
+
+	// Reconcile posts whose scheduled time passed while the daemon was down, now that catch-up
+	// publishes are notified and the dependents they release can be armed
+	cronScheduler.CatchUp(ctx)
+
+	log.Println("✅ Scheduler daemon running, waiting for scheduled posts")
+
+	<-ctx.Done()
+
+	log.Println("🛑 Shutdown signal received, stopping auto-scheduler...")
+	cronScheduler.Stop()
+
+	if notifications != nil {
+		log.Println("🛑 Flushing notifications...")
+		notifications.Stop()
+	}
+
+	log.Println("✅ Scheduler daemon stopped gracefully")
+
+	return nil
+}
@@ -0,0 +1,218 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"syscall"
+	"testing"
+	"time"
+
+	"PostedIn/internal/config"
+	"PostedIn/internal/models"
+)
+
+// useDaemonDir runs the test in a temp directory holding a config with cron enabled, dry-run
+// publishing and the publish overdue policy, and a posts file with one overdue and one upcoming post.
+func useDaemonDir(t *testing.T) {
+	t.Helper()
+
+	t.Chdir(t.TempDir())
+
+	cfg := `{
+		"linkedin": {"client_id": "id", "client_secret": "secret"},
+		"timezone": {"location": "UTC"},
+		"storage": {"posts_file": "posts.json", "token_file": "token.json"},
+		"cron": {"enabled": true, "overdue_policy": "publish"},
+		"dry_run": true
+	}`
+	if err := os.WriteFile("config.json", []byte(cfg), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	config.SetConfigPath("config.json")
+	t.Cleanup(func() { config.SetConfigPath("") })
+
+	now := time.Now().UTC()
+	posts := []models.Post{
+		{ID: 1, Content: "missed while down", ScheduledAt: now.Add(-time.Hour), Status: models.StatusScheduled, PostType: models.PostTypeText},
+		{ID: 2, Content: "later today", ScheduledAt: now.Add(3 * time.Hour), Status: models.StatusScheduled, PostType: models.PostTypeText},
+	}
+
+	data, err := json.Marshal(posts)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := os.WriteFile("posts.json", data, 0o600); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// waitForLog waits until the log file contains want.
+func waitForLog(t *testing.T, logFile, want string) {
+	t.Helper()
+
+	deadline := time.Now().Add(10 * time.Second)
+	for time.Now().Before(deadline) {
+		if data, _ := os.ReadFile(logFile); strings.Contains(string(data), want) {
+			return
+		}
+
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	data, _ := os.ReadFile(logFile)
+	t.Fatalf("log never showed %q:\n%s", want, data)
+}
+
+func loadStatuses(t *testing.T) map[int]string {
+	t.Helper()
+
+	data, err := os.ReadFile("posts.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var posts []models.Post
+	if err := json.Unmarshal(data, &posts); err != nil {
+		t.Fatal(err)
+	}
+
+	statuses := make(map[int]string, len(posts))
+	for _, post := range posts {
+		statuses[post.ID] = post.Status
+	}
+
+	return statuses
+}
+
+func TestDaemonCatchesUpAndStops(t *testing.T) {
+	useDaemonDir(t)
+
+	logFile := filepath.Join(t.TempDir(), "daemon.log")
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+
+	go func() { done <- runDaemon(ctx, logFile) }()
+
+	waitForLog(t, logFile, "Scheduler daemon running")
+
+	statuses := loadStatuses(t)
+	if statuses[1] != models.StatusPosted {
+		t.Errorf("overdue post status = %q, want it published on startup", statuses[1])
+	}
+
+	if statuses[2] != models.StatusScheduled {
+		t.Errorf("upcoming post status = %q, want it left scheduled", statuses[2])
+	}
+
+	cancel()
+
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Fatalf("runDaemon: %v", err)
+		}
+	case <-time.After(10 * time.Second):
+		t.Fatal("runDaemon did not return after cancellation")
+	}
+
+	waitForLog(t, logFile, "Scheduler daemon stopped gracefully")
+}
+
+func TestDaemonStopsOnSignal(t *testing.T) {
+	useDaemonDir(t)
+
+	logFile := filepath.Join(t.TempDir(), "daemon.log")
+	done := make(chan int, 1)
+
+	go func() { done <- runDaemonCommand([]string{"--log-file", logFile}) }()
+
+	waitForLog(t, logFile, "Scheduler daemon running")
+
+	if err := syscall.Kill(os.Getpid(), syscall.SIGTERM); err != nil {
+		t.Fatal(err)
+	}
+
+	select {
+	case code := <-done:
+		if code != 0 {
+			t.Fatalf("exit code = %d, want 0", code)
+		}
+	case <-time.After(10 * time.Second):
+		t.Fatal("daemon did not stop on SIGTERM")
+	}
+
+	waitForLog(t, logFile, "Shutdown signal received")
+}
+
+func TestDaemonRequiresCron(t *testing.T) {
+	useDaemonDir(t)
+
+	cfg := `{"linkedin": {"client_id": "id", "client_secret": "secret"}, "timezone": {"location": "UTC"}, "cron": {"enabled": false}}`
+	if err := os.WriteFile("config.json", []byte(cfg), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := runDaemon(context.Background(), filepath.Join(t.TempDir(), "daemon.log")); err == nil {
+		t.Fatal("runDaemon started with cron disabled")
+	}
+}
+
+func TestDaemonNotifiesCatchUpPublish(t *testing.T) {
+	useDaemonDir(t)
+
+	var (
+		mu       sync.Mutex
+		received []string
+	)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+
+		mu.Lock()
+		received = append(received, string(body))
+		mu.Unlock()
+	}))
+	t.Cleanup(server.Close)
+
+	cfg := `{
+		"linkedin": {"client_id": "id", "client_secret": "secret"},
+		"timezone": {"location": "UTC"},
+		"storage": {"posts_file": "posts.json", "token_file": "token.json"},
+		"cron": {"enabled": true, "overdue_policy": "publish"},
+		"notifications": {"webhook_url": "` + server.URL + `"},
+		"dry_run": true
+	}`
+	if err := os.WriteFile("config.json", []byte(cfg), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	logFile := filepath.Join(t.TempDir(), "daemon.log")
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+
+	go func() { done <- runDaemon(ctx, logFile) }()
+
+	waitForLog(t, logFile, "Scheduler daemon running")
+	cancel()
+
+	// Stopping flushes the notification queue
+	if err := <-done; err != nil {
+		t.Fatalf("runDaemon: %v", err)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+
+	if all := strings.Join(received, "\n"); !strings.Contains(all, `"post_id":1`) {
+		t.Errorf("webhook received %q, want the catch-up publish of post 1", all)
+	}
+}
@@ -0,0 +1,137 @@
+package cli
+
+import (
+	"bufio"
+	"encoding/json"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"PostedIn/internal/config"
+	"PostedIn/internal/cron"
+	"PostedIn/internal/models"
+	"PostedIn/internal/scheduler"
+	"PostedIn/pkg/linkedin"
+)
+
+// newEditCLI runs the test in a daemon directory holding the given posts and returns a CLI with a
+// running auto-scheduler that reads the given input lines.
+func newEditCLI(t *testing.T, posts []models.Post, input ...string) *CLI {
+	t.Helper()
+
+	useDaemonDir(t)
+
+	data, err := json.Marshal(posts)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := os.WriteFile("posts.json", data, 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	cfg, err := config.LoadConfig()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sched, err := scheduler.NewSchedulerFromConfig(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sched.LoadSwitches(cfg)
+
+	cronScheduler := cron.NewScheduler(sched, cfg)
+	if err := cronScheduler.Start(); err != nil {
+		t.Fatal(err)
+	}
+
+	t.Cleanup(cronScheduler.Stop)
+
+	cli := NewCLI(sched, cronScheduler)
+	cli.reader = bufio.NewReader(strings.NewReader(strings.Join(input, "\n") + "\n"))
+
+	return cli
+}
+
+func findPost(t *testing.T, c *CLI, id int) models.Post {
+	t.Helper()
+
+	for _, post := range c.scheduler.GetPosts() {
+		if post.ID == id {
+			return post
+		}
+	}
+
+	t.Fatalf("post %d not found", id)
+
+	return models.Post{}
+}
+
+func TestEditPostContent(t *testing.T) {
+	scheduledAt := time.Now().UTC().Add(3 * time.Hour).Truncate(time.Minute)
+	posts := []models.Post{{ID: 1, Content: "original", ScheduledAt: scheduledAt, Status: models.StatusScheduled, PostType: models.PostTypeText}}
+
+	c := newEditCLI(t, posts, "1", "rewritten", "n")
+	c.editPost()
+
+	post := findPost(t, c, 1)
+	if post.Content != "rewritten" {
+		t.Errorf("content = %q, want %q", post.Content, "rewritten")
+	}
+
+	if !post.ScheduledAt.Equal(scheduledAt) {
+		t.Errorf("scheduled at = %v, want %v kept", post.ScheduledAt, scheduledAt)
+	}
+
+	if statuses := loadStatuses(t); statuses[1] != models.StatusScheduled {
+		t.Errorf("saved status = %q, want scheduled", statuses[1])
+	}
+}
+
+func TestEditPostReplacesTimer(t *testing.T) {
+	// The post is due in a moment, so the timer armed for its old time would publish it (dry-run)
+	posts := []models.Post{{ID: 1, Content: "soon", ScheduledAt: time.Now().UTC().Add(time.Second), Status: models.StatusScheduled, PostType: models.PostTypeText}}
+	later := time.Now().UTC().Add(48 * time.Hour).Truncate(time.Minute)
+
+	c := newEditCLI(t, posts, "1", "", "y", later.Format("2006-01-02"), later.Format("15:04"))
+	c.editPost()
+
+	if post := findPost(t, c, 1); !post.ScheduledAt.Equal(later) {
+		t.Fatalf("scheduled at = %v, want %v", post.ScheduledAt, later)
+	}
+
+	if id, next := c.cronScheduler.NextPost(); id != 1 || !next.Equal(later) {
+		t.Errorf("next timer = post %d at %v, want post 1 at %v", id, next, later)
+	}
+
+	time.Sleep(2 * time.Second)
+
+	if post := findPost(t, c, 1); post.Status != models.StatusScheduled {
+		t.Errorf("status = %q after the old time passed, want the old timer removed", post.Status)
+	}
+}
+
+func TestEditPostRejectsPosted(t *testing.T) {
+	posts := []models.Post{{ID: 1, Content: "published", ScheduledAt: time.Now().UTC().Add(-time.Hour), Status: models.StatusPosted, PostType: models.PostTypeText}}
+
+	c := newEditCLI(t, posts, "1", "rewritten", "n")
+	c.editPost()
+
+	if post := findPost(t, c, 1); post.Content != "published" {
+		t.Errorf("content = %q, want a posted post left unchanged", post.Content)
+	}
+}
+
+func TestEditPostRejectsContentOverLimit(t *testing.T) {
+	posts := []models.Post{{ID: 1, Content: "original", ScheduledAt: time.Now().UTC().Add(3 * time.Hour), Status: models.StatusScheduled, PostType: models.PostTypeText}}
+
+	c := newEditCLI(t, posts, "1", strings.Repeat("é", linkedin.MaxPostLength+1), "n")
+	c.editPost()
+
+	if post := findPost(t, c, 1); post.Content != "original" {
+		t.Errorf("content is %d characters, want the over-limit edit rejected", len([]rune(post.Content)))
+	}
+}
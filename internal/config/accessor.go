@@ -0,0 +1,299 @@
+package config
+
+import (
+	"fmt"
+	"net/url"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"PostedIn/internal/transform"
+	"PostedIn/pkg/linkedin"
+)
+
+const secretMask = "****"
+
+// secretKeys lists config keys whose values are masked by Get.
+var secretKeys = map[string]bool{
+	"linkedin.client_secret": true,
+	"sheets.api_key":         true,
+	"email.password":         true,
+}
+
+var offsetPattern = regexp.MustCompile(`^[+-]\d{2}:\d{2}$`)
+
+// keyValidators validate values for keys that need more than a type check.
+var keyValidators = map[string]func(string) error{
+	"linkedin.redirect_url":  validateRedirectURL,
+	"linkedin.user_id_field": validateUserIDField,
+	"linkedin.bind_address": func(v string) error {
+		if v == "" {
+			return nil
+		}
+
+		return ValidateBindAddress(v)
+	},
+	"timezone.offset": func(v string) error {
+		if !offsetPattern.MatchString(v) {
+			return fmt.Errorf("offset must look like +07:00 or -05:00")
+		}
+
+		return nil
+	},
+	"best_of.metric": func(v string) error {
+		return BestOfConfig{Metric: v}.Validate()
+	},
+	"cron.overdue_policy": func(v string) error {
+		switch v {
+		case "", OverdueReport, OverduePublish, OverdueReview, OverdueSnooze, OverdueFail:
+			return nil
+		}
+
+		return fmt.Errorf("overdue_policy must be one of %s, %s, %s, %s, %s", OverdueReport, OverduePublish, OverdueReview, OverdueSnooze, OverdueFail)
+	},
+	"cron.overdue_grace_minutes": func(v string) error {
+		if n, err := strconv.Atoi(v); err == nil && n < 0 {
+			return fmt.Errorf("overdue_grace_minutes cannot be negative")
+		}
+
+		return nil
+	},
+	"cron.dependency_failure_policy": func(v string) error {
+		switch v {
+		case "", DependencySkip, DependencyPublish:
+			return nil
+		}
+
+		return fmt.Errorf("dependency_failure_policy must be %s or %s", DependencySkip, DependencyPublish)
+	},
+	"visibility.default": func(v string) error {
+		if v == "" {
+			return nil
+		}
+
+		_, err := linkedin.NormalizeVisibility(v)
+
+		return err
+	},
+	"notifications.webhook_url": func(v string) error {
+		if v == "" {
+			return nil
+		}
+
+		u, err := url.Parse(v)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return fmt.Errorf("must be an http(s) URL")
+		}
+
+		return nil
+	},
+	"cron.publish_timeout_seconds":       validateTimeoutSetting,
+	"cron.media_publish_timeout_seconds": validateTimeoutSetting,
+	"cron.sweep_interval_minutes": func(v string) error {
+		if n, err := strconv.Atoi(v); err == nil && n < 0 {
+			return fmt.Errorf("sweep_interval_minutes cannot be negative")
+		}
+
+		return nil
+	},
+	"cron.publish_interval_seconds": func(v string) error {
+		if n, err := strconv.Atoi(v); err == nil && n < 0 {
+			return fmt.Errorf("publish_interval_seconds cannot be negative")
+		}
+
+		return nil
+	},
+	"cron.retry_max_attempts": func(v string) error {
+		if n, err := strconv.Atoi(v); err == nil {
+			return validateRetryAttempts(n)
+		}
+
+		return nil
+	},
+	"linkedin.api_version": func(v string) error {
+		if v == "" {
+			return nil
+		}
+
+		return linkedin.ValidateAPIVersion(v)
+	},
+	"content.duplicate_similarity_percent": func(v string) error {
+		if n, err := strconv.Atoi(v); err == nil && n > 100 {
+			return fmt.Errorf("duplicate_similarity_percent must be at most 100")
+		}
+
+		return nil
+	},
+	"content.default_language": func(v string) error {
+		if v == "" {
+			return nil
+		}
+
+		_, err := transform.NormalizeLanguage(v)
+
+		return err
+	},
+}
+
+// Keys returns every dotted key that can be read or written with Get and Set.
+func Keys() []string {
+	var keys []string
+
+	collectKeys(reflect.TypeOf(Config{}), "", &keys)
+	sort.Strings(keys)
+
+	return keys
+}
+
+// Get returns the value for a dotted key such as "timezone.location", masking secrets.
+func (c *Config) Get(key string) (string, error) {
+	field, err := c.lookup(key)
+	if err != nil {
+		return "", err
+	}
+
+	value := fmt.Sprint(field.Interface())
+	if secretKeys[key] && value != "" {
+		return secretMask, nil
+	}
+
+	return value, nil
+}
+
+// Set validates and stores a value for a dotted key such as "linkedin.redirect_url".
+// The change is only applied in memory; call SaveConfig to persist it.
+func (c *Config) Set(key, value string) error {
+	field, err := c.lookup(key)
+	if err != nil {
+		return err
+	}
+
+	// Timezone location also updates the derived offset
+	if key == "timezone.location" {
+		return c.UpdateTimezone(value)
+	}
+
+	if validate, ok := keyValidators[key]; ok {
+		if err := validate(value); err != nil {
+			return fmt.Errorf("invalid value for %s: %w", key, err)
+		}
+	}
+
+	switch field.Kind() {
+	case reflect.String:
+		field.SetString(value)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(value)
+		if err != nil {
+			return fmt.Errorf("invalid value for %s: expected true or false", key)
+		}
+
+		field.SetBool(b)
+	case reflect.Int:
+		n, err := strconv.Atoi(value)
+		if err != nil || n < 0 {
+			return fmt.Errorf("invalid value for %s: expected a non-negative integer", key)
+		}
+
+		field.SetInt(int64(n))
+	default:
+		return fmt.Errorf("%s cannot be set from the command line", key)
+	}
+
+	return nil
+}
+
+// lookup resolves a dotted key to the addressable config field using JSON tag names.
+func (c *Config) lookup(key string) (reflect.Value, error) {
+	value := reflect.ValueOf(c).Elem()
+
+	for _, part := range strings.Split(key, ".") {
+		if value.Kind() != reflect.Struct {
+			return reflect.Value{}, unknownKeyError(key)
+		}
+
+		field, ok := fieldByTag(value, part)
+		if !ok {
+			return reflect.Value{}, unknownKeyError(key)
+		}
+
+		value = field
+	}
+
+	if value.Kind() == reflect.Struct {
+		return reflect.Value{}, fmt.Errorf("%s is a section, use one of its keys", key)
+	}
+
+	return value, nil
+}
+
+func fieldByTag(value reflect.Value, name string) (reflect.Value, bool) {
+	t := value.Type()
+
+	for i := 0; i < t.NumField(); i++ {
+		if jsonName(t.Field(i)) == name {
+			return value.Field(i), true
+		}
+	}
+
+	return reflect.Value{}, false
+}
+
+func collectKeys(t reflect.Type, prefix string, keys *[]string) {
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+
+		name := jsonName(field)
+		if name == "" {
+			continue
+		}
+
+		switch field.Type.Kind() {
+		case reflect.Struct:
+			collectKeys(field.Type, prefix+name+".", keys)
+		case reflect.String, reflect.Bool, reflect.Int:
+			*keys = append(*keys, prefix+name)
+		}
+	}
+}
+
+func jsonName(field reflect.StructField) string {
+	name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+	if name == "-" {
+		return ""
+	}
+
+	return name
+}
+
+func unknownKeyError(key string) error {
+	return fmt.Errorf("unknown config key %q (valid keys: %s)", key, strings.Join(Keys(), ", "))
+}
+
+func validateRedirectURL(v string) error {
+	parsed, err := url.Parse(v)
+	if err != nil {
+		return err
+	}
+
+	if parsed.Scheme != "http" && parsed.Scheme != "https" {
+		return fmt.Errorf("redirect URL must use http or https scheme")
+	}
+
+	if parsed.Host == "" {
+		return fmt.Errorf("redirect URL must have a valid host")
+	}
+
+	return nil
+}
+
+func validateTimeoutSetting(v string) error {
+	n, err := strconv.Atoi(v)
+	if err != nil {
+		return fmt.Errorf("expected a number of seconds")
+	}
+
+	return validateTimeoutSeconds(n)
+}
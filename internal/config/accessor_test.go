@@ -0,0 +1,133 @@
+package config
+
+import (
+	"os"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestGetMasksSecrets(t *testing.T) {
+	cfg := &Config{LinkedIn: LinkedInConfig{ClientID: "id", ClientSecret: "secret"}}
+
+	if got, err := cfg.Get("linkedin.client_id"); err != nil || got != "id" {
+		t.Errorf("Get(client_id) = %q, %v", got, err)
+	}
+
+	if got, err := cfg.Get("linkedin.client_secret"); err != nil || got != secretMask {
+		t.Errorf("Get(client_secret) = %q, %v, want it masked", got, err)
+	}
+
+	if _, err := cfg.Get("linkedin.nope"); err == nil || !strings.Contains(err.Error(), "unknown config key") {
+		t.Errorf("Get(unknown) error = %v", err)
+	}
+
+	if _, err := cfg.Get("linkedin"); err == nil || !strings.Contains(err.Error(), "is a section") {
+		t.Errorf("Get(section) error = %v", err)
+	}
+}
+
+func TestSetValidatesValues(t *testing.T) {
+	tests := []struct {
+		key, value string
+		wantErr    bool
+	}{
+		{"linkedin.redirect_url", "https://example.com/callback", false},
+		{"linkedin.redirect_url", "ftp://example.com", true},
+		{"cron.enabled", "true", false},
+		{"cron.enabled", "yes please", true},
+		{"cron.overdue_snooze_minutes", "15", false},
+		{"cron.overdue_snooze_minutes", "-1", true},
+		{"cron.overdue_policy", OverdueSnooze, false},
+		{"cron.overdue_policy", "ignore", true},
+		{"cron.overdue_policy", OverdueFail, false},
+		{"cron.overdue_grace_minutes", "10", false},
+		{"cron.overdue_grace_minutes", "-5", true},
+		{"cron.publish_interval_seconds", "30", false},
+		{"cron.publish_interval_seconds", "-1", true},
+		{"timezone.offset", "+07:00", false},
+		{"timezone.offset", "7", true},
+		{"best_of.metric", "nope", true},
+	}
+
+	for _, tt := range tests {
+		cfg := &Config{}
+
+		err := cfg.Set(tt.key, tt.value)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("Set(%s, %q) = %v, wantErr %v", tt.key, tt.value, err, tt.wantErr)
+			continue
+		}
+
+		if !tt.wantErr {
+			if got, _ := cfg.Get(tt.key); got != tt.value {
+				t.Errorf("Get(%s) after Set = %q, want %q", tt.key, got, tt.value)
+			}
+		}
+	}
+}
+
+func TestSetTimezoneLocationUpdatesOffset(t *testing.T) {
+	cfg := &Config{}
+	if err := cfg.Set("timezone.location", "UTC"); err != nil {
+		t.Fatalf("Set: %v", err)
+	}
+
+	if cfg.Timezone.Location != "UTC" || cfg.Timezone.Offset != "+00:00" {
+		t.Errorf("timezone = %+v, want UTC with offset +00:00", cfg.Timezone)
+	}
+}
+
+func TestKeys(t *testing.T) {
+	keys := Keys()
+
+	for _, key := range []string{"linkedin.client_id", "cron.overdue_policy", "timezone.location", "best_of.metric"} {
+		if !slices.Contains(keys, key) {
+			t.Errorf("Keys() is missing %s", key)
+		}
+	}
+
+	if !slices.IsSorted(keys) {
+		t.Error("Keys() is not sorted")
+	}
+}
+
+func TestSaveConfigIsAtomicAndPrivate(t *testing.T) {
+	useTestConfig(t, "")
+
+	cfg, err := ReadConfig()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := cfg.Set("cron.min_lead_minutes", "10"); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := SaveConfig(cfg); err != nil {
+		t.Fatalf("SaveConfig: %v", err)
+	}
+
+	info, err := os.Stat(ConfigPath())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if perm := info.Mode().Perm(); perm != restrictedPerm {
+		t.Errorf("config permissions = %o, want %o", perm, restrictedPerm)
+	}
+
+	entries, _ := os.ReadDir(strings.TrimSuffix(ConfigPath(), "config.json"))
+	if len(entries) != 1 {
+		t.Errorf("config directory holds %d files, want no leftover temp file", len(entries))
+	}
+
+	saved, err := ReadConfig()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if saved.Cron.MinLeadMinutes != 10 {
+		t.Errorf("saved min_lead_minutes = %d, want 10", saved.Cron.MinLeadMinutes)
+	}
+}
@@ -0,0 +1,164 @@
+package config
+
+import (
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	"PostedIn/pkg/linkedin"
+)
+
+// DefaultAccount names the identity of the top-level linkedin section and storage.token_file.
+const DefaultAccount = "default"
+
+// identityMu guards the LinkedIn identity publishes cache at runtime (the user ID and author URN of
+// the linkedin section and of the accounts) and SaveConfig, as publishes run concurrently with each
+// other and with the API handlers reading the same config.
+var identityMu sync.RWMutex
+
+// AccountConfig is an additional LinkedIn identity with its own token. Empty client_id, client_secret,
+// redirect_url, user_id_field and api_version are taken from the linkedin section, so accounts can share one app.
+// An empty footer keeps content.footer.
+type AccountConfig struct {
+	LinkedInConfig
+	TokenFile string `json:"token_file"`
+	Footer    string `json:"footer,omitempty"`
+}
+
+// ForAccount returns a view of the config that acts as the named account: the account's LinkedIn
+// settings, token file and footer replace the default ones. An empty name or "default" returns a copy
+// of c. The view is a snapshot: use StoreAccountIdentity to copy what it learns back into c.
+func (c *Config) ForAccount(name string) (*Config, error) {
+	identityMu.RLock()
+	defer identityMu.RUnlock()
+
+	view := *c
+	if name == "" || name == DefaultAccount {
+		return &view, nil
+	}
+
+	account, ok := c.Accounts[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown account %q (configured: %v)", name, c.accountNames())
+	}
+
+	view.LinkedIn = account.LinkedInConfig
+	view.Storage.TokenFile = account.TokenFile
+
+	if account.Footer != "" {
+		view.Content.Footer = account.Footer
+	}
+
+	if view.LinkedIn.ClientID == "" {
+		view.LinkedIn.ClientID = c.LinkedIn.ClientID
+		view.LinkedIn.ClientSecret = c.LinkedIn.ClientSecret
+	}
+
+	if view.LinkedIn.RedirectURL == "" {
+		view.LinkedIn.RedirectURL = c.LinkedIn.RedirectURL
+		view.LinkedIn.BindAddress = c.LinkedIn.BindAddress
+	}
+
+	if view.LinkedIn.UserIDField == "" {
+		view.LinkedIn.UserIDField = c.LinkedIn.UserIDField
+	}
+
+	if view.LinkedIn.APIVersion == "" {
+		view.LinkedIn.APIVersion = c.LinkedIn.APIVersion
+	}
+
+	return &view, nil
+}
+
+// StoreAccountIdentity copies the user ID and author URN learned through a ForAccount view back
+// into the named account. Call SaveConfig to persist them.
+func (c *Config) StoreAccountIdentity(name string, view *Config) {
+	identityMu.Lock()
+	defer identityMu.Unlock()
+
+	if name == "" || name == DefaultAccount {
+		c.LinkedIn.UserID = view.LinkedIn.UserID
+		c.LinkedIn.AuthorURN = view.LinkedIn.AuthorURN
+
+		return
+	}
+
+	account, ok := c.Accounts[name]
+	if !ok {
+		return
+	}
+
+	account.UserID = view.LinkedIn.UserID
+	account.AuthorURN = view.LinkedIn.AuthorURN
+	c.Accounts[name] = account
+}
+
+// AccountNames returns "default" followed by the configured account names, sorted.
+func (c *Config) AccountNames() []string {
+	identityMu.RLock()
+	defer identityMu.RUnlock()
+
+	return c.accountNames()
+}
+
+// accountNames is AccountNames for callers that already hold identityMu or own the config.
+func (c *Config) accountNames() []string {
+	names := make([]string, 0, len(c.Accounts))
+	for name := range c.Accounts {
+		if name != DefaultAccount {
+			names = append(names, name)
+		}
+	}
+
+	sort.Strings(names)
+
+	return append([]string{DefaultAccount}, names...)
+}
+
+// validateAccounts checks that every account has a usable name and its own token file.
+func (c *Config) validateAccounts() error {
+	tokenFiles := map[string]string{filepath.Clean(c.Storage.TokenFile): DefaultAccount}
+
+	if _, ok := c.Accounts[DefaultAccount]; ok {
+		return fmt.Errorf("account name %q is reserved for the linkedin section", DefaultAccount)
+	}
+
+	for _, name := range c.accountNames()[1:] {
+		account := c.Accounts[name]
+
+		switch {
+		case strings.TrimSpace(name) != name || name == "":
+			return fmt.Errorf("account name %q must not be empty or padded with spaces", name)
+		case account.TokenFile == "":
+			return fmt.Errorf("account %q: token_file is required", name)
+		case (account.ClientID == "") != (account.ClientSecret == ""):
+			return fmt.Errorf("account %q: set both client_id and client_secret, or neither to use the default app", name)
+		}
+
+		if other, ok := tokenFiles[filepath.Clean(account.TokenFile)]; ok {
+			return fmt.Errorf("account %q: token_file %s is already used by account %q", name, account.TokenFile, other)
+		}
+
+		tokenFiles[filepath.Clean(account.TokenFile)] = name
+
+		if err := validateUserIDField(account.UserIDField); err != nil {
+			return fmt.Errorf("account %q: %w", name, err)
+		}
+
+		if account.APIVersion != "" {
+			if err := linkedin.ValidateAPIVersion(account.APIVersion); err != nil {
+				return fmt.Errorf("account %q: %w", name, err)
+			}
+		}
+
+		if account.OrganizationURN != "" {
+			if _, err := linkedin.OrganizationURN(account.OrganizationURN); err != nil {
+				return fmt.Errorf("account %q: %w", name, err)
+			}
+		}
+	}
+
+	return nil
+}
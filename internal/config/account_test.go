@@ -0,0 +1,35 @@
+package config
+
+import "testing"
+
+func TestForAccountFooter(t *testing.T) {
+	cfg := &Config{
+		Content: ContentConfig{Footer: "Default footer"},
+		Accounts: map[string]AccountConfig{
+			"page":  {TokenFile: "token-page.json", Footer: "Page footer"},
+			"alice": {TokenFile: "token-alice.json"},
+		},
+	}
+
+	tests := map[string]string{
+		"":        "Default footer",
+		"default": "Default footer",
+		"page":    "Page footer",
+		"alice":   "Default footer",
+	}
+
+	for name, want := range tests {
+		view, err := cfg.ForAccount(name)
+		if err != nil {
+			t.Fatalf("ForAccount(%q): %v", name, err)
+		}
+
+		if view.Content.Footer != want {
+			t.Errorf("ForAccount(%q) footer = %q, want %q", name, view.Content.Footer, want)
+		}
+	}
+
+	if cfg.Content.Footer != "Default footer" {
+		t.Errorf("ForAccount changed the default footer to %q", cfg.Content.Footer)
+	}
+}
@@ -0,0 +1,28 @@
+package config
+
+import "time"
+
+const (
+	// DefaultAssetCacheFile records uploaded media URNs when storage.asset_cache_file is unset.
+	DefaultAssetCacheFile = BaseConfigPath + "/asset_cache.json"
+	// DefaultAssetCacheTTL is how long an uploaded image or video is reused when storage.asset_cache_hours is unset.
+	DefaultAssetCacheTTL = 24 * time.Hour
+)
+
+// AssetCache returns the asset cache file and how long cached uploads are reused;
+// a zero duration means caching is disabled.
+func (s StorageConfig) AssetCache() (string, time.Duration) {
+	path := s.AssetCacheFile
+	if path == "" {
+		path = DefaultAssetCacheFile
+	}
+
+	switch {
+	case s.AssetCacheHours < 0:
+		return path, 0
+	case s.AssetCacheHours > 0:
+		return path, time.Duration(s.AssetCacheHours) * time.Hour
+	}
+
+	return path, DefaultAssetCacheTTL
+}
@@ -0,0 +1,41 @@
+package config
+
+import (
+	"fmt"
+	"sort"
+
+	"PostedIn/pkg/linkedin"
+)
+
+// Audience returns the named audience from the audiences section of the config.
+func (c *Config) Audience(name string) (linkedin.Audience, error) {
+	audience, ok := c.Audiences[name]
+	if !ok {
+		return linkedin.Audience{}, fmt.Errorf("unknown audience %q (configured: %v)", name, c.AudienceNames())
+	}
+
+	return audience, nil
+}
+
+// AudienceNames returns the configured audience names, sorted.
+func (c *Config) AudienceNames() []string {
+	names := make([]string, 0, len(c.Audiences))
+	for name := range c.Audiences {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	return names
+}
+
+// validateAudiences checks every configured audience.
+func validateAudiences(audiences map[string]linkedin.Audience) error {
+	for name, audience := range audiences {
+		if err := audience.Validate(); err != nil {
+			return fmt.Errorf("audience %q: %w", name, err)
+		}
+	}
+
+	return nil
+}
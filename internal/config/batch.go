@@ -0,0 +1,8 @@
+package config
+
+import "time"
+
+// PublishInterval returns the minimum pause between the posts of one batch publish.
+func (c CronConfig) PublishInterval() time.Duration {
+	return time.Duration(max(c.PublishIntervalSeconds, 0)) * time.Second
+}
@@ -0,0 +1,23 @@
+package config
+
+import (
+	"testing"
+	"time"
+)
+
+func TestPublishInterval(t *testing.T) {
+	tests := []struct {
+		seconds int
+		want    time.Duration
+	}{
+		{0, 0},
+		{-5, 0},
+		{30, 30 * time.Second},
+	}
+
+	for _, tt := range tests {
+		if got := (CronConfig{PublishIntervalSeconds: tt.seconds}).PublishInterval(); got != tt.want {
+			t.Errorf("PublishInterval(%d) = %v, want %v", tt.seconds, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,118 @@
+package config
+
+import (
+	"fmt"
+	"time"
+
+	"PostedIn/internal/timezone"
+)
+
+// Metrics that rank posts for best-of reposts.
+const (
+	MetricReactions  = "reactions"
+	MetricComments   = "comments"
+	MetricEngagement = "engagement" // Reactions plus comments
+)
+
+// Best-of defaults used when the best_of section leaves a setting unset.
+const (
+	defaultBestOfInterval = 24 * time.Hour
+	defaultBestOfMinAge   = 30 * 24 * time.Hour
+	defaultBestOfCooldown = 90 * 24 * time.Hour
+	defaultBestOfHorizon  = 14 * 24 * time.Hour
+	defaultBestOfCount    = 1
+)
+
+// BestOfConfig selects top-performing published posts to repost into open weekly slots.
+type BestOfConfig struct {
+	// Enabled refreshes post analytics and schedules reposts every IntervalHours (default 24)
+	// while the auto-scheduler runs; suggestions can be listed and scheduled on demand either way.
+	Enabled       bool   `json:"enabled,omitempty"`
+	IntervalHours int    `json:"interval_hours,omitempty"`
+	Metric        string `json:"metric,omitempty"`       // reactions, comments or engagement (default)
+	MinAgeDays    int    `json:"min_age_days,omitempty"` // Only posts published at least this long ago; default 30
+	// CooldownDays skips posts reposted, or with a repost pending, within this many days; default 90.
+	CooldownDays int      `json:"cooldown_days,omitempty"`
+	Count        int      `json:"count,omitempty"`        // Reposts suggested per run; default 1
+	Slots        []string `json:"slots,omitempty"`        // Weekly publish slots such as "tuesday 9am"
+	HorizonDays  int      `json:"horizon_days,omitempty"` // How far ahead to look for an open slot; default 14
+}
+
+// Interval returns how often best-of reposts are scheduled automatically.
+func (c BestOfConfig) Interval() time.Duration {
+	if c.IntervalHours > 0 {
+		return time.Duration(c.IntervalHours) * time.Hour
+	}
+
+	return defaultBestOfInterval
+}
+
+// RankMetric returns the metric posts are ranked by.
+func (c BestOfConfig) RankMetric() string {
+	if c.Metric != "" {
+		return c.Metric
+	}
+
+	return MetricEngagement
+}
+
+// MinAge returns how long ago a post must have been published to be reposted.
+func (c BestOfConfig) MinAge() time.Duration {
+	if c.MinAgeDays > 0 {
+		return time.Duration(c.MinAgeDays) * 24 * time.Hour
+	}
+
+	return defaultBestOfMinAge
+}
+
+// Cooldown returns how long a post is not reposted again after a repost.
+func (c BestOfConfig) Cooldown() time.Duration {
+	if c.CooldownDays > 0 {
+		return time.Duration(c.CooldownDays) * 24 * time.Hour
+	}
+
+	return defaultBestOfCooldown
+}
+
+// Horizon returns how far ahead open slots are looked for.
+func (c BestOfConfig) Horizon() time.Duration {
+	if c.HorizonDays > 0 {
+		return time.Duration(c.HorizonDays) * 24 * time.Hour
+	}
+
+	return defaultBestOfHorizon
+}
+
+// Reposts returns how many reposts are suggested per run.
+func (c BestOfConfig) Reposts() int {
+	if c.Count > 0 {
+		return c.Count
+	}
+
+	return defaultBestOfCount
+}
+
+// Validate checks the metric, the slots and that no setting is negative.
+func (c BestOfConfig) Validate() error {
+	switch c.Metric {
+	case "", MetricReactions, MetricComments, MetricEngagement:
+	default:
+		return fmt.Errorf("unknown metric %q (allowed: %s, %s, %s)", c.Metric, MetricReactions, MetricComments, MetricEngagement)
+	}
+
+	if c.IntervalHours < 0 || c.MinAgeDays < 0 || c.CooldownDays < 0 || c.Count < 0 || c.HorizonDays < 0 {
+		return fmt.Errorf("interval_hours, min_age_days, cooldown_days, count and horizon_days cannot be negative")
+	}
+
+	for _, slot := range c.Slots {
+		if _, err := timezone.NextWeekly(slot, time.Now()); err != nil {
+			return err
+		}
+	}
+
+	if c.Enabled && len(c.Slots) == 0 {
+		return fmt.Errorf("slots are required when enabled")
+	}
+
+	return nil
+}
@@ -0,0 +1,45 @@
+package config
+
+import (
+	"testing"
+	"time"
+)
+
+func TestBestOfDefaults(t *testing.T) {
+	var c BestOfConfig
+
+	if c.RankMetric() != MetricEngagement || c.Reposts() != 1 || c.Interval() != 24*time.Hour {
+		t.Errorf("defaults = %s, %d, %v", c.RankMetric(), c.Reposts(), c.Interval())
+	}
+
+	if c.MinAge() != 30*24*time.Hour || c.Cooldown() != 90*24*time.Hour || c.Horizon() != 14*24*time.Hour {
+		t.Errorf("default ages = %v, %v, %v", c.MinAge(), c.Cooldown(), c.Horizon())
+	}
+
+	c = BestOfConfig{Metric: MetricComments, MinAgeDays: 7, CooldownDays: 30, Count: 3}
+	if c.RankMetric() != MetricComments || c.MinAge() != 7*24*time.Hour || c.Cooldown() != 30*24*time.Hour || c.Reposts() != 3 {
+		t.Errorf("configured = %s, %v, %v, %d", c.RankMetric(), c.MinAge(), c.Cooldown(), c.Reposts())
+	}
+}
+
+func TestBestOfValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		config  BestOfConfig
+		wantErr bool
+	}{
+		{"empty", BestOfConfig{}, false},
+		{"valid", BestOfConfig{Enabled: true, Metric: MetricReactions, Slots: []string{"tuesday 9am", "Friday 14:30"}}, false},
+		{"unknown metric", BestOfConfig{Metric: "impressions"}, true},
+		{"negative", BestOfConfig{CooldownDays: -1}, true},
+		{"slot without weekday", BestOfConfig{Slots: []string{"9am"}}, true},
+		{"slot without time", BestOfConfig{Slots: []string{"monday"}}, true},
+		{"enabled without slots", BestOfConfig{Enabled: true}, true},
+	}
+
+	for _, tt := range tests {
+		if err := tt.config.Validate(); (err != nil) != tt.wantErr {
+			t.Errorf("%s: Validate() = %v, wantErr %v", tt.name, err, tt.wantErr)
+		}
+	}
+}
@@ -4,26 +4,55 @@ package config
 import (
 	"encoding/json"
 	"fmt"
+	"log"
+	"net"
+	"net/url"
 	"os"
+	"path/filepath"
+	"strings"
 	"time"
 
 	"PostedIn/internal/timezone"
+	"PostedIn/pkg/linkedin"
 
 	"golang.org/x/oauth2"
 )
 
 const (
-	secondsPerHour   = 3600
-	secondsPerMinute = 60
-	restrictedPerm   = 0o600
+	secondsPerHour    = 3600
+	secondsPerMinute  = 60
+	restrictedPerm    = 0o600
+	restrictedDirPerm = 0o700
 )
 
 // Config represents the main application configuration structure.
 type Config struct {
-	LinkedIn LinkedInConfig `json:"linkedin"`
-	Storage  StorageConfig  `json:"storage"`
-	Timezone TimezoneConfig `json:"timezone"`
-	Cron     CronConfig     `json:"cron"`
+	LinkedIn      LinkedInConfig      `json:"linkedin"`
+	Storage       StorageConfig       `json:"storage"`
+	Timezone      TimezoneConfig      `json:"timezone"`
+	Cron          CronConfig          `json:"cron"`
+	Content       ContentConfig       `json:"content"`
+	Sheets        SheetsConfig        `json:"sheets"`
+	Email         EmailConfig         `json:"email"`
+	Visibility    VisibilityConfig    `json:"visibility"`
+	Notifications NotificationsConfig `json:"notifications"`
+	SLO           SLOConfig           `json:"slo"`
+	RateLimits    RateLimitConfig     `json:"rate_limits"`
+	BestOf        BestOfConfig        `json:"best_of"`
+	// Events maps calendar event names to 'YYYY-MM-DD HH:MM' times that posts can be scheduled relative to.
+	Events map[string]string `json:"events,omitempty"`
+	// Audiences holds named targeting sets that posts reference by name.
+	Audiences map[string]linkedin.Audience `json:"audiences,omitempty"`
+	// Recipes holds reusable post definitions applied with the apply-recipe command.
+	Recipes map[string]Recipe `json:"recipes,omitempty"`
+	// Accounts holds additional LinkedIn identities by name; posts choose one with their account field.
+	Accounts map[string]AccountConfig `json:"accounts,omitempty"`
+	// Paused blocks all publishing (manual, cron and API) until cleared; it survives restarts.
+	Paused bool `json:"paused,omitempty"`
+	// DryRun makes every publish log what it would send to LinkedIn and mark the post posted without calling the API.
+	DryRun bool `json:"dry_run,omitempty"`
+
+	fileValues map[string]string // File values of settings overridden from the environment, by variable
 }
 
 // LinkedInConfig holds LinkedIn OAuth configuration settings.
@@ -32,37 +61,199 @@ type LinkedInConfig struct {
 	ClientSecret string `json:"client_secret"`
 	RedirectURL  string `json:"redirect_url"`
 	UserID       string `json:"user_id,omitempty"`
+	UserIDField  string `json:"user_id_field,omitempty"` // Profile field stored as UserID ("sub" or "id"); empty tries "sub", then "id"
+	AuthorURN    string `json:"author_urn,omitempty"`    // Cached author URN format LinkedIn accepted
+	BindAddress  string `json:"bind_address,omitempty"`  // Listen address for callback servers; defaults to the redirect URL host
+	APIVersion   string `json:"api_version,omitempty"`   // Default LinkedIn-Version header, e.g. "202506"
+	// OrganizationURN is the company page organization posts publish as unless they name one;
+	// setting it also requests the w_organization_social scope when authenticating.
+	OrganizationURN string `json:"organization_urn,omitempty"`
 }
 
 // StorageConfig defines file paths for data storage.
 type StorageConfig struct {
 	PostsFile string `json:"posts_file"`
 	TokenFile string `json:"token_file"`
+	// ReplicaFile receives a copy of the posts after every save and is loaded if the posts file is missing or corrupt.
+	ReplicaFile string `json:"replica_file,omitempty"`
+	// AssetCacheFile records uploaded media URNs so posts attaching the same file reuse them.
+	AssetCacheFile  string `json:"asset_cache_file,omitempty"`
+	AssetCacheHours int    `json:"asset_cache_hours,omitempty"` // How long uploads are reused; default 24, negative disables
+	// Backend selects where posts are stored: "json" (the default) in posts_file, or "sqlite" in
+	// sqlite_file, which needs a binary built with the sqlite tag.
+	Backend    string `json:"backend,omitempty"`
+	SQLiteFile string `json:"sqlite_file,omitempty"` // SQLite database for the sqlite backend; default posts.db
 }
 
+// Storage backends for StorageConfig.Backend.
+const (
+	StorageJSON   = "json"
+	StorageSQLite = "sqlite"
+)
+
 // TimezoneConfig specifies timezone settings for post scheduling.
 type TimezoneConfig struct {
 	Location string `json:"location"`
 	Offset   string `json:"offset"`
 }
 
+// Overdue handling policies for CronConfig.OverduePolicy.
+const (
+	OverdueReport  = "report"
+	OverduePublish = "publish"
+	OverdueReview  = "review"
+	OverdueSnooze  = "snooze"
+	OverdueFail    = "fail"
+)
+
+// Dependency failure policies for CronConfig.DependencyFailurePolicy.
+const (
+	DependencySkip    = "skip"
+	DependencyPublish = "publish"
+)
+
 // CronConfig controls automatic post scheduling functionality.
 type CronConfig struct {
 	Enabled bool `json:"enabled"`
+	// OverduePolicy decides what happens at startup to scheduled posts whose time has passed:
+	// "report" (default) only reports them, "publish" publishes them now, "review" marks
+	// them needs_review, "snooze" reschedules them OverdueSnoozeMinutes from now, and "fail" marks them failed as missed.
+	OverduePolicy        string `json:"overdue_policy,omitempty"`
+	OverdueSnoozeMinutes int    `json:"overdue_snooze_minutes,omitempty"`
+	// OverdueGraceMinutes publishes posts overdue by at most this many minutes at startup whatever
+	// the policy, which then only applies to older posts; 0 disables the grace window.
+	OverdueGraceMinutes int `json:"overdue_grace_minutes,omitempty"`
+	// DependencyFailurePolicy decides what happens to posts depending on a post that failed:
+	// "skip" (default) marks them failed, "publish" schedules them relative to the failure time.
+	DependencyFailurePolicy string `json:"dependency_failure_policy,omitempty"`
+	// MinLeadMinutes rejects new posts scheduled sooner than this many minutes from now; 0 disables it.
+	MinLeadMinutes int `json:"min_lead_minutes,omitempty"`
+	// MaxPublishPerRun caps how many due posts one batch publish sends; the rest wait for the next run. 0 means no cap.
+	MaxPublishPerRun int `json:"max_publish_per_run,omitempty"`
+	// PublishIntervalSeconds is the minimum pause between the posts of one batch publish; 0 publishes them back-to-back.
+	PublishIntervalSeconds int `json:"publish_interval_seconds,omitempty"`
+	// PublishTimeoutSeconds and MediaPublishTimeoutSeconds bound a single publish, the latter for
+	// posts with an image or video; 0 uses 2 and 10 minutes.
+	PublishTimeoutSeconds      int `json:"publish_timeout_seconds,omitempty"`
+	MediaPublishTimeoutSeconds int `json:"media_publish_timeout_seconds,omitempty"`
+	// SweepEnabled adds a periodic check that publishes scheduled posts whose timer never fired,
+	// e.g. after a suspend or clock jump. SweepIntervalMinutes defaults to 5.
+	SweepEnabled         bool `json:"sweep_enabled,omitempty"`
+	SweepIntervalMinutes int  `json:"sweep_interval_minutes,omitempty"`
+	// RetryMaxAttempts is how many times a publish failing with a transient error (a LinkedIn server
+	// error or a network failure) is attempted before the post is marked failed; 0 uses 3 and 1 disables retries.
+	// Retries wait RetryInitialDelaySeconds (default 60), multiplied by RetryBackoffFactor (default 2) each time.
+	RetryMaxAttempts         int `json:"retry_max_attempts,omitempty"`
+	RetryInitialDelaySeconds int `json:"retry_initial_delay_seconds,omitempty"`
+	RetryBackoffFactor       int `json:"retry_backoff_factor,omitempty"`
+}
+
+// ContentConfig defines transformations applied to post content at publish time.
+type ContentConfig struct {
+	Footer          string `json:"footer,omitempty"`           // Appended after a blank line; stored content stays untouched
+	DefaultLanguage string `json:"default_language,omitempty"` // Language of post content when not specified
+	FilesDir        string `json:"files_dir,omitempty"`        // Directory the API may read content_file from; empty disables it
+	// DuplicateSimilarityPercent is the word overlap with a recently posted item that triggers a
+	// near-duplicate warning when scheduling; DuplicateLookbackDays is how far back posts are compared.
+	// 0 uses 80% and 30 days.
+	DuplicateSimilarityPercent int `json:"duplicate_similarity_percent,omitempty"`
+	DuplicateLookbackDays      int `json:"duplicate_lookback_days,omitempty"`
+}
+
+// SheetsConfig configures pulling scheduled posts from a Google Sheet.
+// The first row of the range is a header naming the columns.
+type SheetsConfig struct {
+	Enabled             bool   `json:"enabled"`
+	SheetID             string `json:"sheet_id,omitempty"`
+	Range               string `json:"range,omitempty"` // A1 notation, e.g. "Posts!A:C"
+	APIKey              string `json:"api_key,omitempty"`
+	PollIntervalMinutes int    `json:"poll_interval_minutes,omitempty"`
+	CursorFile          string `json:"cursor_file,omitempty"`         // Remembers the last synced row
+	ContentColumn       string `json:"content_column,omitempty"`      // Header of the content column, default "content"
+	ScheduledAtColumn   string `json:"scheduled_at_column,omitempty"` // Header of the 'YYYY-MM-DD HH:MM' column, default "scheduled_at"
+}
+
+// DefaultSheetsCursorFile remembers the last synced sheet row when sheets.cursor_file is unset.
+const DefaultSheetsCursorFile = "sheets_cursor.json"
+
+// Cursor returns the file remembering the last synced sheet row.
+func (s SheetsConfig) Cursor() string {
+	if s.CursorFile == "" {
+		return DefaultSheetsCursorFile
+	}
+
+	return s.CursorFile
+}
+
+// EmailConfig configures scheduling posts from emails in an IMAP mailbox.
+// The subject, after SubjectPrefix, is the schedule, e.g. "tomorrow 9am"; the body is the content.
+type EmailConfig struct {
+	Enabled             bool   `json:"enabled"`
+	Server              string `json:"server,omitempty"` // IMAPS host:port, e.g. imap.gmail.com:993
+	Username            string `json:"username,omitempty"`
+	Password            string `json:"password,omitempty"`
+	Mailbox             string `json:"mailbox,omitempty"` // Folder or Gmail label to read, default INBOX
+	PollIntervalMinutes int    `json:"poll_interval_minutes,omitempty"`
+	ProcessedFlag       string `json:"processed_flag,omitempty"` // IMAP keyword set on processed emails, default $PostedIn
+	// SubjectPrefix limits ingestion to subjects starting with it, e.g. "[post]"; it is removed before the schedule is read.
+	SubjectPrefix string `json:"subject_prefix,omitempty"`
+	// AllowedSenders limits ingestion to these sender addresses; empty accepts any sender.
+	AllowedSenders []string `json:"allowed_senders,omitempty"`
+	// DefaultSchedule is used when the subject holds no schedule, e.g. "tomorrow 9am"; empty skips such emails.
+	DefaultSchedule string `json:"default_schedule,omitempty"`
+	AttachmentsDir  string `json:"attachments_dir,omitempty"` // Where image and video attachments are saved, default email_attachments
+}
+
+// NotificationsConfig configures the webhook notified when posts publish or fail.
+type NotificationsConfig struct {
+	WebhookURL         string `json:"webhook_url,omitempty"`
+	BatchWindowSeconds int    `json:"batch_window_seconds,omitempty"` // Events within the window are sent together; default 10
+	MaxRetries         int    `json:"max_retries,omitempty"`          // Retries per batch with exponential backoff; default 3
 }
 
 const (
 	BaseConfigPath = "./internal/config"
-	// ConfigFile is the default configuration file name.
+	// ConfigFile is the default configuration file name; see ConfigPath for the one in use.
 	ConfigFile = BaseConfigPath + "/config.json"
 	// TokenFile is the default OAuth token file name.
 	TokenFile = BaseConfigPath + "/linkedin_token.json"
+	// DefaultPostsFile stores the posts when storage.posts_file is unset.
+	DefaultPostsFile = "posts.json"
+	// DefaultSQLiteFile stores the posts of the sqlite backend when storage.sqlite_file is unset.
+	DefaultSQLiteFile = "posts.db"
 )
 
+// PostsPath returns the file posts are stored in, defaulting to DefaultPostsFile.
+func (s StorageConfig) PostsPath() string {
+	if s.PostsFile == "" {
+		return DefaultPostsFile
+	}
+
+	return s.PostsFile
+}
+
+// SQLitePath returns the database the sqlite backend stores posts in, defaulting to DefaultSQLiteFile.
+func (s StorageConfig) SQLitePath() string {
+	if s.SQLiteFile == "" {
+		return DefaultSQLiteFile
+	}
+
+	return s.SQLiteFile
+}
+
+// DataPath returns the file holding the posts for the configured backend.
+func (s StorageConfig) DataPath() string {
+	if s.Backend == StorageSQLite {
+		return s.SQLitePath()
+	}
+
+	return s.PostsPath()
+}
+
 // LoadConfig loads application configuration from the config file or creates default configuration.
 func LoadConfig() (*Config, error) {
 	// Check if config file exists
-	if _, err := os.Stat(ConfigFile); os.IsNotExist(err) {
+	if _, err := os.Stat(ConfigPath()); os.IsNotExist(err) {
 		// Detect local timezone
 		localLocation, localOffset, err := timezone.DetectLocalTimezone()
 		if err != nil {
@@ -79,7 +270,7 @@ func LoadConfig() (*Config, error) {
 				RedirectURL:  "http://localhost:8080/callback",
 			},
 			Storage: StorageConfig{
-				PostsFile: "posts.json",
+				PostsFile: DefaultPostsFile,
 				TokenFile: TokenFile,
 			},
 			Timezone: TimezoneConfig{
@@ -95,10 +286,114 @@ func LoadConfig() (*Config, error) {
 			return nil, fmt.Errorf("failed to create default config: %w", err)
 		}
 
-		return nil, fmt.Errorf("config file created at %s with local timezone (%s %s) - please fill in your LinkedIn app credentials", ConfigFile, localLocation, localOffset)
+		return nil, fmt.Errorf("config file created at %s with local timezone (%s %s) - please fill in your LinkedIn app credentials", ConfigPath(), localLocation, localOffset)
 	}
 
-	data, err := os.ReadFile(ConfigFile)
+	config, err := ReadConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	config.applyEnvOverrides()
+
+	if err := config.Validate(ConfigPath()); err != nil {
+		return nil, err
+	}
+
+	// Keep the displayed offset in sync with the location, e.g. after a DST change
+	stale := config.Timezone.Offset
+	if corrected, err := config.ReconcileOffset(); err != nil {
+		log.Printf("⚠️ Could not verify timezone offset: %v", err)
+	} else if corrected {
+		log.Printf("⚠️ Timezone offset %q is stale for %s, corrected to %s", stale, config.Timezone.Location, config.Timezone.Offset)
+
+		if err := SaveConfig(config); err != nil {
+			log.Printf("⚠️ Failed to save corrected timezone offset: %v", err)
+		}
+	}
+
+	return config, nil
+}
+
+// Validate checks the required fields and the settings that need more than a type check,
+// naming source, usually the config file path, in errors.
+func (c *Config) Validate(source string) error {
+	if c.LinkedIn.ClientID == "" || c.LinkedIn.ClientSecret == "" {
+		return fmt.Errorf("LinkedIn client_id and client_secret are required in %s or $%s and $%s", source, ClientIDEnv, ClientSecretEnv)
+	}
+
+	if err := c.Visibility.Validate(); err != nil {
+		return fmt.Errorf("invalid visibility settings in %s: %w", source, err)
+	}
+
+	if err := validateUserIDField(c.LinkedIn.UserIDField); err != nil {
+		return fmt.Errorf("invalid linkedin.user_id_field in %s: %w", source, err)
+	}
+
+	if err := c.validateEvents(); err != nil {
+		return fmt.Errorf("invalid events in %s: %w", source, err)
+	}
+
+	if err := c.validateAccounts(); err != nil {
+		return fmt.Errorf("invalid accounts in %s: %w", source, err)
+	}
+
+	if err := validateAudiences(c.Audiences); err != nil {
+		return fmt.Errorf("invalid audiences in %s: %w", source, err)
+	}
+
+	if err := c.validateRecipes(); err != nil {
+		return fmt.Errorf("invalid recipes in %s: %w", source, err)
+	}
+
+	if c.LinkedIn.APIVersion != "" {
+		if err := linkedin.ValidateAPIVersion(c.LinkedIn.APIVersion); err != nil {
+			return fmt.Errorf("invalid linkedin.api_version in %s: %w", source, err)
+		}
+	}
+
+	if c.LinkedIn.OrganizationURN != "" {
+		if _, err := linkedin.OrganizationURN(c.LinkedIn.OrganizationURN); err != nil {
+			return fmt.Errorf("invalid linkedin.organization_urn in %s: %w", source, err)
+		}
+	}
+
+	if err := c.RateLimits.Validate(); err != nil {
+		return fmt.Errorf("invalid rate_limits in %s: %w", source, err)
+	}
+
+	if err := c.BestOf.Validate(); err != nil {
+		return fmt.Errorf("invalid best_of in %s: %w", source, err)
+	}
+
+	switch c.Storage.Backend {
+	case "", StorageJSON, StorageSQLite:
+	default:
+		return fmt.Errorf("invalid storage.backend in %s: use %s or %s", source, StorageJSON, StorageSQLite)
+	}
+
+	if err := validateTimeoutSeconds(c.Cron.PublishTimeoutSeconds); err != nil {
+		return fmt.Errorf("invalid cron.publish_timeout_seconds in %s: %w", source, err)
+	}
+
+	if err := validateTimeoutSeconds(c.Cron.MediaPublishTimeoutSeconds); err != nil {
+		return fmt.Errorf("invalid cron.media_publish_timeout_seconds in %s: %w", source, err)
+	}
+
+	if c.Cron.PublishIntervalSeconds < 0 {
+		return fmt.Errorf("invalid cron.publish_interval_seconds in %s: it cannot be negative", source)
+	}
+
+	if err := c.Cron.validateRetry(); err != nil {
+		return fmt.Errorf("invalid cron retry settings in %s: %w", source, err)
+	}
+
+	return nil
+}
+
+// ReadConfig reads the config file without validating required fields or creating defaults.
+func ReadConfig() (*Config, error) {
+	data, err := os.ReadFile(ConfigPath())
 	if err != nil {
 		return nil, fmt.Errorf("failed to read config file: %w", err)
 	}
@@ -108,28 +403,59 @@ func LoadConfig() (*Config, error) {
 		return nil, fmt.Errorf("failed to parse config file: %w", err)
 	}
 
-	// Validate required fields
-	if config.LinkedIn.ClientID == "" || config.LinkedIn.ClientSecret == "" {
-		return nil, fmt.Errorf("LinkedIn client_id and client_secret are required in %s", ConfigFile)
-	}
-
 	return &config, nil
 }
 
 // SaveConfig saves the configuration to the config file.
+// The file is written to a temporary file first and renamed so a failed write never leaves a truncated config.
+// Settings overridden from the environment are saved with the values read from the file. Saves are
+// serialized, and hold off identity updates, so the file always holds one consistent config.
 func SaveConfig(config *Config) error {
-	data, err := json.MarshalIndent(config, "", "  ")
+	identityMu.Lock()
+	defer identityMu.Unlock()
+
+	data, err := json.MarshalIndent(config.fileView(), "", "  ")
 	if err != nil {
 		return fmt.Errorf("failed to marshal config: %w", err)
 	}
 
-	return os.WriteFile(ConfigFile, data, restrictedPerm)
+	path := ConfigPath()
+
+	if err := os.MkdirAll(filepath.Dir(path), restrictedDirPerm); err != nil {
+		return fmt.Errorf("failed to create config directory: %w", err)
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".config-*.json")
+	if err != nil {
+		return fmt.Errorf("failed to create temp config file: %w", err)
+	}
+
+	tmpName := tmp.Name()
+
+	defer func() {
+		_ = os.Remove(tmpName) // No-op once renamed
+	}()
+
+	if _, err := tmp.Write(data); err != nil {
+		_ = tmp.Close() // Write error takes precedence
+		return fmt.Errorf("failed to write config: %w", err)
+	}
+
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("failed to write config: %w", err)
+	}
+
+	if err := os.Chmod(tmpName, restrictedPerm); err != nil {
+		return fmt.Errorf("failed to set config permissions: %w", err)
+	}
+
+	return os.Rename(tmpName, ConfigPath())
 }
 
 // LoadToken loads an OAuth token from the specified file.
 func LoadToken(filename string) (*oauth2.Token, error) {
 	if _, err := os.Stat(filename); os.IsNotExist(err) {
-		return nil, fmt.Errorf("token file does not exist: %s", filename)
+		return nil, fmt.Errorf("%w: %s", ErrTokenNotFound, filename)
 	}
 
 	data, err := os.ReadFile(filename)
@@ -137,24 +463,65 @@ func LoadToken(filename string) (*oauth2.Token, error) {
 		return nil, fmt.Errorf("failed to read token file: %w", err)
 	}
 
-	var token oauth2.Token
-	if err := json.Unmarshal(data, &token); err != nil {
-		return nil, fmt.Errorf("failed to parse token file: %w", err)
-	}
-
-	return &token, nil
+	return ParseToken(data)
 }
 
-// SaveToken saves an OAuth token to the specified file.
+// SaveToken saves an OAuth token to the specified file, encrypted when $POSTEDIN_TOKEN_KEY is set.
 func SaveToken(token *oauth2.Token, filename string) error {
-	data, err := json.MarshalIndent(token, "", "  ")
+	data, err := encodeToken(token)
 	if err != nil {
-		return fmt.Errorf("failed to marshal token: %w", err)
+		return err
 	}
 
 	return os.WriteFile(filename, data, restrictedPerm) // More restrictive permissions for token
 }
 
+// CallbackBindAddress returns the address callback servers listen on.
+// The bind address is decoupled from the redirect URL advertised to LinkedIn so the
+// server can bind e.g. 0.0.0.0:8080 inside a container while LinkedIn redirects to a public host.
+func (c *Config) CallbackBindAddress() (string, error) {
+	redirectURL, err := url.Parse(c.LinkedIn.RedirectURL)
+	if err != nil {
+		return "", fmt.Errorf("invalid redirect URL: %w", err)
+	}
+
+	if redirectURL.Scheme != "http" && redirectURL.Scheme != "https" {
+		return "", fmt.Errorf("redirect URL must use http or https scheme")
+	}
+
+	if redirectURL.Host == "" {
+		return "", fmt.Errorf("redirect URL must have a valid host")
+	}
+
+	if c.LinkedIn.BindAddress == "" {
+		return redirectURL.Host, nil
+	}
+
+	if err := ValidateBindAddress(c.LinkedIn.BindAddress); err != nil {
+		return "", err
+	}
+
+	return c.LinkedIn.BindAddress, nil
+}
+
+// ValidateBindAddress checks that a listen address is in host:port form with a valid port.
+func ValidateBindAddress(addr string) error {
+	host, port, err := net.SplitHostPort(addr)
+	if err != nil {
+		return fmt.Errorf("invalid bind address %q (expected host:port, e.g. 0.0.0.0:8080): %w", addr, err)
+	}
+
+	if strings.ContainsAny(host, "/ ") {
+		return fmt.Errorf("invalid bind address host %q", host)
+	}
+
+	if _, err := net.LookupPort("tcp", port); err != nil {
+		return fmt.Errorf("invalid bind address port %q: %w", port, err)
+	}
+
+	return nil
+}
+
 // GetTimezone returns the configured timezone location.
 func (c *Config) GetTimezone() (*time.Location, error) {
 	if c.Timezone.Location == "" {
@@ -213,24 +580,47 @@ func (c *Config) UpdateTimezone(location string) error {
 		return fmt.Errorf("failed to load timezone: %w", err)
 	}
 
-	now := time.Now().In(loc)
-	_, offset := now.Zone()
+	// Update config
+	c.Timezone.Location = location
+	c.Timezone.Offset = currentOffset(loc)
+
+	return nil
+}
+
+// ReconcileOffset recomputes the stored offset from the location's current offset, which
+// changes across DST, and reports whether the stored value was stale and has been corrected.
+func (c *Config) ReconcileOffset() (bool, error) {
+	if c.Timezone.Location == "" {
+		return false, nil
+	}
+
+	loc, err := time.LoadLocation(c.Timezone.Location)
+	if err != nil {
+		return false, fmt.Errorf("failed to load timezone: %w", err)
+	}
+
+	offset := currentOffset(loc)
+	if offset == c.Timezone.Offset {
+		return false, nil
+	}
+
+	c.Timezone.Offset = offset
+
+	return true, nil
+}
+
+// currentOffset formats the location's current UTC offset as "+HH:MM".
+func currentOffset(loc *time.Location) string {
+	_, offset := time.Now().In(loc).Zone()
 
 	hours := offset / secondsPerHour
 	minutes := (offset % secondsPerHour) / secondsPerMinute
 
-	var offsetStr string
 	if offset >= 0 {
-		offsetStr = fmt.Sprintf("+%02d:%02d", hours, minutes)
-	} else {
-		offsetStr = fmt.Sprintf("-%02d:%02d", -hours, -minutes)
+		return fmt.Sprintf("+%02d:%02d", hours, minutes)
 	}
 
-	// Update config
-	c.Timezone.Location = location
-	c.Timezone.Offset = offsetStr
-
-	return nil
+	return fmt.Sprintf("-%02d:%02d", -hours, -minutes)
 }
 
 // GetTimezoneInfo returns formatted timezone information.
@@ -247,3 +637,17 @@ func GetCommonTimezones() []timezone.Info {
 func DetectLocalTimezone() (location, offset string, err error) {
 	return timezone.DetectLocalTimezone()
 }
+
+// VerifyLocation checks that loc, the location a scheduler runs in, is the configured timezone.
+func (c *Config) VerifyLocation(loc *time.Location) error {
+	want, err := c.GetTimezone()
+	if err != nil {
+		return fmt.Errorf("configured timezone %q cannot be loaded (%w); the scheduler runs in %s instead", c.Timezone.Location, err, loc)
+	}
+
+	if loc.String() != want.String() {
+		return fmt.Errorf("the scheduler runs in %s but the configured timezone is %s; restart to apply it", loc, want)
+	}
+
+	return nil
+}
@@ -0,0 +1,127 @@
+package config
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestCallbackBindAddress(t *testing.T) {
+	tests := []struct {
+		name        string
+		redirectURL string
+		bind        string
+		want        string
+		wantErr     bool
+	}{
+		{"redirect host", "http://localhost:8080/callback", "", "localhost:8080", false},
+		{"bind decoupled from redirect", "https://scheduler.example.com/callback", "0.0.0.0:8080", "0.0.0.0:8080", false},
+		{"invalid bind", "http://localhost:8080/callback", "8080", "", true},
+		{"bad scheme", "ftp://localhost:8080/callback", "", "", true},
+		{"no host", "http:///callback", "", "", true},
+	}
+
+	for _, tt := range tests {
+		cfg := &Config{LinkedIn: LinkedInConfig{RedirectURL: tt.redirectURL, BindAddress: tt.bind}}
+
+		got, err := cfg.CallbackBindAddress()
+		if (err != nil) != tt.wantErr {
+			t.Errorf("%s: error = %v, wantErr %v", tt.name, err, tt.wantErr)
+			continue
+		}
+
+		if got != tt.want {
+			t.Errorf("%s: address = %q, want %q", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestValidateBindAddress(t *testing.T) {
+	for _, addr := range []string{"0.0.0.0:8080", ":8080", "[::1]:443", "localhost:http"} {
+		if err := ValidateBindAddress(addr); err != nil {
+			t.Errorf("ValidateBindAddress(%q): %v", addr, err)
+		}
+	}
+
+	for _, addr := range []string{"", "8080", "localhost", "local host:8080", "0.0.0.0:notaport", "0.0.0.0:99999"} {
+		if err := ValidateBindAddress(addr); err == nil {
+			t.Errorf("ValidateBindAddress(%q) succeeded, want an error", addr)
+		}
+	}
+}
+
+func TestReconcileOffset(t *testing.T) {
+	if _, err := time.LoadLocation("Asia/Kolkata"); err != nil {
+		t.Skipf("timezone data unavailable: %v", err)
+	}
+
+	cfg := &Config{Timezone: TimezoneConfig{Location: "Asia/Kolkata", Offset: "+05:00"}}
+
+	corrected, err := cfg.ReconcileOffset()
+	if err != nil {
+		t.Fatalf("ReconcileOffset: %v", err)
+	}
+
+	if !corrected || cfg.Timezone.Offset != "+05:30" {
+		t.Errorf("stale offset: corrected %v to %q, want +05:30", corrected, cfg.Timezone.Offset)
+	}
+
+	if corrected, err := cfg.ReconcileOffset(); err != nil || corrected {
+		t.Errorf("current offset: corrected %v, err %v, want it left alone", corrected, err)
+	}
+
+	cfg = &Config{Timezone: TimezoneConfig{Location: "America/Argentina/Buenos_Aires"}}
+	if _, err := cfg.ReconcileOffset(); err != nil || cfg.Timezone.Offset != "-03:00" {
+		t.Errorf("negative offset = %q, err %v, want -03:00", cfg.Timezone.Offset, err)
+	}
+}
+
+func TestReconcileOffsetWithoutLocation(t *testing.T) {
+	cfg := &Config{Timezone: TimezoneConfig{Offset: "+02:00"}}
+
+	if corrected, err := cfg.ReconcileOffset(); err != nil || corrected || cfg.Timezone.Offset != "+02:00" {
+		t.Errorf("no location: corrected %v, err %v, offset %q", corrected, err, cfg.Timezone.Offset)
+	}
+
+	cfg.Timezone.Location = "Mars/Olympus_Mons"
+	if _, err := cfg.ReconcileOffset(); err == nil {
+		t.Error("ReconcileOffset accepted an unknown location")
+	}
+}
+
+func TestLoadConfigCorrectsStaleOffset(t *testing.T) {
+	useTestConfig(t, "")
+
+	// useTestConfig sets the UTC location without an offset, so the stored offset is stale
+	cfg, err := LoadConfig()
+	if err != nil {
+		t.Fatalf("LoadConfig: %v", err)
+	}
+
+	if cfg.Timezone.Offset != "+00:00" {
+		t.Errorf("loaded offset = %q, want +00:00", cfg.Timezone.Offset)
+	}
+
+	saved, err := os.ReadFile(ConfigPath())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var stored Config
+	if err := json.Unmarshal(saved, &stored); err != nil {
+		t.Fatalf("saved config is not JSON: %v", err)
+	}
+
+	if stored.Timezone.Offset != "+00:00" {
+		t.Errorf("saved offset = %q, want the corrected +00:00", stored.Timezone.Offset)
+	}
+}
+
+func TestLoadTokenMissing(t *testing.T) {
+	if _, err := LoadToken(filepath.Join(t.TempDir(), "token.json")); !errors.Is(err, ErrTokenNotFound) {
+		t.Errorf("LoadToken of a missing file = %v, want ErrTokenNotFound", err)
+	}
+}
@@ -0,0 +1,61 @@
+package config
+
+import "os"
+
+// Environment variables that override config file settings when set, so secrets need not be on disk.
+const (
+	ClientIDEnv       = "POSTEDIN_CLIENT_ID"
+	ClientSecretEnv   = "POSTEDIN_CLIENT_SECRET"
+	RedirectURLEnv    = "POSTEDIN_REDIRECT_URL"
+	PostsFileEnv      = "POSTEDIN_POSTS_FILE"
+	TokenFileEnv      = "POSTEDIN_TOKEN_FILE"
+	ReplicaFileEnv    = "POSTEDIN_REPLICA_FILE"
+	AssetCacheFileEnv = "POSTEDIN_ASSET_CACHE_FILE"
+)
+
+// envFields maps each override variable to the setting it replaces.
+var envFields = map[string]func(*Config) *string{
+	ClientIDEnv:       func(c *Config) *string { return &c.LinkedIn.ClientID },
+	ClientSecretEnv:   func(c *Config) *string { return &c.LinkedIn.ClientSecret },
+	RedirectURLEnv:    func(c *Config) *string { return &c.LinkedIn.RedirectURL },
+	PostsFileEnv:      func(c *Config) *string { return &c.Storage.PostsFile },
+	TokenFileEnv:      func(c *Config) *string { return &c.Storage.TokenFile },
+	ReplicaFileEnv:    func(c *Config) *string { return &c.Storage.ReplicaFile },
+	AssetCacheFileEnv: func(c *Config) *string { return &c.Storage.AssetCacheFile },
+}
+
+// applyEnvOverrides replaces settings with the override variables that are set, remembering the
+// file's values so SaveConfig never writes the overrides to disk.
+func (c *Config) applyEnvOverrides() {
+	for env, field := range envFields {
+		value := os.Getenv(env)
+		if value == "" {
+			continue
+		}
+
+		if c.fileValues == nil {
+			c.fileValues = make(map[string]string)
+		}
+
+		if _, ok := c.fileValues[env]; !ok {
+			c.fileValues[env] = *field(c)
+		}
+
+		*field(c) = value
+	}
+}
+
+// fileView returns the config as it should be saved: c with the overridden settings restored to
+// the values read from the file.
+func (c *Config) fileView() *Config {
+	if len(c.fileValues) == 0 {
+		return c
+	}
+
+	view := *c
+	for env, value := range c.fileValues {
+		*envFields[env](&view) = value
+	}
+
+	return &view
+}
@@ -0,0 +1,73 @@
+package config
+
+import (
+	"fmt"
+	"sort"
+	"time"
+)
+
+// eventTimeLayout is the format of event times in the events section, in the configured timezone.
+const eventTimeLayout = "2006-01-02 15:04"
+
+// EventTime returns the time of the named event in the configured timezone.
+func (c *Config) EventTime(name string) (time.Time, error) {
+	value, ok := c.Events[name]
+	if !ok {
+		return time.Time{}, fmt.Errorf("unknown event %q", name)
+	}
+
+	loc, err := c.GetTimezone()
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	at, err := time.ParseInLocation(eventTimeLayout, value, loc)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("event %q has invalid time %q, use 'YYYY-MM-DD HH:MM'", name, value)
+	}
+
+	return at, nil
+}
+
+// SetEvent creates or moves the named event; call SaveConfig to persist it.
+func (c *Config) SetEvent(name string, at time.Time) error {
+	if name == "" {
+		return fmt.Errorf("event name is required")
+	}
+
+	loc, err := c.GetTimezone()
+	if err != nil {
+		return err
+	}
+
+	if c.Events == nil {
+		c.Events = make(map[string]string)
+	}
+
+	c.Events[name] = at.In(loc).Format(eventTimeLayout)
+
+	return nil
+}
+
+// EventNames returns the configured event names, sorted.
+func (c *Config) EventNames() []string {
+	names := make([]string, 0, len(c.Events))
+	for name := range c.Events {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	return names
+}
+
+// validateEvents checks that every event time parses.
+func (c *Config) validateEvents() error {
+	for name := range c.Events {
+		if _, err := c.EventTime(name); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
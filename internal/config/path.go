@@ -0,0 +1,36 @@
+package config
+
+import "os"
+
+// ConfigEnv is the environment variable selecting the config file when no --config flag is given.
+const ConfigEnv = "POSTEDIN_CONFIG"
+
+// configPath is set once at startup, before any config is loaded.
+var configPath = ConfigFile
+
+// ConfigPath returns the config file read by LoadConfig and ReadConfig and written by SaveConfig.
+func ConfigPath() string {
+	return configPath
+}
+
+// SetConfigPath selects the config file used by this process; an empty path restores the default.
+func SetConfigPath(path string) {
+	if path == "" {
+		path = ConfigFile
+	}
+
+	configPath = path
+}
+
+// UseConfigPath selects the config file from the --config flag value, then $POSTEDIN_CONFIG,
+// then the default, and returns the chosen path.
+func UseConfigPath(flagValue string) string {
+	path := flagValue
+	if path == "" {
+		path = os.Getenv(ConfigEnv)
+	}
+
+	SetConfigPath(path)
+
+	return ConfigPath()
+}
@@ -0,0 +1,71 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestUseConfigPath(t *testing.T) {
+	t.Cleanup(func() { SetConfigPath("") })
+
+	t.Setenv(ConfigEnv, "")
+
+	if got := UseConfigPath(""); got != ConfigFile {
+		t.Errorf("no flag or env: path = %q, want %q", got, ConfigFile)
+	}
+
+	t.Setenv(ConfigEnv, "/etc/postedin/staging.json")
+
+	if got := UseConfigPath(""); got != "/etc/postedin/staging.json" {
+		t.Errorf("env only: path = %q, want $%s", got, ConfigEnv)
+	}
+
+	if got := UseConfigPath("prod.json"); got != "prod.json" || ConfigPath() != "prod.json" {
+		t.Errorf("flag and env: path = %q, want the flag", got)
+	}
+}
+
+func TestCustomConfigPathLoadAndSave(t *testing.T) {
+	t.Chdir(t.TempDir())
+
+	path := filepath.Join(t.TempDir(), "staging", "config.json")
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		t.Fatal(err)
+	}
+
+	data := `{"linkedin": {"client_id": "staging-id", "client_secret": "secret"}, "timezone": {"location": "UTC"}}`
+	if err := os.WriteFile(path, []byte(data), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	SetConfigPath(path)
+	t.Cleanup(func() { SetConfigPath("") })
+
+	cfg, err := LoadConfig()
+	if err != nil {
+		t.Fatalf("LoadConfig: %v", err)
+	}
+
+	if cfg.LinkedIn.ClientID != "staging-id" {
+		t.Fatalf("client_id = %q, want the custom file's", cfg.LinkedIn.ClientID)
+	}
+
+	cfg.LinkedIn.ClientID = "changed"
+	if err := SaveConfig(cfg); err != nil {
+		t.Fatalf("SaveConfig: %v", err)
+	}
+
+	reloaded, err := LoadConfig()
+	if err != nil {
+		t.Fatalf("reload: %v", err)
+	}
+
+	if reloaded.LinkedIn.ClientID != "changed" {
+		t.Errorf("reloaded client_id = %q, want the saved one", reloaded.LinkedIn.ClientID)
+	}
+
+	if _, err := os.Stat(ConfigFile); !os.IsNotExist(err) {
+		t.Errorf("the default %s was touched: %v", ConfigFile, err)
+	}
+}
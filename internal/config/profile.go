@@ -0,0 +1,46 @@
+package config
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"PostedIn/pkg/linkedin"
+)
+
+// ApplyProfile stores the user ID read from a LinkedIn profile payload, honoring linkedin.user_id_field,
+// so every sign-in flow records the same identifier. It clears the cached author URN: the URN format is
+// only known once LinkedIn accepts a post, so the next publish probes and caches it. Call SaveConfig to persist them.
+func (c *Config) ApplyProfile(profile map[string]interface{}) error {
+	identityMu.Lock()
+	defer identityMu.Unlock()
+
+	id, err := linkedin.ProfileUserID(profile, c.LinkedIn.UserIDField)
+	if err != nil {
+		return err
+	}
+
+	c.LinkedIn.UserID = id
+	c.LinkedIn.AuthorURN = ""
+
+	return nil
+}
+
+// OAuthScopes returns the scopes to request when authenticating, adding w_organization_social when
+// linkedin.organization_urn is set so the member can post as the page.
+func (c *Config) OAuthScopes() []string {
+	if c.LinkedIn.OrganizationURN == "" {
+		return linkedin.DefaultScopes
+	}
+
+	return append(slices.Clone(linkedin.DefaultScopes), linkedin.ScopeOrganizationSocial)
+}
+
+// validateUserIDField checks linkedin.user_id_field names a known profile field; empty auto-detects.
+func validateUserIDField(field string) error {
+	if field == "" || slices.Contains(linkedin.ProfileIDFields(), field) {
+		return nil
+	}
+
+	return fmt.Errorf("user_id_field must be empty (auto-detect) or one of: %s", strings.Join(linkedin.ProfileIDFields(), ", "))
+}
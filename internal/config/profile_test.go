@@ -0,0 +1,73 @@
+package config
+
+import "testing"
+
+func TestApplyProfileClearsCachedAuthorURN(t *testing.T) {
+	cfg := &Config{LinkedIn: LinkedInConfig{AuthorURN: "urn:li:person:previous"}}
+
+	if err := cfg.ApplyProfile(map[string]interface{}{"sub": "abc123"}); err != nil {
+		t.Fatalf("ApplyProfile: %v", err)
+	}
+
+	if cfg.LinkedIn.UserID != "abc123" {
+		t.Errorf("UserID = %q, want %q", cfg.LinkedIn.UserID, "abc123")
+	}
+
+	// The URN format is unverified until LinkedIn accepts a post, so sign-in must not guess one
+	if cfg.LinkedIn.AuthorURN != "" {
+		t.Errorf("AuthorURN = %q, want it cleared", cfg.LinkedIn.AuthorURN)
+	}
+}
+
+func TestApplyProfileUserIDField(t *testing.T) {
+	// A profile answering both endpoints: OpenID userinfo carries "sub", the v2 /me response "id"
+	profile := map[string]interface{}{"sub": "openid-sub", "id": "legacy-id"}
+
+	tests := []struct {
+		field   string
+		profile map[string]interface{}
+		want    string
+	}{
+		{field: "", profile: profile, want: "openid-sub"},
+		{field: "sub", profile: profile, want: "openid-sub"},
+		{field: "id", profile: profile, want: "legacy-id"},
+		{field: "", profile: map[string]interface{}{"id": "legacy-id"}, want: "legacy-id"},
+		{field: "sub", profile: map[string]interface{}{"sub": "  ", "id": "legacy-id"}, want: "legacy-id"},
+	}
+
+	for _, tt := range tests {
+		cfg := &Config{LinkedIn: LinkedInConfig{UserIDField: tt.field}}
+
+		if err := cfg.ApplyProfile(tt.profile); err != nil {
+			t.Fatalf("ApplyProfile(%v) with field %q: %v", tt.profile, tt.field, err)
+		}
+
+		if cfg.LinkedIn.UserID != tt.want {
+			t.Errorf("ApplyProfile(%v) with field %q stored %q, want %q", tt.profile, tt.field, cfg.LinkedIn.UserID, tt.want)
+		}
+	}
+}
+
+func TestApplyProfileWithoutIdentifier(t *testing.T) {
+	cfg := &Config{LinkedIn: LinkedInConfig{UserID: "previous"}}
+
+	if err := cfg.ApplyProfile(map[string]interface{}{"name": "Ada", "id": 42}); err == nil {
+		t.Fatal("ApplyProfile accepted a profile without a string identifier")
+	}
+
+	if cfg.LinkedIn.UserID != "previous" {
+		t.Errorf("UserID = %q, want the previous one kept", cfg.LinkedIn.UserID)
+	}
+}
+
+func TestValidateUserIDField(t *testing.T) {
+	for _, field := range []string{"", "sub", "id"} {
+		if err := validateUserIDField(field); err != nil {
+			t.Errorf("validateUserIDField(%q): %v", field, err)
+		}
+	}
+
+	if err := validateUserIDField("email"); err == nil {
+		t.Error("validateUserIDField accepted an unknown field")
+	}
+}
@@ -0,0 +1,53 @@
+package config
+
+import (
+	"fmt"
+
+	"PostedIn/internal/ratelimit"
+)
+
+// RateLimitConfig bounds publishing across all accounts and for each LinkedIn account.
+type RateLimitConfig struct {
+	Global     ratelimit.Policy `json:"global"`
+	PerAccount ratelimit.Policy `json:"per_account"`
+	// Accounts overrides PerAccount for specific accounts, keyed by LinkedIn user ID.
+	Accounts map[string]ratelimit.Policy `json:"accounts,omitempty"`
+}
+
+// AccountID returns the key the account's publishes are limited under: the LinkedIn user ID.
+func (c *Config) AccountID() string {
+	if c.LinkedIn.UserID != "" {
+		return c.LinkedIn.UserID
+	}
+
+	// Keys the per-account limit before the LinkedIn user ID is known
+	return DefaultAccount
+}
+
+// AccountPolicy returns the rate limit policy of an account.
+func (r RateLimitConfig) AccountPolicy(account string) ratelimit.Policy {
+	if policy, ok := r.Accounts[account]; ok {
+		return policy
+	}
+
+	return r.PerAccount
+}
+
+// Validate checks every configured policy.
+func (r RateLimitConfig) Validate() error {
+	if err := r.Global.Validate(); err != nil {
+		return fmt.Errorf("global: %w", err)
+	}
+
+	if err := r.PerAccount.Validate(); err != nil {
+		return fmt.Errorf("per_account: %w", err)
+	}
+
+	for account, policy := range r.Accounts {
+		if err := policy.Validate(); err != nil {
+			return fmt.Errorf("accounts.%s: %w", account, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,52 @@
+package config
+
+import (
+	"testing"
+
+	"PostedIn/internal/ratelimit"
+)
+
+func TestAccountPolicy(t *testing.T) {
+	limits := RateLimitConfig{
+		PerAccount: ratelimit.Policy{MaxConcurrent: 1},
+		Accounts:   map[string]ratelimit.Policy{"busy": {MaxConcurrent: 3, PerMinute: 5}},
+	}
+
+	if got := limits.AccountPolicy("busy"); got != (ratelimit.Policy{MaxConcurrent: 3, PerMinute: 5}) {
+		t.Errorf("AccountPolicy(busy) = %+v, want its override", got)
+	}
+
+	if got := limits.AccountPolicy("other"); got != limits.PerAccount {
+		t.Errorf("AccountPolicy(other) = %+v, want per_account", got)
+	}
+}
+
+func TestRateLimitValidate(t *testing.T) {
+	if err := (RateLimitConfig{}).Validate(); err != nil {
+		t.Errorf("Validate of unlimited config: %v", err)
+	}
+
+	invalid := []RateLimitConfig{
+		{Global: ratelimit.Policy{MaxConcurrent: -1}},
+		{PerAccount: ratelimit.Policy{PerMinute: -1}},
+		{Accounts: map[string]ratelimit.Policy{"a": {MaxConcurrent: -2}}},
+	}
+
+	for _, limits := range invalid {
+		if err := limits.Validate(); err == nil {
+			t.Errorf("Validate(%+v) accepted a negative limit", limits)
+		}
+	}
+}
+
+func TestAccountID(t *testing.T) {
+	cfg := &Config{}
+	if cfg.AccountID() != DefaultAccount {
+		t.Errorf("AccountID = %q before sign-in, want %q", cfg.AccountID(), DefaultAccount)
+	}
+
+	cfg.LinkedIn.UserID = "abc"
+	if cfg.AccountID() != "abc" {
+		t.Errorf("AccountID = %q, want the user ID", cfg.AccountID())
+	}
+}
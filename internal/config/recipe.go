@@ -0,0 +1,83 @@
+package config
+
+import (
+	"fmt"
+	"sort"
+	"text/template"
+	"time"
+)
+
+// Recipe is a reusable post: a content template filled with --var values, a schedule phrase
+// such as "next monday 10am", and default tags, image and audience.
+type Recipe struct {
+	Template     string   `json:"template"`           // text/template, e.g. "New release {{.version}} is out on {{weekday}}"
+	Schedule     string   `json:"schedule,omitempty"` // 'YYYY-MM-DD HH:MM' or a phrase; required unless a time is given when applying
+	Tags         []string `json:"tags,omitempty"`
+	ImagePath    string   `json:"image_path,omitempty"`
+	ImageAltText string   `json:"image_alt_text,omitempty"`
+	Audience     string   `json:"audience,omitempty"` // Name of an audience configured under audiences
+
+	SampleVars map[string]string `json:"sample_vars,omitempty"` // Values templates test renders the template with
+}
+
+// Recipe returns the named recipe from the recipes section of the config.
+func (c *Config) Recipe(name string) (Recipe, error) {
+	recipe, ok := c.Recipes[name]
+	if !ok {
+		return Recipe{}, fmt.Errorf("unknown recipe %q (configured: %v)", name, c.RecipeNames())
+	}
+
+	return recipe, nil
+}
+
+// RecipeNames returns the configured recipe names, sorted.
+func (c *Config) RecipeNames() []string {
+	names := make([]string, 0, len(c.Recipes))
+	for name := range c.Recipes {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	return names
+}
+
+// ParseTemplate parses the recipe's content template.
+func (r Recipe) ParseTemplate(name string) (*template.Template, error) {
+	if r.Template == "" {
+		return nil, fmt.Errorf("template is required")
+	}
+
+	tmpl, err := template.New(name).Option("missingkey=error").Funcs(TemplateFuncs(time.Time{})).Parse(r.Template)
+	if err != nil {
+		return nil, fmt.Errorf("invalid template: %w", err)
+	}
+
+	return tmpl, nil
+}
+
+// TemplateFuncs returns the functions recipe templates can call, such as {{date}} and {{weekday}},
+// which render the date of at.
+func TemplateFuncs(at time.Time) template.FuncMap {
+	return template.FuncMap{
+		"date":    func() string { return at.Format("2006-01-02") },
+		"weekday": func() string { return at.Weekday().String() },
+	}
+}
+
+// validateRecipes checks that every recipe's template parses and its audience exists.
+func (c *Config) validateRecipes() error {
+	for name, recipe := range c.Recipes {
+		if _, err := recipe.ParseTemplate(name); err != nil {
+			return fmt.Errorf("recipe %q: %w", name, err)
+		}
+
+		if recipe.Audience != "" {
+			if _, err := c.Audience(recipe.Audience); err != nil {
+				return fmt.Errorf("recipe %q: %w", name, err)
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,69 @@
+package config
+
+import (
+	"fmt"
+	"time"
+)
+
+// Publish retry defaults used when the cron.retry_* settings are 0.
+const (
+	DefaultRetryMaxAttempts   = 3
+	DefaultRetryInitialDelay  = time.Minute
+	DefaultRetryBackoffFactor = 2
+	maxRetryAttempts          = 10
+)
+
+// RetryDelay returns how long to wait before retrying a publish that failed on its attempts-th
+// attempt, and false once the configured attempts are used up.
+func (c CronConfig) RetryDelay(attempts int) (time.Duration, bool) {
+	maxAttempts := c.RetryMaxAttempts
+	if maxAttempts == 0 {
+		maxAttempts = DefaultRetryMaxAttempts
+	}
+
+	if attempts >= maxAttempts {
+		return 0, false
+	}
+
+	delay := DefaultRetryInitialDelay
+	if c.RetryInitialDelaySeconds > 0 {
+		delay = time.Duration(c.RetryInitialDelaySeconds) * time.Second
+	}
+
+	factor := c.RetryBackoffFactor
+	if factor == 0 {
+		factor = DefaultRetryBackoffFactor
+	}
+
+	for i := 1; i < attempts; i++ {
+		delay *= time.Duration(factor)
+	}
+
+	return delay, true
+}
+
+// validateRetry checks the publish retry settings; 0 selects the defaults.
+func (c CronConfig) validateRetry() error {
+	if err := validateRetryAttempts(c.RetryMaxAttempts); err != nil {
+		return err
+	}
+
+	if c.RetryInitialDelaySeconds < 0 {
+		return fmt.Errorf("retry_initial_delay_seconds cannot be negative")
+	}
+
+	if c.RetryBackoffFactor < 0 {
+		return fmt.Errorf("retry_backoff_factor must be at least 1, or 0 for the default")
+	}
+
+	return nil
+}
+
+// validateRetryAttempts checks cron.retry_max_attempts; 0 selects the default.
+func validateRetryAttempts(attempts int) error {
+	if attempts < 0 || attempts > maxRetryAttempts {
+		return fmt.Errorf("retry_max_attempts must be between 1 and %d, or 0 for the default", maxRetryAttempts)
+	}
+
+	return nil
+}
@@ -0,0 +1,46 @@
+package config
+
+import (
+	"testing"
+	"time"
+)
+
+func TestRetryDelay(t *testing.T) {
+	tests := []struct {
+		name     string
+		cron     CronConfig
+		attempts int
+		want     time.Duration
+		ok       bool
+	}{
+		{"default first retry", CronConfig{}, 1, time.Minute, true},
+		{"default backoff", CronConfig{}, 2, 2 * time.Minute, true},
+		{"default attempts used up", CronConfig{}, 3, 0, false},
+		{"custom", CronConfig{RetryMaxAttempts: 5, RetryInitialDelaySeconds: 30, RetryBackoffFactor: 3}, 3, 270 * time.Second, true},
+		{"custom attempts used up", CronConfig{RetryMaxAttempts: 5}, 5, 0, false},
+		{"single attempt", CronConfig{RetryMaxAttempts: 1}, 1, 0, false},
+	}
+
+	for _, tt := range tests {
+		got, ok := tt.cron.RetryDelay(tt.attempts)
+		if got != tt.want || ok != tt.ok {
+			t.Errorf("%s: RetryDelay(%d) = %v, %v, want %v, %v", tt.name, tt.attempts, got, ok, tt.want, tt.ok)
+		}
+	}
+}
+
+func TestValidateRetry(t *testing.T) {
+	valid := []CronConfig{{}, {RetryMaxAttempts: 10, RetryInitialDelaySeconds: 5, RetryBackoffFactor: 1}}
+	for _, cron := range valid {
+		if err := cron.validateRetry(); err != nil {
+			t.Errorf("validateRetry(%+v): %v", cron, err)
+		}
+	}
+
+	invalid := []CronConfig{{RetryMaxAttempts: -1}, {RetryMaxAttempts: 11}, {RetryInitialDelaySeconds: -1}, {RetryBackoffFactor: -2}}
+	for _, cron := range invalid {
+		if err := cron.validateRetry(); err == nil {
+			t.Errorf("validateRetry(%+v) accepted invalid settings", cron)
+		}
+	}
+}
@@ -0,0 +1,54 @@
+package config
+
+import "time"
+
+// SLO defaults used when the slo section leaves a setting unset.
+const (
+	defaultSuccessTargetPercent = 95
+	defaultLatencyTarget        = 5 * time.Minute
+)
+
+// defaultSLOWindowHours are the rolling windows reported when slo.window_hours is empty: a day and a week.
+var defaultSLOWindowHours = []int{24, 7 * 24}
+
+// SLOConfig sets the publish reliability targets reported by the diagnostics and the SLO endpoint.
+type SLOConfig struct {
+	WindowHours             []int `json:"window_hours,omitempty"`               // Rolling windows in hours; default 24 and 168
+	SuccessTargetPercent    int   `json:"success_target_percent,omitempty"`     // Minimum success rate; default 95
+	P95LatencyTargetSeconds int   `json:"p95_latency_target_seconds,omitempty"` // Maximum p95 delay after the scheduled time; default 300
+}
+
+// Windows returns the rolling windows to report, in hours, ignoring non-positive entries.
+func (c SLOConfig) Windows() []int {
+	var windows []int
+
+	for _, hours := range c.WindowHours {
+		if hours > 0 {
+			windows = append(windows, hours)
+		}
+	}
+
+	if len(windows) == 0 {
+		return defaultSLOWindowHours
+	}
+
+	return windows
+}
+
+// SuccessTarget returns the minimum success rate in percent.
+func (c SLOConfig) SuccessTarget() int {
+	if c.SuccessTargetPercent > 0 {
+		return c.SuccessTargetPercent
+	}
+
+	return defaultSuccessTargetPercent
+}
+
+// LatencyTarget returns the maximum p95 publish latency.
+func (c SLOConfig) LatencyTarget() time.Duration {
+	if c.P95LatencyTargetSeconds > 0 {
+		return time.Duration(c.P95LatencyTargetSeconds) * time.Second
+	}
+
+	return defaultLatencyTarget
+}
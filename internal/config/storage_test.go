@@ -0,0 +1,61 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// useTestConfig writes a config file with credentials and extra JSON fields and selects it for the test.
+func useTestConfig(t *testing.T, extra string) {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), "config.json")
+	data := `{"linkedin": {"client_id": "id", "client_secret": "secret"}, "timezone": {"location": "UTC"}` + extra + `}`
+
+	if err := os.WriteFile(path, []byte(data), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	SetConfigPath(path)
+	t.Cleanup(func() { SetConfigPath("") })
+}
+
+func TestPostsPath(t *testing.T) {
+	tests := []struct {
+		name  string
+		extra string
+		env   string
+		want  string
+	}{
+		{name: "default", want: DefaultPostsFile},
+		{name: "config", extra: `, "storage": {"posts_file": "data/posts.json"}`, want: "data/posts.json"},
+		{name: "env overrides config", extra: `, "storage": {"posts_file": "data/posts.json"}`, env: "/srv/posts.json", want: "/srv/posts.json"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			useTestConfig(t, tt.extra)
+			t.Setenv(PostsFileEnv, tt.env)
+
+			cfg, err := LoadConfig()
+			if err != nil {
+				t.Fatalf("LoadConfig: %v", err)
+			}
+
+			if got := cfg.Storage.PostsPath(); got != tt.want {
+				t.Errorf("PostsPath() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidateStorageBackend(t *testing.T) {
+	for backend, wantErr := range map[string]bool{"": false, StorageJSON: false, StorageSQLite: false, "postgres": true} {
+		cfg := &Config{LinkedIn: LinkedInConfig{ClientID: "id", ClientSecret: "secret"}, Storage: StorageConfig{Backend: backend}}
+
+		if err := cfg.Validate("config.json"); (err != nil) != wantErr {
+			t.Errorf("backend %q: Validate() = %v, wantErr %v", backend, err, wantErr)
+		}
+	}
+}
@@ -0,0 +1,19 @@
+package config
+
+import "time"
+
+// DefaultSweepInterval is how often the overdue sweep runs when cron.sweep_interval_minutes is unset.
+const DefaultSweepInterval = 5 * time.Minute
+
+// SweepInterval returns how often the overdue sweep runs, or 0 when the sweep is disabled.
+func (c CronConfig) SweepInterval() time.Duration {
+	if !c.SweepEnabled {
+		return 0
+	}
+
+	if c.SweepIntervalMinutes > 0 {
+		return time.Duration(c.SweepIntervalMinutes) * time.Minute
+	}
+
+	return DefaultSweepInterval
+}
@@ -0,0 +1,42 @@
+package config
+
+import (
+	"fmt"
+	"time"
+)
+
+// Publish timeout defaults and the bounds accepted for cron.publish_timeout_seconds and
+// cron.media_publish_timeout_seconds.
+const (
+	DefaultPublishTimeout      = 2 * time.Minute
+	DefaultMediaPublishTimeout = 10 * time.Minute
+	minPublishTimeoutSeconds   = 10
+	maxPublishTimeoutSeconds   = 2 * 60 * 60
+)
+
+// PublishTimeout returns how long a single publish may take; posts with an image or video
+// get the media timeout since uploads take longer.
+func (c CronConfig) PublishTimeout(media bool) time.Duration {
+	if media {
+		if c.MediaPublishTimeoutSeconds > 0 {
+			return time.Duration(c.MediaPublishTimeoutSeconds) * time.Second
+		}
+
+		return DefaultMediaPublishTimeout
+	}
+
+	if c.PublishTimeoutSeconds > 0 {
+		return time.Duration(c.PublishTimeoutSeconds) * time.Second
+	}
+
+	return DefaultPublishTimeout
+}
+
+// validateTimeoutSeconds checks a timeout setting; 0 selects the default.
+func validateTimeoutSeconds(seconds int) error {
+	if seconds != 0 && (seconds < minPublishTimeoutSeconds || seconds > maxPublishTimeoutSeconds) {
+		return fmt.Errorf("timeout must be between %d and %d seconds, or 0 for the default", minPublishTimeoutSeconds, maxPublishTimeoutSeconds)
+	}
+
+	return nil
+}
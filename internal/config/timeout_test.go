@@ -0,0 +1,68 @@
+package config
+
+import (
+	"testing"
+	"time"
+)
+
+func TestPublishTimeout(t *testing.T) {
+	var defaults CronConfig
+
+	if got := defaults.PublishTimeout(false); got != DefaultPublishTimeout {
+		t.Errorf("default timeout = %v, want %v", got, DefaultPublishTimeout)
+	}
+
+	if got := defaults.PublishTimeout(true); got != DefaultMediaPublishTimeout {
+		t.Errorf("default media timeout = %v, want %v", got, DefaultMediaPublishTimeout)
+	}
+
+	configured := CronConfig{PublishTimeoutSeconds: 30, MediaPublishTimeoutSeconds: 1800}
+
+	if got := configured.PublishTimeout(false); got != 30*time.Second {
+		t.Errorf("configured timeout = %v, want 30s", got)
+	}
+
+	if got := configured.PublishTimeout(true); got != 30*time.Minute {
+		t.Errorf("configured media timeout = %v, want 30m", got)
+	}
+}
+
+func TestLoadConfigValidatesPublishTimeouts(t *testing.T) {
+	for _, extra := range []string{
+		`, "cron": {"publish_timeout_seconds": 1}`,
+		`, "cron": {"media_publish_timeout_seconds": 86400}`,
+	} {
+		useTestConfig(t, extra)
+
+		if _, err := LoadConfig(); err == nil {
+			t.Errorf("LoadConfig accepted %s", extra)
+		}
+	}
+
+	useTestConfig(t, `, "cron": {"publish_timeout_seconds": 60, "media_publish_timeout_seconds": 900}`)
+
+	cfg, err := LoadConfig()
+	if err != nil {
+		t.Fatalf("LoadConfig: %v", err)
+	}
+
+	if got := cfg.Cron.PublishTimeout(true); got != 15*time.Minute {
+		t.Errorf("loaded media timeout = %v, want 15m", got)
+	}
+}
+
+func TestSetPublishTimeoutKey(t *testing.T) {
+	validate := keyValidators["cron.publish_timeout_seconds"]
+
+	for _, value := range []string{"0", "10", "600"} {
+		if err := validate(value); err != nil {
+			t.Errorf("validate(%q): %v", value, err)
+		}
+	}
+
+	for _, value := range []string{"5", "100000", "soon"} {
+		if err := validate(value); err == nil {
+			t.Errorf("validate(%q) succeeded, want an error", value)
+		}
+	}
+}
@@ -0,0 +1,149 @@
+package config
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/pbkdf2"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+
+	"golang.org/x/oauth2"
+)
+
+// TokenKeyEnv holds the passphrase token files are encrypted with; unset stores them in plain text.
+const TokenKeyEnv = "POSTEDIN_TOKEN_KEY"
+
+const (
+	tokenKeyIterations = 600_000 // OWASP's recommendation for PBKDF2-HMAC-SHA256
+	tokenKeyLength     = 32      // AES-256
+	tokenSaltLength    = 16
+)
+
+// tokenMagic starts every encrypted token file, followed by the salt, the nonce and the sealed JSON.
+var tokenMagic = []byte("POSTEDIN-TOKEN-1\n")
+
+// ErrTokenNotFound is returned when loading a token file that does not exist, i.e. before authenticating.
+var ErrTokenNotFound = errors.New("token file does not exist")
+
+// ErrTokenKeyRequired is returned when loading an encrypted token without $POSTEDIN_TOKEN_KEY set.
+var ErrTokenKeyRequired = errors.New("token file is encrypted, set " + TokenKeyEnv + " to load it")
+
+// tokenKeys caches derived keys by passphrase and salt, as every publish loads the token.
+var tokenKeys sync.Map
+
+// IsEncryptedToken reports whether data is an encrypted token file.
+func IsEncryptedToken(data []byte) bool {
+	return bytes.HasPrefix(data, tokenMagic)
+}
+
+// ParseToken parses the contents of a token file, decrypting it with $POSTEDIN_TOKEN_KEY when it is
+// encrypted. Plain-text token files load whether or not the key is set.
+func ParseToken(data []byte) (*oauth2.Token, error) {
+	if IsEncryptedToken(data) {
+		plain, err := decryptToken(data[len(tokenMagic):])
+		if err != nil {
+			return nil, err
+		}
+
+		data = plain
+	}
+
+	var token oauth2.Token
+	if err := json.Unmarshal(data, &token); err != nil {
+		return nil, fmt.Errorf("failed to parse token file: %w", err)
+	}
+
+	return &token, nil
+}
+
+// encodeToken marshals the token, encrypting it when $POSTEDIN_TOKEN_KEY is set.
+func encodeToken(token *oauth2.Token) ([]byte, error) {
+	data, err := json.MarshalIndent(token, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal token: %w", err)
+	}
+
+	passphrase := os.Getenv(TokenKeyEnv)
+	if passphrase == "" {
+		return data, nil
+	}
+
+	salt := make([]byte, tokenSaltLength)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, fmt.Errorf("failed to generate salt: %w", err)
+	}
+
+	gcm, err := tokenCipher(passphrase, salt)
+	if err != nil {
+		return nil, err
+	}
+
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, fmt.Errorf("failed to generate nonce: %w", err)
+	}
+
+	out := append(append(append([]byte{}, tokenMagic...), salt...), nonce...)
+
+	return gcm.Seal(out, nonce, data, nil), nil
+}
+
+// decryptToken opens the salt, nonce and sealed JSON that follow the magic header.
+func decryptToken(data []byte) ([]byte, error) {
+	passphrase := os.Getenv(TokenKeyEnv)
+	if passphrase == "" {
+		return nil, ErrTokenKeyRequired
+	}
+
+	if len(data) < tokenSaltLength {
+		return nil, fmt.Errorf("encrypted token file is truncated")
+	}
+
+	salt, data := data[:tokenSaltLength], data[tokenSaltLength:]
+
+	gcm, err := tokenCipher(passphrase, salt)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(data) < gcm.NonceSize() {
+		return nil, fmt.Errorf("encrypted token file is truncated")
+	}
+
+	nonce, sealed := data[:gcm.NonceSize()], data[gcm.NonceSize():]
+
+	plain, err := gcm.Open(nil, nonce, sealed, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt token file, check %s: %w", TokenKeyEnv, err)
+	}
+
+	return plain, nil
+}
+
+// tokenCipher derives the AES-256-GCM cipher of a token file from the passphrase and its salt.
+func tokenCipher(passphrase string, salt []byte) (cipher.AEAD, error) {
+	cacheKey := string(salt) + "\x00" + passphrase
+
+	key, ok := tokenKeys.Load(cacheKey)
+	if !ok {
+		derived, err := pbkdf2.Key(sha256.New, passphrase, salt, tokenKeyIterations, tokenKeyLength)
+		if err != nil {
+			return nil, fmt.Errorf("failed to derive token key: %w", err)
+		}
+
+		key, _ = tokenKeys.LoadOrStore(cacheKey, derived)
+	}
+
+	block, err := aes.NewCipher(key.([]byte))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create cipher: %w", err)
+	}
+
+	return cipher.NewGCM(block)
+}
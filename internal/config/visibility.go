@@ -0,0 +1,127 @@
+package config
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"PostedIn/pkg/linkedin"
+)
+
+// VisibilityConfig picks the default LinkedIn visibility of a post from its scheduled day and time.
+type VisibilityConfig struct {
+	Default string           `json:"default,omitempty"` // Used when no rule matches; PUBLIC when empty
+	Rules   []VisibilityRule `json:"rules,omitempty"`   // Evaluated in order, the first match wins
+}
+
+// VisibilityRule applies a visibility on the given days between From (inclusive) and To (exclusive).
+// Days accepts "mon".."sun", "weekdays" and "weekend"; empty Days matches every day. Empty From/To
+// cover the whole day, and a From later than To wraps past midnight.
+type VisibilityRule struct {
+	Days       []string `json:"days,omitempty"`
+	From       string   `json:"from,omitempty"`
+	To         string   `json:"to,omitempty"`
+	Visibility string   `json:"visibility"`
+}
+
+var dayNames = map[string][]time.Weekday{
+	"sun":      {time.Sunday},
+	"mon":      {time.Monday},
+	"tue":      {time.Tuesday},
+	"wed":      {time.Wednesday},
+	"thu":      {time.Thursday},
+	"fri":      {time.Friday},
+	"sat":      {time.Saturday},
+	"weekdays": {time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday},
+	"weekend":  {time.Saturday, time.Sunday},
+}
+
+// Validate checks the default visibility and every rule's days, times and visibility.
+func (v VisibilityConfig) Validate() error {
+	if v.Default != "" {
+		if _, err := linkedin.NormalizeVisibility(v.Default); err != nil {
+			return fmt.Errorf("default: %w", err)
+		}
+	}
+
+	for i, rule := range v.Rules {
+		if err := rule.validate(); err != nil {
+			return fmt.Errorf("rule %d: %w", i+1, err)
+		}
+	}
+
+	return nil
+}
+
+func (r VisibilityRule) validate() error {
+	if _, err := linkedin.NormalizeVisibility(r.Visibility); err != nil {
+		return err
+	}
+
+	for _, day := range r.Days {
+		if _, ok := dayNames[strings.ToLower(day)]; !ok {
+			return fmt.Errorf("unknown day %q (use mon-sun, weekdays or weekend)", day)
+		}
+	}
+
+	for _, clock := range []string{r.From, r.To} {
+		if _, err := minuteOfDay(clock, 0); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Matches reports whether the local time falls within the rule's days and time window.
+func (r VisibilityRule) Matches(local time.Time) bool {
+	if len(r.Days) > 0 && !r.matchesDay(local.Weekday()) {
+		return false
+	}
+
+	const minutesPerDay = 24 * 60
+
+	from, err := minuteOfDay(r.From, 0)
+	if err != nil {
+		return false
+	}
+
+	to, err := minuteOfDay(r.To, minutesPerDay)
+	if err != nil {
+		return false
+	}
+
+	now := local.Hour()*60 + local.Minute()
+
+	if from <= to {
+		return now >= from && now < to
+	}
+
+	return now >= from || now < to
+}
+
+func (r VisibilityRule) matchesDay(day time.Weekday) bool {
+	for _, name := range r.Days {
+		for _, d := range dayNames[strings.ToLower(name)] {
+			if d == day {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// minuteOfDay parses "HH:MM" into minutes since midnight, returning fallback for an empty value.
+func minuteOfDay(clock string, fallback int) (int, error) {
+	if clock == "" {
+		return fallback, nil
+	}
+
+	t, err := time.Parse("15:04", clock)
+	if err != nil {
+		return 0, fmt.Errorf("invalid time %q, use HH:MM", clock)
+	}
+
+	return t.Hour()*60 + t.Minute(), nil
+}
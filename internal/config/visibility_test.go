@@ -0,0 +1,61 @@
+package config
+
+import (
+	"testing"
+	"time"
+)
+
+func TestVisibilityConfigValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		config  VisibilityConfig
+		wantErr bool
+	}{
+		{"empty", VisibilityConfig{}, false},
+		{"valid rules", VisibilityConfig{Default: "public", Rules: []VisibilityRule{
+			{Days: []string{"Weekend"}, Visibility: "connections"},
+			{Days: []string{"mon", "fri"}, From: "18:00", To: "08:00", Visibility: "LOGGED_IN"},
+		}}, false},
+		{"unknown default", VisibilityConfig{Default: "friends"}, true},
+		{"unknown visibility", VisibilityConfig{Rules: []VisibilityRule{{Visibility: "private"}}}, true},
+		{"missing visibility", VisibilityConfig{Rules: []VisibilityRule{{Days: []string{"sat"}}}}, true},
+		{"unknown day", VisibilityConfig{Rules: []VisibilityRule{{Days: []string{"saturday"}, Visibility: "public"}}}, true},
+		{"invalid time", VisibilityConfig{Rules: []VisibilityRule{{From: "9am", Visibility: "public"}}}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.config.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestVisibilityRuleMatches(t *testing.T) {
+	saturdayNoon := time.Date(2025, 6, 7, 12, 0, 0, 0, time.UTC)
+	mondayNight := time.Date(2025, 6, 9, 23, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name  string
+		rule  VisibilityRule
+		local time.Time
+		want  bool
+	}{
+		{"every day, whole day", VisibilityRule{}, saturdayNoon, true},
+		{"weekend on saturday", VisibilityRule{Days: []string{"weekend"}}, saturdayNoon, true},
+		{"weekend on monday", VisibilityRule{Days: []string{"weekend"}}, mondayNight, false},
+		{"inside window", VisibilityRule{From: "09:00", To: "17:00"}, saturdayNoon, true},
+		{"outside window", VisibilityRule{From: "09:00", To: "12:00"}, saturdayNoon, false},
+		{"window wrapping midnight", VisibilityRule{From: "22:00", To: "06:00"}, mondayNight, true},
+		{"open-ended window", VisibilityRule{From: "20:00"}, mondayNight, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.rule.Matches(tt.local); got != tt.want {
+				t.Errorf("Matches(%v) = %v, want %v", tt.local, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,59 @@
+package cron
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"PostedIn/internal/scheduler"
+)
+
+// startBestOf registers the periodic best-of repost job when best_of.enabled is set, replacing
+// the job of a previous start so a restart never runs it twice.
+func (cs *Scheduler) startBestOf() error {
+	if cs.bestOfEntry != 0 {
+		cs.cron.Remove(cs.bestOfEntry)
+		cs.bestOfEntry = 0
+	}
+
+	if !cs.config.BestOf.Enabled {
+		return nil
+	}
+
+	interval := cs.config.BestOf.Interval()
+
+	entry, err := cs.cron.AddFunc(fmt.Sprintf("@every %s", interval), cs.scheduleBestOf)
+	if err != nil {
+		return fmt.Errorf("failed to schedule best-of reposts: %w", err)
+	}
+
+	cs.bestOfEntry = entry
+
+	log.Printf("🏆 Best-of reposts enabled (every %v)", interval)
+
+	return nil
+}
+
+// scheduleBestOf refreshes post analytics from LinkedIn and schedules reposts of the top
+// performers into open slots. Failed fetches are logged and the posts keep their previous stats.
+func (cs *Scheduler) scheduleBestOf() {
+	updated, err := cs.scheduler.RefreshStats(context.Background(), cs.config, scheduler.LinkedInStats(cs.config))
+	if err != nil {
+		log.Printf("⚠️ Failed to refresh some post analytics: %v", err)
+	}
+
+	log.Printf("📊 Refreshed analytics of %d post(s)", updated)
+
+	reposts, err := cs.scheduler.ScheduleBestOf(cs.config)
+	if err != nil {
+		log.Printf("❌ Best-of reposts: %v", err)
+	}
+
+	for i := range reposts {
+		log.Printf("🏆 Post %d reposts post %d at %s", reposts[i].ID, reposts[i].RepostOf, reposts[i].ScheduledAt.Format("2006-01-02 15:04 MST"))
+
+		if err := cs.AddNewPost(&reposts[i]); err != nil {
+			log.Printf("❌ Failed to schedule repost %d: %v", reposts[i].ID, err)
+		}
+	}
+}
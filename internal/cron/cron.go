@@ -3,6 +3,7 @@ package cron
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
 	"sync"
@@ -17,7 +18,6 @@ import (
 
 const (
 	shutdownTimeout    = 30 * time.Second
-	publishTimeout     = 2 * time.Minute
 	executionTolerance = 2 * time.Minute // Allow 2 minutes tolerance for cron execution timing
 	statusScheduled    = "scheduled"
 )
@@ -30,39 +30,35 @@ type PostTimer struct {
 
 // Scheduler manages automatic post publishing using timers and cron jobs.
 type Scheduler struct {
-	cron      *cron.Cron
-	scheduler *scheduler.Scheduler
-	config    *config.Config
-	running   bool
-	timers    map[int]*PostTimer // Map of post ID to timer
-	timersMux sync.RWMutex       // Protect timers map
+	cron        *cron.Cron
+	scheduler   *scheduler.Scheduler
+	config      *config.Config
+	running     bool
+	timers      map[int]*PostTimer // Map of post ID to timer
+	timersMux   sync.RWMutex       // Protect timers map
+	sweepEntry  cron.EntryID       // Overdue sweep job, 0 when not scheduled
+	bestOfEntry cron.EntryID       // Best-of repost job, 0 when not scheduled
+	startedAt   time.Time          // First start; posts due before it are left to the startup overdue policy
 }
 
 // NewScheduler creates a new cron-based scheduler.
 func NewScheduler(s *scheduler.Scheduler, cfg *config.Config) *Scheduler {
-	// Get the user's configured timezone
-	loc, err := cfg.GetTimezone()
-	if err != nil {
-		log.Printf("⚠️ Failed to get user timezone, using UTC: %v", err)
+	c := newCron(cfg)
 
-		loc = time.UTC
-	}
+	log.Printf("🌍 Cron scheduler initialized with timezone: %s", c.Location())
 
-	// Create cron scheduler with user's timezone
-	c := cron.New(
-		cron.WithLocation(loc),
-		cron.WithLogger(cron.VerbosePrintfLogger(log.New(log.Writer(), "CRON: ", log.LstdFlags))),
-	)
-
-	log.Printf("🌍 Cron scheduler initialized with timezone: %s", loc.String())
-
-	return &Scheduler{
+	cs := &Scheduler{
 		cron:      c,
 		scheduler: s,
 		config:    cfg,
 		running:   false,
 		timers:    make(map[int]*PostTimer),
 	}
+
+	// Arm posts that become scheduled when the post they depend on publishes
+	s.OnDependentsReleased(cs.scheduleReleasedPosts)
+
+	return cs
 }
 
 // Start begins the cron scheduler.
@@ -77,9 +73,25 @@ func (cs *Scheduler) Start() error {
 		return fmt.Errorf("failed to schedule posts: %w", err)
 	}
 
+	// Clear timer IDs persisted by a previous run for posts that were not re-armed
+	cs.reconcileTimerEntries()
+
+	if err := cs.startSweep(); err != nil {
+		return err
+	}
+
+	if err := cs.startBestOf(); err != nil {
+		return err
+	}
+
 	cs.cron.Start()
 	cs.running = true
 
+	// Keep the first start so a restart on config change still sweeps posts due during the gap
+	if cs.startedAt.IsZero() {
+		cs.startedAt = time.Now()
+	}
+
 	log.Println("✅ Auto-scheduler started - posts will be published at their exact scheduled times")
 
 	return nil
@@ -128,21 +140,14 @@ func (cs *Scheduler) UpdateConfig(cfg *config.Config) error {
 
 	cs.config = cfg
 
-	// Recreate cron scheduler with new timezone if timezone changed
-	loc, err := cfg.GetTimezone()
-	if err != nil {
-		log.Printf("⚠️ Failed to get updated timezone, using UTC: %v", err)
-
-		loc = time.UTC
-	}
-
 	// Recreate the cron scheduler with the new timezone
-	cs.cron = cron.New(
-		cron.WithLocation(loc),
-		cron.WithLogger(cron.VerbosePrintfLogger(log.New(log.Writer(), "CRON: ", log.LstdFlags))),
-	)
+	cs.cron = newCron(cfg)
 
-	log.Printf("🌍 Cron scheduler timezone updated to: %s", loc.String())
+	log.Printf("🌍 Cron scheduler timezone updated to: %s", cs.cron.Location())
+
+	if err := cs.VerifyTimezone(); err != nil {
+		log.Printf("⚠️ %v", err)
+	}
 
 	if wasRunning && cs.isCronEnabled() {
 		return cs.Start()
@@ -180,6 +185,39 @@ func (cs *Scheduler) scheduleAllPendingPosts() error {
 	return firstError
 }
 
+// reconcileTimerEntries clears CronEntryID on posts without a live timer and sets it on armed posts,
+// so stored timer IDs always reflect the timers of this process.
+func (cs *Scheduler) reconcileTimerEntries() {
+	cs.timersMux.RLock()
+	armed := make(map[int]bool, len(cs.timers))
+	for postID := range cs.timers {
+		armed[postID] = true
+	}
+	cs.timersMux.RUnlock()
+
+	cleared := 0
+
+	for _, post := range cs.scheduler.GetPosts() {
+		switch {
+		case armed[post.ID] && post.CronEntryID != post.ID:
+			if err := cs.scheduler.UpdatePostCronEntry(post.ID, post.ID); err != nil {
+				log.Printf("⚠️ Failed to store timer ID for post %d: %v", post.ID, err)
+			}
+		case !armed[post.ID] && post.CronEntryID != 0:
+			if err := cs.scheduler.UpdatePostCronEntry(post.ID, 0); err != nil {
+				log.Printf("⚠️ Failed to clear stale timer ID for post %d: %v", post.ID, err)
+				continue
+			}
+
+			cleared++
+		}
+	}
+
+	if cleared > 0 {
+		log.Printf("🧹 Cleared stale timer IDs from %d post(s)", cleared)
+	}
+}
+
 // schedulePost schedules a single post for publishing at its exact time using timers.
 func (cs *Scheduler) schedulePost(post *models.Post) error {
 	// Get the configured timezone
@@ -201,6 +239,10 @@ func (cs *Scheduler) schedulePost(post *models.Post) error {
 
 	if scheduledTime.Before(now) {
 		log.Printf("⚠️ Post %d scheduled time is in the past (%s), skipping scheduling", post.ID, scheduledTime.Format("2006-01-02 15:04:05 MST"))
+
+		// A timer armed for the post's previous time is stale now
+		cs.RemovePostTimers([]int{post.ID})
+
 		return nil
 	}
 
@@ -209,31 +251,54 @@ func (cs *Scheduler) schedulePost(post *models.Post) error {
 	log.Printf("🔧 Scheduling post %d for %s (in %v)", post.ID, scheduledTime.Format("2006-01-02 15:04:05 MST"), timeUntil)
 
 	// Use a timer for precise one-time execution
-	timer := time.AfterFunc(timeUntil, func() {
-		currentTime := time.Now().In(loc)
-		log.Printf("🚀 Timer triggered for post %d at %s", post.ID, currentTime.Format("2006-01-02 15:04:05 MST"))
+	postID := post.ID
+	postTimer := &PostTimer{PostID: postID}
 
-		// Publish the post
-		cs.publishPost(post.ID)
+	// Replace any live timer for this post so it cannot fire twice
+	cs.timersMux.Lock()
+	if existing, ok := cs.timers[postID]; ok {
+		existing.Timer.Stop()
+		log.Printf("♻️ Replacing existing timer for post %d", postID)
+	}
+
+	postTimer.Timer = time.AfterFunc(timeUntil, func() {
+		currentTime := time.Now().In(loc)
 
-		// Remove the timer from our tracking map
+		// Remove the timer from our tracking map before publishing so a deferred
+		// publish can arm a new timer for the same post. A timer that was replaced
+		// after it already fired finds another timer in the map and does nothing.
 		cs.timersMux.Lock()
-		delete(cs.timers, post.ID)
+		if cs.timers[postID] != postTimer {
+			cs.timersMux.Unlock()
+			return
+		}
+
+		delete(cs.timers, postID)
 		cs.timersMux.Unlock()
 
+		// The timer counts elapsed time, so a wall-clock step (suspend, NTP correction, a host clock
+		// set to the wrong DST offset) can fire it early; re-arm against the wall-clock target instead
+		if early := scheduledTime.Sub(currentTime); early > executionTolerance {
+			log.Printf("⏰ Timer for post %d fired %v before %s, re-arming", postID, early.Round(time.Second), scheduledTime.Format("2006-01-02 15:04:05 MST"))
+			cs.rearm(postID)
+
+			return
+		}
+
+		log.Printf("🚀 Timer triggered for post %d at %s", postID, currentTime.Format("2006-01-02 15:04:05 MST"))
+
 		// Clear the timer ID from the post
-		err := cs.scheduler.UpdatePostCronEntry(post.ID, 0)
+		err := cs.scheduler.UpdatePostCronEntry(postID, 0)
 		if err != nil {
-			log.Printf("⚠️ Failed to clear timer ID for post %d: %v", post.ID, err)
+			log.Printf("⚠️ Failed to clear timer ID for post %d: %v", postID, err)
 		}
+
+		// Publish the post
+		cs.publishPost(postID)
 	})
 
 	// Store the timer in our tracking map
-	cs.timersMux.Lock()
-	cs.timers[post.ID] = &PostTimer{
-		PostID: post.ID,
-		Timer:  timer,
-	}
+	cs.timers[postID] = postTimer
 	cs.timersMux.Unlock()
 
 	// Store a dummy timer ID in the post (we'll use the post ID as the identifier)
@@ -248,59 +313,175 @@ func (cs *Scheduler) schedulePost(post *models.Post) error {
 	return nil
 }
 
-// publishPost publishes a single post.
+// publishPost publishes a single post. The scheduler claims the post for the attempt, so a timer,
+// the sweep and a resume firing together publish it once and the others skip it.
 func (cs *Scheduler) publishPost(postID int) {
 	log.Printf("📤 Auto-publishing post %d...", postID)
 
-	ctx, cancel := context.WithTimeout(context.Background(), publishTimeout)
+	timeout := cs.config.Cron.PublishTimeout(false)
+
+	for _, post := range cs.scheduler.GetPosts() {
+		if post.ID == postID {
+			timeout = scheduler.PublishTimeout(post, cs.config)
+			break
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
 	defer cancel()
 
 	err := cs.scheduler.PublishToLinkedIn(ctx, postID, cs.config)
-	if err != nil {
+	switch {
+	case errors.Is(err, scheduler.ErrPublishInProgress):
+		log.Printf("⏭️ Post %d is already being published, skipping", postID)
+	case errors.Is(err, scheduler.ErrPublishingPaused):
+		log.Printf("⏸️ Publishing paused, holding post %d until resumed", postID)
+	case errors.Is(err, scheduler.ErrPublishDeferred):
+		log.Printf("⏳ %v", err)
+		cs.rearm(postID)
+	case err != nil:
 		log.Printf("❌ Failed to auto-publish post %d: %v", postID, err)
-	} else {
+	default:
 		log.Printf("✅ Successfully auto-published post %d", postID)
 	}
 }
 
+// rearm schedules a new timer for a post whose publish was deferred to a later time.
+func (cs *Scheduler) rearm(postID int) {
+	for _, post := range cs.scheduler.GetPosts() {
+		if post.ID != postID || post.Status != statusScheduled {
+			continue
+		}
+
+		if err := cs.schedulePost(&post); err != nil {
+			log.Printf("⚠️ Failed to re-arm deferred post %d: %v", postID, err)
+		}
+
+		return
+	}
+}
+
+// PublishHeld publishes posts that came due while publishing was paused.
+func (cs *Scheduler) PublishHeld() {
+	if !cs.running {
+		return
+	}
+
+	for _, post := range cs.scheduler.GetDuePosts(cs.config) {
+		go cs.publishPost(post.ID)
+	}
+}
+
 // isCronEnabled returns whether cron scheduling is enabled.
 func (cs *Scheduler) isCronEnabled() bool {
 	return cs.config.Cron.Enabled
 }
 
-// AddNewPost adds a newly scheduled post to the cron scheduler.
+// AddNewPost adds a newly scheduled post to the cron scheduler. A post whose dependency already
+// published may be due at once, and is published right away like a released dependent.
 func (cs *Scheduler) AddNewPost(post *models.Post) error {
 	if !cs.running || post.Status != statusScheduled {
 		return nil
 	}
 
+	if post.DependsOn != 0 && !post.ScheduledAt.After(time.Now()) {
+		go cs.publishPost(post.ID)
+		return nil
+	}
+
 	return cs.schedulePost(post)
 }
 
+// scheduleReleasedPosts arms timers for released dependents, poll results follow-ups and next occurrences of recurring posts, publishing right away those already due.
+func (cs *Scheduler) scheduleReleasedPosts(posts []models.Post) {
+	if !cs.running {
+		return
+	}
+
+	for i := range posts {
+		post := posts[i]
+		if !post.ScheduledAt.After(time.Now()) {
+			go cs.publishPost(post.ID)
+			continue
+		}
+
+		if err := cs.schedulePost(&post); err != nil {
+			log.Printf("⚠️ Failed to schedule released post %d: %v", post.ID, err)
+		}
+	}
+}
+
+// RemovePostTimers stops and forgets the timers armed for the posts, e.g. after they were deleted or
+// before they are rescheduled, and clears their timer IDs. IDs without an active timer are ignored.
+func (cs *Scheduler) RemovePostTimers(ids []int) {
+	cs.timersMux.Lock()
+
+	var stopped []int
+
+	for _, id := range ids {
+		if existing, ok := cs.timers[id]; ok {
+			existing.Timer.Stop()
+			delete(cs.timers, id)
+
+			stopped = append(stopped, id)
+		}
+	}
+
+	cs.timersMux.Unlock()
+
+	// Deleted posts have no timer ID left to clear
+	for _, id := range stopped {
+		if err := cs.scheduler.UpdatePostCronEntry(id, 0); err != nil && !errors.Is(err, scheduler.ErrPostNotFound) {
+			log.Printf("⚠️ Failed to clear timer ID for post %d: %v", id, err)
+		}
+	}
+}
+
 // GetNextRun returns the next scheduled run time.
 func (cs *Scheduler) GetNextRun() time.Time {
+	_, nextRun := cs.NextPost()
+
+	return nextRun
+}
+
+// NextPost returns the ID and time of the earliest post with an armed timer, or zero values when none.
+func (cs *Scheduler) NextPost() (int, time.Time) {
+	post, ok := cs.nextPost()
+	if !ok {
+		return 0, time.Time{}
+	}
+
+	return post.ID, post.ScheduledAt
+}
+
+// nextPost returns the earliest post with an armed timer.
+func (cs *Scheduler) nextPost() (models.Post, bool) {
 	if !cs.running {
-		return time.Time{}
+		return models.Post{}, false
 	}
 
 	cs.timersMux.RLock()
 	defer cs.timersMux.RUnlock()
 
-	var nextRun time.Time
+	var (
+		next  models.Post
+		found bool
+	)
 
 	posts := cs.scheduler.GetPosts()
 
 	for _, post := range posts {
 		if post.Status == statusScheduled && post.CronEntryID > 0 {
 			if _, exists := cs.timers[post.ID]; exists {
-				if nextRun.IsZero() || post.ScheduledAt.Before(nextRun) {
-					nextRun = post.ScheduledAt
+				if !found || post.ScheduledAt.Before(next.ScheduledAt) {
+					next = post
+					found = true
 				}
 			}
 		}
 	}
 
-	return nextRun
+	return next, found
 }
 
 // GetStatus returns the current status of the cron scheduler.
@@ -313,6 +494,7 @@ func (cs *Scheduler) GetStatus() map[string]interface{} {
 		"running": cs.running,
 		"enabled": cs.isCronEnabled(),
 		"mode":    "timer_based_scheduling", // Using Go timers for precise timing
+		"paused":  cs.scheduler.IsPaused(),
 	}
 
 	if cs.running {
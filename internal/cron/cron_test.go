@@ -0,0 +1,286 @@
+package cron
+
+import (
+	"bytes"
+	"encoding/json"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"PostedIn/internal/config"
+	"PostedIn/internal/models"
+	"PostedIn/internal/scheduler"
+)
+
+func TestPublishPostConcurrentCallsPublishOnce(t *testing.T) {
+	cfg := &config.Config{Timezone: config.TimezoneConfig{Location: "UTC"}, DryRun: true}
+
+	sched := scheduler.NewScheduler(filepath.Join(t.TempDir(), "posts.json"))
+	sched.LoadSwitches(cfg)
+
+	post, err := sched.Add(models.Post{Content: "once", ScheduledAt: time.Now().Add(time.Hour)}, cfg)
+	if err != nil {
+		t.Fatalf("Add: %v", err)
+	}
+
+	var published atomic.Int32
+
+	sched.OnPublished(func(models.Post, error) { published.Add(1) })
+
+	cs := NewScheduler(sched, cfg)
+
+	// A timer, the sweep and a resume firing together must publish the post once
+	var wg sync.WaitGroup
+
+	for range 8 {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+			cs.publishPost(post.ID)
+		}()
+	}
+
+	wg.Wait()
+
+	if got := published.Load(); got != 1 {
+		t.Errorf("published %d times, want once", got)
+	}
+
+	for _, p := range sched.GetPosts() {
+		if p.ID == post.ID && p.Status != models.StatusPosted {
+			t.Errorf("post status = %q, want %q", p.Status, models.StatusPosted)
+		}
+	}
+}
+
+func TestStartReconcilesTimerEntries(t *testing.T) {
+	cfg := &config.Config{Timezone: config.TimezoneConfig{Location: "UTC"}, DryRun: true}
+	sched := scheduler.NewScheduler(filepath.Join(t.TempDir(), "posts.json"))
+
+	// Timer IDs left behind by a previous process
+	backup, err := json.Marshal(scheduler.Backup{Version: scheduler.BackupVersion, Posts: []models.Post{
+		{ID: 1, Content: "upcoming", Status: models.StatusScheduled, ScheduledAt: time.Now().Add(time.Hour), CronEntryID: 5},
+		{ID: 2, Content: "published", Status: models.StatusPosted, ScheduledAt: time.Now().Add(-time.Hour), CronEntryID: 7},
+		{ID: 3, Content: "missed", Status: models.StatusScheduled, ScheduledAt: time.Now().Add(-time.Hour), CronEntryID: 9},
+		{ID: 4, Content: "unarmed", Status: models.StatusScheduled, ScheduledAt: time.Now().Add(2 * time.Hour)},
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := sched.Restore(bytes.NewReader(backup), cfg); err != nil {
+		t.Fatalf("Restore: %v", err)
+	}
+
+	cs := NewScheduler(sched, cfg)
+	if err := cs.Start(); err != nil {
+		t.Fatalf("Start: %v", err)
+	}
+
+	t.Cleanup(cs.Stop)
+
+	want := map[int]int{1: 1, 2: 0, 3: 0, 4: 4}
+
+	for _, post := range sched.GetPosts() {
+		if post.CronEntryID != want[post.ID] {
+			t.Errorf("post %d (%s) CronEntryID = %d, want %d", post.ID, post.Content, post.CronEntryID, want[post.ID])
+		}
+	}
+
+	cs.timersMux.RLock()
+	defer cs.timersMux.RUnlock()
+
+	for id, entry := range want {
+		if _, armed := cs.timers[id]; armed != (entry != 0) {
+			t.Errorf("post %d armed = %v, want %v", id, armed, entry != 0)
+		}
+	}
+}
+
+func TestReschedulingKeepsOneTimer(t *testing.T) {
+	cfg := &config.Config{Timezone: config.TimezoneConfig{Location: "UTC"}, DryRun: true}
+
+	sched := scheduler.NewScheduler(filepath.Join(t.TempDir(), "posts.json"))
+	sched.LoadSwitches(cfg)
+
+	post, err := sched.Add(models.Post{Content: "edited twice", ScheduledAt: time.Now().Add(500 * time.Millisecond)}, cfg)
+	if err != nil {
+		t.Fatalf("Add: %v", err)
+	}
+
+	var published atomic.Int32
+
+	sched.OnPublished(func(models.Post, error) { published.Add(1) })
+
+	cs := NewScheduler(sched, cfg)
+	if err := cs.Start(); err != nil {
+		t.Fatalf("Start: %v", err)
+	}
+
+	t.Cleanup(cs.Stop)
+
+	cs.timersMux.RLock()
+	first := cs.timers[post.ID]
+	cs.timersMux.RUnlock()
+
+	if first == nil {
+		t.Fatal("Start did not arm a timer for the post")
+	}
+
+	// Editing and re-adding the post schedules it again, possibly from several requests at once
+	var wg sync.WaitGroup
+
+	for range 8 {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			if err := cs.AddNewPost(&post); err != nil {
+				t.Errorf("AddNewPost: %v", err)
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	cs.timersMux.RLock()
+	armed := len(cs.timers)
+	cs.timersMux.RUnlock()
+
+	if armed != 1 {
+		t.Errorf("%d timers armed, want 1", armed)
+	}
+
+	if first.Timer.Stop() {
+		t.Error("the replaced timer was still active")
+	}
+
+	deadline := time.Now().Add(5 * time.Second)
+	for published.Load() == 0 && time.Now().Before(deadline) {
+		time.Sleep(20 * time.Millisecond)
+	}
+
+	// Give a leaked timer the chance to fire too
+	time.Sleep(200 * time.Millisecond)
+
+	if got := published.Load(); got != 1 {
+		t.Errorf("published %d times, want once", got)
+	}
+
+	cs.timersMux.RLock()
+	defer cs.timersMux.RUnlock()
+
+	if len(cs.timers) != 0 {
+		t.Errorf("%d timers left after publishing, want none", len(cs.timers))
+	}
+}
+
+func TestRemovePostTimers(t *testing.T) {
+	cfg := &config.Config{Timezone: config.TimezoneConfig{Location: "UTC"}, DryRun: true}
+
+	sched := scheduler.NewScheduler(filepath.Join(t.TempDir(), "posts.json"))
+	sched.LoadSwitches(cfg)
+
+	for _, content := range []string{"removed", "kept", "deleted"} {
+		if _, err := sched.Add(models.Post{Content: content, ScheduledAt: time.Now().Add(time.Hour)}, cfg); err != nil {
+			t.Fatalf("Add: %v", err)
+		}
+	}
+
+	cs := NewScheduler(sched, cfg)
+	if err := cs.Start(); err != nil {
+		t.Fatalf("Start: %v", err)
+	}
+
+	t.Cleanup(cs.Stop)
+
+	cs.timersMux.RLock()
+	removed, deleted := cs.timers[1], cs.timers[3]
+	cs.timersMux.RUnlock()
+
+	if removed == nil || deleted == nil {
+		t.Fatal("Start did not arm timers for the posts")
+	}
+
+	if err := sched.DeletePost(3); err != nil {
+		t.Fatalf("DeletePost: %v", err)
+	}
+
+	// 4 never had a timer, and 3 is gone from the store
+	cs.RemovePostTimers([]int{1, 3, 4})
+
+	cs.timersMux.RLock()
+	_, kept := cs.timers[2]
+	armed := len(cs.timers)
+	cs.timersMux.RUnlock()
+
+	if !kept || armed != 1 {
+		t.Errorf("%d timers armed (post 2 armed: %v), want only post 2's", armed, kept)
+	}
+
+	if removed.Timer.Stop() || deleted.Timer.Stop() {
+		t.Error("a removed timer was still active")
+	}
+
+	for _, post := range sched.GetPosts() {
+		switch {
+		case post.ID == 1 && post.CronEntryID != 0:
+			t.Errorf("post 1 timer ID = %d, want it cleared", post.CronEntryID)
+		case post.ID == 2 && post.CronEntryID == 0:
+			t.Error("post 2 timer ID was cleared")
+		}
+	}
+}
+
+func TestAddNewPostPublishesDueDependent(t *testing.T) {
+	cfg := &config.Config{Timezone: config.TimezoneConfig{Location: "UTC"}, DryRun: true}
+
+	sched := scheduler.NewScheduler(filepath.Join(t.TempDir(), "posts.json"))
+	sched.LoadSwitches(cfg)
+
+	publishedAt := time.Now().Add(-48 * time.Hour)
+
+	backup, err := json.Marshal(scheduler.Backup{Version: scheduler.BackupVersion, Posts: []models.Post{
+		{ID: 1, Content: "long ago", Status: models.StatusPosted, ScheduledAt: publishedAt, PublishedAt: &publishedAt},
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := sched.Restore(bytes.NewReader(backup), cfg); err != nil {
+		t.Fatalf("Restore: %v", err)
+	}
+
+	cs := NewScheduler(sched, cfg)
+	if err := cs.Start(); err != nil {
+		t.Fatalf("Start: %v", err)
+	}
+
+	t.Cleanup(cs.Stop)
+
+	post, err := sched.Add(models.Post{Content: "follow-up", DependsOn: 1, OffsetMinutes: 30}, cfg)
+	if err != nil {
+		t.Fatalf("Add: %v", err)
+	}
+
+	if err := cs.AddNewPost(&post); err != nil {
+		t.Fatalf("AddNewPost: %v", err)
+	}
+
+	deadline := time.Now().Add(5 * time.Second)
+	for time.Now().Before(deadline) {
+		for _, p := range sched.GetPosts() {
+			if p.ID == post.ID && p.Status == models.StatusPosted {
+				return
+			}
+		}
+
+		time.Sleep(20 * time.Millisecond)
+	}
+
+	t.Error("the due dependent was never published")
+}
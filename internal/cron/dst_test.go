@@ -0,0 +1,86 @@
+package cron
+
+import (
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"PostedIn/internal/config"
+	"PostedIn/internal/models"
+	"PostedIn/internal/scheduler"
+)
+
+func TestEarlyTimerRearmsAcrossDST(t *testing.T) {
+	ny, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skipf("timezone data unavailable: %v", err)
+	}
+
+	cfg := &config.Config{Timezone: config.TimezoneConfig{Location: "America/New_York"}, DryRun: true}
+
+	sched := scheduler.NewScheduler(filepath.Join(t.TempDir(), "posts.json"))
+	sched.LoadSwitches(cfg)
+
+	// Clocks spring forward at 2:00 on March 14, 2027, so 09:00 that morning is 13:00 UTC, not 14:00
+	target := time.Date(2027, 3, 14, 9, 0, 0, 0, ny)
+
+	post, err := sched.Add(models.Post{Content: "after the clocks change", ScheduledAt: target}, cfg)
+	if err != nil {
+		t.Fatalf("Add: %v", err)
+	}
+
+	var published atomic.Int32
+
+	sched.OnPublished(func(models.Post, error) { published.Add(1) })
+
+	cs := NewScheduler(sched, cfg)
+	if err := cs.Start(); err != nil {
+		t.Fatalf("Start: %v", err)
+	}
+
+	t.Cleanup(cs.Stop)
+
+	cs.timersMux.RLock()
+	first := cs.timers[post.ID]
+	cs.timersMux.RUnlock()
+
+	if first == nil {
+		t.Fatal("Start did not arm a timer for the post")
+	}
+
+	// Fire the timer now, as a wall-clock step would, long before the post is due
+	first.Timer.Reset(0)
+
+	var rearmed *PostTimer
+
+	deadline := time.Now().Add(5 * time.Second)
+	for time.Now().Before(deadline) {
+		cs.timersMux.RLock()
+		rearmed = cs.timers[post.ID]
+		cs.timersMux.RUnlock()
+
+		if rearmed != nil && rearmed != first {
+			break
+		}
+
+		time.Sleep(20 * time.Millisecond)
+	}
+
+	if rearmed == nil || rearmed == first {
+		t.Fatal("the early timer was not re-armed")
+	}
+
+	if got := published.Load(); got != 0 {
+		t.Fatalf("published %d times when the timer fired early, want none", got)
+	}
+
+	id, next := cs.NextPost()
+	if id != post.ID || !next.Equal(time.Date(2027, 3, 14, 13, 0, 0, 0, time.UTC)) {
+		t.Errorf("next timer = post %d at %v, want post %d at 09:00 EDT", id, next, post.ID)
+	}
+
+	if local := next.In(ny); local.Hour() != 9 || local.Minute() != 0 {
+		t.Errorf("re-armed for %s, want the intended 09:00 wall-clock time", local.Format("15:04 MST"))
+	}
+}
@@ -0,0 +1,28 @@
+package cron
+
+import (
+	"context"
+	"log"
+	"slices"
+
+	"PostedIn/internal/scheduler"
+)
+
+// CatchUp reconciles the posts that became overdue while the app was not running, then arms the
+// posts the overdue policy snoozed. Call it once the publish hooks are registered and after Start,
+// so catch-up publishes are notified and the dependents they release are armed.
+func (cs *Scheduler) CatchUp(ctx context.Context) scheduler.OverdueResult {
+	result := cs.scheduler.HandleOverdue(ctx, cs.config)
+
+	for _, post := range cs.scheduler.GetPosts() {
+		if !slices.Contains(result.Snoozed, post.ID) {
+			continue
+		}
+
+		if err := cs.AddNewPost(&post); err != nil {
+			log.Printf("⚠️ Failed to schedule snoozed post %d: %v", post.ID, err)
+		}
+	}
+
+	return result
+}
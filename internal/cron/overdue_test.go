@@ -0,0 +1,99 @@
+package cron
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"path/filepath"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"PostedIn/internal/config"
+	"PostedIn/internal/models"
+	"PostedIn/internal/scheduler"
+)
+
+func TestCatchUpNotifiesAndArms(t *testing.T) {
+	cfg := &config.Config{
+		Timezone: config.TimezoneConfig{Location: "UTC"},
+		Cron:     config.CronConfig{Enabled: true, OverduePolicy: config.OverdueSnooze, OverdueGraceMinutes: 10},
+		DryRun:   true,
+	}
+
+	sched := scheduler.NewScheduler(filepath.Join(t.TempDir(), "posts.json"))
+	sched.LoadSwitches(cfg)
+
+	now := time.Now()
+
+	backup, err := json.Marshal(scheduler.Backup{Version: scheduler.BackupVersion, Posts: []models.Post{
+		{ID: 1, Content: "just missed", Status: models.StatusScheduled, ScheduledAt: now.Add(-5 * time.Minute), PostType: models.PostTypeText},
+		{ID: 2, Content: "follow-up", Status: models.StatusWaiting, DependsOn: 1, PostType: models.PostTypeText},
+		{ID: 3, Content: "long gone", Status: models.StatusScheduled, ScheduledAt: now.Add(-2 * time.Hour), PostType: models.PostTypeText},
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := sched.Restore(bytes.NewReader(backup), cfg); err != nil {
+		t.Fatalf("Restore: %v", err)
+	}
+
+	var (
+		mu        sync.Mutex
+		published []int
+	)
+
+	sched.OnPublished(func(post models.Post, err error) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		if err == nil {
+			published = append(published, post.ID)
+		}
+	})
+
+	cs := NewScheduler(sched, cfg)
+	if err := cs.Start(); err != nil {
+		t.Fatalf("Start: %v", err)
+	}
+
+	t.Cleanup(cs.Stop)
+
+	result := cs.CatchUp(context.Background())
+	if !slices.Equal(result.ToPublish, []int{1}) || !slices.Equal(result.Snoozed, []int{3}) {
+		t.Fatalf("to publish %v, snoozed %v, want [1] and [3]", result.ToPublish, result.Snoozed)
+	}
+
+	// The catch-up publish releases post 2, which is due at once and published by the cron scheduler
+	deadline := time.Now().Add(5 * time.Second)
+	for time.Now().Before(deadline) {
+		mu.Lock()
+		done := len(published) == 2
+		mu.Unlock()
+
+		if done {
+			break
+		}
+
+		time.Sleep(20 * time.Millisecond)
+	}
+
+	mu.Lock()
+	slices.Sort(published)
+	got := slices.Clone(published)
+	mu.Unlock()
+
+	if !slices.Equal(got, []int{1, 2}) {
+		t.Errorf("publish hook saw %v, want the catch-up publish and the dependent it released", got)
+	}
+
+	cs.timersMux.RLock()
+	_, armed := cs.timers[3]
+	cs.timersMux.RUnlock()
+
+	if !armed {
+		t.Error("the snoozed post has no timer")
+	}
+}
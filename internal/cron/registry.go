@@ -0,0 +1,70 @@
+package cron
+
+import (
+	"sort"
+	"sync"
+	"time"
+)
+
+// DefaultStore is the registry name of the scheduler backed by the main posts file.
+const DefaultStore = "default"
+
+// NextRun identifies the next post to publish and the store/account it belongs to.
+type NextRun struct {
+	Store   string    `json:"store"`
+	Account string    `json:"account,omitempty"` // Empty is the default LinkedIn account
+	PostID  int       `json:"post_id"`
+	At      time.Time `json:"at"`
+}
+
+// Registry tracks the cron schedulers of every store/account so their next runs can be compared.
+type Registry struct {
+	mu         sync.RWMutex
+	schedulers map[string]*Scheduler
+}
+
+// NewRegistry creates an empty scheduler registry.
+func NewRegistry() *Registry {
+	return &Registry{schedulers: make(map[string]*Scheduler)}
+}
+
+// Register adds or replaces the scheduler for a store.
+func (r *Registry) Register(store string, s *Scheduler) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.schedulers[store] = s
+}
+
+// Next returns the earliest armed post across all registered schedulers.
+// Ties are broken by store name so the result is stable.
+func (r *Registry) Next() (NextRun, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	stores := make([]string, 0, len(r.schedulers))
+	for store := range r.schedulers {
+		stores = append(stores, store)
+	}
+
+	sort.Strings(stores)
+
+	var (
+		next  NextRun
+		found bool
+	)
+
+	for _, store := range stores {
+		post, ok := r.schedulers[store].nextPost()
+		if !ok {
+			continue
+		}
+
+		if !found || post.ScheduledAt.Before(next.At) {
+			next = NextRun{Store: store, Account: post.Account, PostID: post.ID, At: post.ScheduledAt}
+			found = true
+		}
+	}
+
+	return next, found
+}
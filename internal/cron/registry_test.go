@@ -0,0 +1,139 @@
+package cron
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"PostedIn/internal/config"
+	"PostedIn/internal/models"
+	"PostedIn/internal/scheduler"
+)
+
+// startStore runs a cron scheduler over a store holding posts due after the given delays.
+func startStore(t *testing.T, delays ...time.Duration) (*Scheduler, []models.Post) {
+	t.Helper()
+
+	cfg := &config.Config{Timezone: config.TimezoneConfig{Location: "UTC"}}
+	sched := scheduler.NewScheduler(filepath.Join(t.TempDir(), "posts.json"))
+
+	posts := make([]models.Post, 0, len(delays))
+
+	for _, delay := range delays {
+		post, err := sched.Add(models.Post{Content: delay.String(), ScheduledAt: time.Now().Add(delay)}, cfg)
+		if err != nil {
+			t.Fatalf("Add: %v", err)
+		}
+
+		posts = append(posts, post)
+	}
+
+	cs := NewScheduler(sched, cfg)
+	if err := cs.Start(); err != nil {
+		t.Fatalf("Start: %v", err)
+	}
+
+	t.Cleanup(cs.Stop)
+
+	return cs, posts
+}
+
+func TestRegistryNextAcrossStores(t *testing.T) {
+	late, _ := startStore(t, 5*time.Hour, 3*time.Hour)
+	early, earlyPosts := startStore(t, 4*time.Hour, 2*time.Hour)
+	empty, _ := startStore(t)
+
+	registry := NewRegistry()
+	registry.Register(DefaultStore, late)
+	registry.Register("company", early)
+	registry.Register("empty", empty)
+
+	next, ok := registry.Next()
+	if !ok {
+		t.Fatal("Next found no armed post")
+	}
+
+	if next.Store != "company" || next.PostID != earlyPosts[1].ID {
+		t.Errorf("Next = %s post %d, want company post %d", next.Store, next.PostID, earlyPosts[1].ID)
+	}
+
+	if !next.At.Equal(earlyPosts[1].ScheduledAt) {
+		t.Errorf("Next at %v, want %v", next.At, earlyPosts[1].ScheduledAt)
+	}
+
+	// Once the company store stops, its timers no longer count
+	early.Stop()
+
+	next, ok = registry.Next()
+	if !ok || next.Store != DefaultStore {
+		t.Errorf("Next after stopping company = %+v, %v, want the default store", next, ok)
+	}
+}
+
+func TestRegistryNextEmpty(t *testing.T) {
+	registry := NewRegistry()
+
+	if _, ok := registry.Next(); ok {
+		t.Error("empty registry reported a next run")
+	}
+
+	idle, _ := startStore(t)
+	registry.Register(DefaultStore, idle)
+
+	if _, ok := registry.Next(); ok {
+		t.Error("store without posts reported a next run")
+	}
+}
+
+func TestRegistryNextTieBreaksByStore(t *testing.T) {
+	at := time.Now().Add(time.Hour)
+	cfg := &config.Config{Timezone: config.TimezoneConfig{Location: "UTC"}}
+	registry := NewRegistry()
+
+	for _, store := range []string{"zeta", "alpha"} {
+		sched := scheduler.NewScheduler(filepath.Join(t.TempDir(), "posts.json"))
+		if _, err := sched.Add(models.Post{Content: store, ScheduledAt: at}, cfg); err != nil {
+			t.Fatalf("Add: %v", err)
+		}
+
+		cs := NewScheduler(sched, cfg)
+		if err := cs.Start(); err != nil {
+			t.Fatalf("Start: %v", err)
+		}
+
+		t.Cleanup(cs.Stop)
+		registry.Register(store, cs)
+	}
+
+	if next, _ := registry.Next(); next.Store != "alpha" {
+		t.Errorf("tie went to %q, want alpha", next.Store)
+	}
+}
+
+func TestRegistryNextReportsAccount(t *testing.T) {
+	cfg := &config.Config{
+		Timezone: config.TimezoneConfig{Location: "UTC"},
+		Accounts: map[string]config.AccountConfig{"work": {}},
+	}
+	sched := scheduler.NewScheduler(filepath.Join(t.TempDir(), "posts.json"))
+
+	post, err := sched.Add(models.Post{Content: "work post", Account: "work", ScheduledAt: time.Now().Add(time.Hour)}, cfg)
+	if err != nil {
+		t.Fatalf("Add: %v", err)
+	}
+
+	cs := NewScheduler(sched, cfg)
+	if err := cs.Start(); err != nil {
+		t.Fatalf("Start: %v", err)
+	}
+
+	t.Cleanup(cs.Stop)
+
+	registry := NewRegistry()
+	registry.Register(DefaultStore, cs)
+
+	next, ok := registry.Next()
+	if !ok || next.PostID != post.ID || next.Account != "work" {
+		t.Errorf("Next = %+v, %v, want post %d of account work", next, ok, post.ID)
+	}
+}
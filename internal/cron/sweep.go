@@ -0,0 +1,64 @@
+package cron
+
+import (
+	"fmt"
+	"log"
+	"time"
+)
+
+// startSweep registers the periodic overdue sweep when cron.sweep_enabled is set, replacing
+// the job of a previous start so a restart never runs it twice.
+func (cs *Scheduler) startSweep() error {
+	if cs.sweepEntry != 0 {
+		cs.cron.Remove(cs.sweepEntry)
+		cs.sweepEntry = 0
+	}
+
+	interval := cs.config.Cron.SweepInterval()
+	if interval <= 0 {
+		return nil
+	}
+
+	entry, err := cs.cron.AddFunc(fmt.Sprintf("@every %s", interval), cs.sweepOverdue)
+	if err != nil {
+		return fmt.Errorf("failed to schedule overdue sweep: %w", err)
+	}
+
+	cs.sweepEntry = entry
+
+	log.Printf("🧹 Overdue sweep enabled (every %v)", interval)
+
+	return nil
+}
+
+// sweepOverdue publishes scheduled posts that came due while the scheduler was running and are
+// overdue by more than the execution tolerance, which means their timer was lost or did not fire,
+// e.g. after a suspend or a clock jump. A stale timer still tracked for such a post is stopped first so it cannot fire too.
+func (cs *Scheduler) sweepOverdue() {
+	if cs.scheduler.IsPaused() {
+		return
+	}
+
+	cutoff := time.Now().Add(-executionTolerance)
+
+	for _, post := range cs.scheduler.GetDuePosts(cs.config) {
+		if post.ScheduledAt.After(cutoff) {
+			continue // Its timer may be firing right now
+		}
+
+		if post.ScheduledAt.Before(cs.startedAt) {
+			continue // Overdue before this run started, so cron.overdue_policy decides
+		}
+
+		cs.timersMux.Lock()
+		if existing, ok := cs.timers[post.ID]; ok {
+			existing.Timer.Stop()
+			delete(cs.timers, post.ID)
+		}
+		cs.timersMux.Unlock()
+
+		log.Printf("🧹 Sweep found post %d overdue since %s, publishing", post.ID, post.ScheduledAt.Format("2006-01-02 15:04:05 MST"))
+
+		cs.publishPost(post.ID)
+	}
+}
@@ -0,0 +1,157 @@
+package cron
+
+import (
+	"bytes"
+	"encoding/json"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"PostedIn/internal/config"
+	"PostedIn/internal/models"
+	"PostedIn/internal/scheduler"
+)
+
+// sweepStore returns a dry-run scheduler holding scheduled posts due the given offsets from now,
+// with IDs 1, 2, ... in order.
+func sweepStore(t *testing.T, cfg *config.Config, offsets ...time.Duration) *scheduler.Scheduler {
+	t.Helper()
+
+	sched := scheduler.NewScheduler(filepath.Join(t.TempDir(), "posts.json"))
+	sched.LoadSwitches(cfg)
+
+	backup := scheduler.Backup{Version: scheduler.BackupVersion}
+	for i, offset := range offsets {
+		backup.Posts = append(backup.Posts, models.Post{
+			ID:          i + 1,
+			Content:     "sweep",
+			ScheduledAt: time.Now().Add(offset),
+			Status:      models.StatusScheduled,
+			PostType:    models.PostTypeText,
+		})
+	}
+
+	data, err := json.Marshal(backup)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := sched.Restore(bytes.NewReader(data), cfg); err != nil {
+		t.Fatalf("Restore: %v", err)
+	}
+
+	return sched
+}
+
+func sweepStatuses(sched *scheduler.Scheduler) map[int]string {
+	statuses := make(map[int]string)
+	for _, post := range sched.GetPosts() {
+		statuses[post.ID] = post.Status
+	}
+
+	return statuses
+}
+
+func TestSweepPublishesPostWithLostTimer(t *testing.T) {
+	cfg := &config.Config{Timezone: config.TimezoneConfig{Location: "UTC"}, DryRun: true}
+
+	// 1 lost its timer, 2 is within the tolerance of its own timer, 3 was overdue before this run
+	// started and 4 is not due yet
+	sched := sweepStore(t, cfg, -10*time.Minute, -time.Minute, -time.Hour, time.Hour)
+
+	cs := NewScheduler(sched, cfg)
+	cs.startedAt = time.Now().Add(-30 * time.Minute)
+
+	// A stale timer still tracked for the lost post must not publish it a second time
+	stale := time.AfterFunc(time.Hour, func() { t.Error("the stale timer fired") })
+	cs.timers[1] = &PostTimer{PostID: 1, Timer: stale}
+
+	cs.sweepOverdue()
+
+	statuses := sweepStatuses(sched)
+
+	want := map[int]string{
+		1: models.StatusPosted,
+		2: models.StatusScheduled,
+		3: models.StatusScheduled,
+		4: models.StatusScheduled,
+	}
+
+	for id, status := range want {
+		if statuses[id] != status {
+			t.Errorf("post %d status = %q, want %q", id, statuses[id], status)
+		}
+	}
+
+	if _, ok := cs.timers[1]; ok {
+		t.Error("the sweep kept the stale timer of the post it published")
+	}
+
+	if stale.Stop() {
+		t.Error("the stale timer was still active")
+	}
+}
+
+func TestSweepSkipsWhilePaused(t *testing.T) {
+	cfg := &config.Config{Timezone: config.TimezoneConfig{Location: "UTC"}, DryRun: true, Paused: true}
+	sched := sweepStore(t, cfg, -10*time.Minute)
+
+	cs := NewScheduler(sched, cfg)
+	cs.startedAt = time.Now().Add(-time.Hour)
+
+	cs.sweepOverdue()
+
+	if status := sweepStatuses(sched)[1]; status != models.StatusScheduled {
+		t.Errorf("paused sweep left status %q, want the post scheduled", status)
+	}
+}
+
+func TestStartSweepFollowsConfig(t *testing.T) {
+	cfg := &config.Config{Timezone: config.TimezoneConfig{Location: "UTC"}, DryRun: true}
+	sched := sweepStore(t, cfg)
+
+	cs := NewScheduler(sched, cfg)
+	if err := cs.Start(); err != nil {
+		t.Fatalf("Start: %v", err)
+	}
+
+	t.Cleanup(cs.Stop)
+
+	if cs.sweepEntry != 0 {
+		t.Fatal("sweep scheduled although cron.sweep_enabled is off")
+	}
+
+	cfg.Cron.SweepEnabled = true
+	cfg.Cron.SweepIntervalMinutes = 1
+
+	if err := cs.startSweep(); err != nil {
+		t.Fatalf("startSweep: %v", err)
+	}
+
+	if err := cs.startSweep(); err != nil {
+		t.Fatalf("second startSweep: %v", err)
+	}
+
+	// Starting the sweep again replaces its job instead of adding a second one
+	if entries := cs.cron.Entries(); cs.sweepEntry == 0 || len(entries) != 1 || entries[0].ID != cs.sweepEntry {
+		t.Errorf("cron jobs %+v, want only the sweep %d", entries, cs.sweepEntry)
+	}
+}
+
+func TestSweepInterval(t *testing.T) {
+	tests := []struct {
+		cron config.CronConfig
+		want time.Duration
+	}{
+		{config.CronConfig{}, 0},
+		{config.CronConfig{SweepIntervalMinutes: 3}, 0},
+		{config.CronConfig{SweepEnabled: true}, config.DefaultSweepInterval},
+		{config.CronConfig{SweepEnabled: true, SweepIntervalMinutes: 3}, 3 * time.Minute},
+	}
+
+	for _, tt := range tests {
+		if got := tt.cron.SweepInterval(); got != tt.want {
+			t.Errorf("SweepInterval(%+v) = %v, want %v", tt.cron, got, tt.want)
+		}
+	}
+}
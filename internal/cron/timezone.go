@@ -0,0 +1,36 @@
+package cron
+
+import (
+	"log"
+	"time"
+
+	"PostedIn/internal/config"
+
+	"github.com/robfig/cron/v3"
+)
+
+// newCron creates a cron in the configured timezone, falling back to UTC when it cannot be loaded.
+func newCron(cfg *config.Config) *cron.Cron {
+	loc, err := cfg.GetTimezone()
+	if err != nil {
+		log.Printf("⚠️ Failed to get user timezone, using UTC: %v", err)
+
+		loc = time.UTC
+	}
+
+	return cron.New(
+		cron.WithLocation(loc),
+		cron.WithLogger(cron.VerbosePrintfLogger(log.New(log.Writer(), "CRON: ", log.LstdFlags))),
+	)
+}
+
+// CurrentTimezone returns the location the cron scheduler evaluates schedules in.
+func (cs *Scheduler) CurrentTimezone() *time.Location {
+	return cs.cron.Location()
+}
+
+// VerifyTimezone reports whether the cron scheduler runs in the configured timezone, flagging
+// a silent UTC fallback when the configured location cannot be loaded.
+func (cs *Scheduler) VerifyTimezone() error {
+	return cs.config.VerifyLocation(cs.CurrentTimezone())
+}
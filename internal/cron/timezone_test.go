@@ -0,0 +1,60 @@
+package cron
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"PostedIn/internal/config"
+	"PostedIn/internal/scheduler"
+)
+
+func TestUpdateConfigAppliesTimezone(t *testing.T) {
+	if _, err := time.LoadLocation("America/New_York"); err != nil {
+		t.Skipf("timezone data unavailable: %v", err)
+	}
+
+	sched := scheduler.NewScheduler(filepath.Join(t.TempDir(), "posts.json"))
+	cs := NewScheduler(sched, &config.Config{Timezone: config.TimezoneConfig{Location: "UTC"}})
+
+	if got := cs.CurrentTimezone().String(); got != "UTC" {
+		t.Fatalf("CurrentTimezone = %s, want UTC", got)
+	}
+
+	cfg := &config.Config{Timezone: config.TimezoneConfig{Location: "America/New_York"}}
+	if err := cs.UpdateConfig(cfg); err != nil {
+		t.Fatalf("UpdateConfig: %v", err)
+	}
+
+	if got := cs.CurrentTimezone().String(); got != "America/New_York" {
+		t.Errorf("CurrentTimezone = %s after the update, want America/New_York", got)
+	}
+
+	if err := cs.VerifyTimezone(); err != nil {
+		t.Errorf("VerifyTimezone: %v", err)
+	}
+
+	// The config changed without the cron being recreated
+	cfg.Timezone.Location = "UTC"
+
+	if err := cs.VerifyTimezone(); err == nil {
+		t.Error("VerifyTimezone accepted a cron running in another timezone than the config")
+	}
+}
+
+func TestVerifyTimezoneFlagsUTCFallback(t *testing.T) {
+	sched := scheduler.NewScheduler(filepath.Join(t.TempDir(), "posts.json"))
+	cs := NewScheduler(sched, &config.Config{Timezone: config.TimezoneConfig{Location: "UTC"}})
+
+	if err := cs.UpdateConfig(&config.Config{Timezone: config.TimezoneConfig{Location: "Mars/Olympus_Mons"}}); err != nil {
+		t.Fatalf("UpdateConfig: %v", err)
+	}
+
+	if got := cs.CurrentTimezone(); got != time.UTC {
+		t.Fatalf("CurrentTimezone = %s, want the UTC fallback", got)
+	}
+
+	if err := cs.VerifyTimezone(); err == nil {
+		t.Error("VerifyTimezone did not flag the silent UTC fallback")
+	}
+}
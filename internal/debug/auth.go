@@ -6,6 +6,7 @@ import (
 	"net/url"
 	"strings"
 
+	"PostedIn/internal/auth"
 	"PostedIn/internal/config"
 	"PostedIn/pkg/linkedin"
 )
@@ -38,6 +39,10 @@ func ValidateLinkedInConfig(cfg *config.Config) error {
 		return fmt.Errorf("redirect URL must have a valid host")
 	}
 
+	if _, err := cfg.CallbackBindAddress(); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -48,14 +53,29 @@ func PrintAuthDetails(cfg *config.Config) {
 	fmt.Printf("Client ID: %s\n", maskString(cfg.LinkedIn.ClientID))
 	fmt.Printf("Redirect URL: %s\n", cfg.LinkedIn.RedirectURL)
 
+	if bindAddr, err := cfg.CallbackBindAddress(); err == nil {
+		fmt.Printf("Callback bind address: %s\n", bindAddr)
+	}
+
+	printRedirectChecks(cfg.LinkedIn.RedirectURL)
+
 	// Create LinkedIn client and get auth URL
 	linkedinConfig := linkedin.NewConfig(
 		cfg.LinkedIn.ClientID,
 		cfg.LinkedIn.ClientSecret,
 		cfg.LinkedIn.RedirectURL,
 	)
+	linkedinConfig.Scopes = cfg.OAuthScopes()
 	client := linkedin.NewClient(linkedinConfig)
-	authURL := client.GetAuthURL("linkedin-auth-state")
+
+	// A throwaway state: this URL is for inspection, sign in through the CLI or web API instead
+	state, err := auth.NewState()
+	if err != nil {
+		fmt.Printf("❌ %v\n", err)
+		return
+	}
+
+	authURL := client.GetAuthURL(state)
 
 	fmt.Printf("Full Auth URL: %s\n", authURL)
 
@@ -87,6 +107,28 @@ func PrintAuthDetails(cfg *config.Config) {
 	checkParam(queryParams, "state", "State")
 }
 
+// printRedirectChecks prints the redirect URL mistakes found, each with its fix.
+func printRedirectChecks(redirectURL string) {
+	fmt.Println("\n🔗 Redirect URL Checks:")
+
+	defer fmt.Println()
+
+	issues := redirectURLIssues(redirectURL)
+	if len(issues) == 0 {
+		fmt.Println("  ✓ No common redirect URL problems found")
+		return
+	}
+
+	for _, issue := range issues {
+		fmt.Printf("  ⚠️ %s\n", issue.Problem)
+		fmt.Printf("     Fix: %s\n", issue.Fix)
+	}
+
+	if normalized := normalizeRedirectURL(redirectURL); normalized != redirectURL {
+		fmt.Printf("  💡 Suggested redirect URL: %s\n", normalized)
+	}
+}
+
 func checkParam(params url.Values, key, name string) {
 	if values, exists := params[key]; exists && len(values) > 0 && values[0] != "" {
 		if key == "client_id" {
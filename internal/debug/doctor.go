@@ -0,0 +1,146 @@
+package debug
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"PostedIn/internal/config"
+	"PostedIn/internal/models"
+	"PostedIn/internal/scheduler"
+	"PostedIn/pkg/linkedin"
+)
+
+// Options controls the optional parts of RunDiagnostics.
+type Options struct {
+	Online         bool   // Check the LinkedIn app credentials against LinkedIn
+	PostsFile      string // Posts file whose permissions are checked
+	FixPermissions bool   // Tighten sensitive files readable by other users to 0600
+	// CronTimezone is the location the cron scheduler runs in, compared with the config; nil skips the check.
+	CronTimezone *time.Location
+}
+
+// RunDiagnostics checks the configuration, sensitive file permissions and stored posts,
+// printing each finding, and returns the number of problems found.
+func RunDiagnostics(cfg *config.Config, posts []models.Post, opts Options) int {
+	fmt.Println("🩺 PostedIn Diagnostics")
+	fmt.Println("=======================")
+
+	problems := 0
+
+	fmt.Println("\n⚙️ Configuration:")
+
+	if err := ValidateLinkedInConfig(cfg); err != nil {
+		fmt.Printf("  ❌ %v\n", err)
+
+		problems++
+	} else {
+		fmt.Println("  ✅ LinkedIn configuration is valid")
+
+		if opts.Online && !checkCredentials(cfg) {
+			problems++
+		}
+	}
+
+	if opts.CronTimezone != nil {
+		if err := cfg.VerifyLocation(opts.CronTimezone); err != nil {
+			fmt.Printf("  ⚠️ Cron timezone mismatch: %v\n", err)
+
+			problems++
+		} else {
+			fmt.Printf("  ✅ Cron scheduler runs in the configured timezone (%s)\n", opts.CronTimezone)
+		}
+	}
+
+	problems += checkPermissions(cfg, opts.PostsFile, opts.FixPermissions)
+
+	fmt.Println("\n🕒 Schedule integrity:")
+
+	scheduleProblems := 0
+
+	for _, post := range posts {
+		if post.Status != models.StatusScheduled && post.Status != models.StatusWaiting {
+			continue
+		}
+
+		if err := scheduler.VerifyScheduleIntegrity(post, cfg); err != nil {
+			fmt.Printf("  ⚠️ %v\n", err)
+
+			scheduleProblems++
+		}
+	}
+
+	if scheduleProblems == 0 {
+		fmt.Println("  ✅ All scheduled times match the configured timezone")
+	}
+
+	problems += scheduleProblems
+
+	problems += checkSLOs(cfg, posts)
+
+	fmt.Println()
+
+	if problems == 0 {
+		fmt.Println("✅ No problems found")
+	} else {
+		fmt.Printf("❌ %d problem(s) found\n", problems)
+	}
+
+	return problems
+}
+
+// checkCredentials asks LinkedIn whether the app credentials are plausible and reports whether they passed.
+func checkCredentials(cfg *config.Config) bool {
+	client := linkedin.NewClient(linkedin.NewConfig(
+		cfg.LinkedIn.ClientID,
+		cfg.LinkedIn.ClientSecret,
+		cfg.LinkedIn.RedirectURL,
+	))
+
+	check, err := client.CheckCredentials(context.Background())
+	if err != nil {
+		fmt.Printf("  ⚠️ Could not reach LinkedIn to check credentials: %v\n", err)
+		return true
+	}
+
+	switch check.Status {
+	case linkedin.CredentialsRejected:
+		fmt.Printf("  ❌ LinkedIn rejected the app credentials: %s\n", check.Detail)
+		return false
+	case linkedin.CredentialsNeedAuthorization:
+		fmt.Println("  ✅ LinkedIn accepted the app credentials (sign in to authorize if you have not yet)")
+	default:
+		fmt.Printf("  ⚠️ Could not verify the app credentials: %s\n", check.Detail)
+	}
+
+	return true
+}
+
+// checkSLOs prints the publish success rate and latency of each SLO window and returns the number of breaches.
+func checkSLOs(cfg *config.Config, posts []models.Post) int {
+	fmt.Println("\n📈 Publish SLOs:")
+
+	now, err := cfg.Now()
+	if err != nil {
+		now = time.Now()
+	}
+
+	breaches := 0
+
+	for _, window := range scheduler.ComputeSLOs(posts, cfg.SLO, now) {
+		fmt.Printf("  Last %dh: %d attempt(s), %.1f%% succeeded, p50 %.0fs, p95 %.0fs late\n",
+			window.Hours, window.Attempts, window.SuccessRate, window.P50LatencySeconds, window.P95LatencySeconds)
+
+		for _, breach := range window.Breaches {
+			fmt.Printf("  ❌ %s\n", breach)
+		}
+
+		breaches += len(window.Breaches)
+	}
+
+	if breaches == 0 {
+		fmt.Println("  ✅ Publishing meets the SLO targets")
+	}
+
+	return breaches
+}
@@ -0,0 +1,92 @@
+package debug
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"runtime"
+
+	"PostedIn/internal/config"
+)
+
+// sensitiveFileMode is the mode the config, token and posts files are written with.
+const sensitiveFileMode fs.FileMode = 0o600
+
+// sensitiveFiles returns the files that may contain secrets or private drafts, skipping unset paths.
+func sensitiveFiles(cfg *config.Config, postsFile string) []string {
+	var files []string
+
+	for _, path := range []string{config.ConfigPath(), cfg.Storage.TokenFile, postsFile, cfg.Storage.ReplicaFile} {
+		if path != "" {
+			files = append(files, path)
+		}
+	}
+
+	return files
+}
+
+// loosePermissions reports the mode of path when group or other users can access it;
+// missing files and platforms without Unix permission bits are not reported.
+func loosePermissions(path string) (fs.FileMode, bool, error) {
+	if runtime.GOOS == "windows" {
+		return 0, false, nil
+	}
+
+	info, err := os.Stat(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return 0, false, nil
+	}
+
+	if err != nil {
+		return 0, false, err
+	}
+
+	mode := info.Mode().Perm()
+
+	return mode, mode&^sensitiveFileMode != 0, nil
+}
+
+// checkPermissions reports sensitive files readable by other users, tightening them to 0600
+// with fix set, and returns the number of problems left.
+func checkPermissions(cfg *config.Config, postsFile string, fix bool) int {
+	fmt.Println("\n🔒 File permissions:")
+
+	problems := 0
+
+	for _, path := range sensitiveFiles(cfg, postsFile) {
+		mode, loose, err := loosePermissions(path)
+		if err != nil {
+			fmt.Printf("  ⚠️ Could not check %s: %v\n", path, err)
+			continue
+		}
+
+		if !loose {
+			continue
+		}
+
+		if !fix {
+			fmt.Printf("  ❌ %s has mode %04o; other users can read it (run doctor --fix-permissions or chmod 600 %s)\n", path, mode, path)
+
+			problems++
+
+			continue
+		}
+
+		if err := os.Chmod(path, sensitiveFileMode); err != nil {
+			fmt.Printf("  ❌ %s has mode %04o and could not be fixed: %v\n", path, mode, err)
+
+			problems++
+
+			continue
+		}
+
+		fmt.Printf("  🔧 %s changed from %04o to %04o\n", path, mode, sensitiveFileMode)
+	}
+
+	if problems == 0 {
+		fmt.Println("  ✅ Sensitive files are only readable by their owner")
+	}
+
+	return problems
+}
@@ -0,0 +1,99 @@
+package debug
+
+import (
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"PostedIn/internal/config"
+)
+
+// writeWithMode creates a file with exactly the given permission bits.
+func writeWithMode(t *testing.T, path string, mode os.FileMode) {
+	t.Helper()
+
+	if err := os.WriteFile(path, []byte("{}"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := os.Chmod(path, mode); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestLoosePermissions(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("Unix permission bits are not checked on Windows")
+	}
+
+	dir := t.TempDir()
+
+	tests := []struct {
+		mode  os.FileMode
+		loose bool
+	}{
+		{0o600, false},
+		{0o400, false},
+		{0o640, true},
+		{0o604, true},
+		{0o644, true},
+		{0o666, true},
+	}
+
+	for _, tt := range tests {
+		path := filepath.Join(dir, "token.json")
+		writeWithMode(t, path, tt.mode)
+
+		mode, loose, err := loosePermissions(path)
+		if err != nil {
+			t.Fatalf("loosePermissions(%04o): %v", tt.mode, err)
+		}
+
+		if loose != tt.loose || mode != tt.mode {
+			t.Errorf("loosePermissions(%04o) = %04o, %v, want loose %v", tt.mode, mode, loose, tt.loose)
+		}
+	}
+
+	if _, loose, err := loosePermissions(filepath.Join(dir, "missing.json")); err != nil || loose {
+		t.Errorf("missing file = %v, %v, want it not reported", loose, err)
+	}
+}
+
+func TestCheckPermissions(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("Unix permission bits are not checked on Windows")
+	}
+
+	dir := t.TempDir()
+
+	configPath := filepath.Join(dir, "config.json")
+	config.SetConfigPath(configPath)
+	t.Cleanup(func() { config.SetConfigPath("") })
+
+	cfg := &config.Config{Storage: config.StorageConfig{TokenFile: filepath.Join(dir, "token.json")}}
+	postsFile := filepath.Join(dir, "posts.json")
+
+	writeWithMode(t, configPath, 0o600)
+	writeWithMode(t, cfg.Storage.TokenFile, 0o644)
+	writeWithMode(t, postsFile, 0o666)
+
+	if problems := checkPermissions(cfg, postsFile, false); problems != 2 {
+		t.Errorf("problems = %d, want the token and posts files", problems)
+	}
+
+	if problems := checkPermissions(cfg, postsFile, true); problems != 0 {
+		t.Errorf("problems after fixing = %d, want none", problems)
+	}
+
+	for _, path := range []string{cfg.Storage.TokenFile, postsFile} {
+		info, err := os.Stat(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if info.Mode().Perm() != sensitiveFileMode {
+			t.Errorf("%s mode = %04o after fixing, want %04o", path, info.Mode().Perm(), sensitiveFileMode)
+		}
+	}
+}
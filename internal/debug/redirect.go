@@ -0,0 +1,148 @@
+package debug
+
+import (
+	"fmt"
+	"net"
+	"net/url"
+	"strings"
+	"unicode"
+
+	"golang.org/x/net/idna"
+)
+
+// redirectIssue is a common redirect URL mistake with the fix to apply.
+type redirectIssue struct {
+	Problem string
+	Fix     string
+}
+
+// redirectChecks are run in order against the configured redirect URL.
+var redirectChecks = []func(raw string, u *url.URL) *redirectIssue{
+	checkRedirectWhitespace,
+	checkRedirectTrailingSlash,
+	checkRedirectScheme,
+	checkRedirectHost,
+}
+
+// redirectURLIssues runs the redirect URL checks and returns every issue found.
+func redirectURLIssues(raw string) []redirectIssue {
+	u, err := url.Parse(strings.TrimSpace(raw))
+	if err != nil {
+		return []redirectIssue{{
+			Problem: fmt.Sprintf("redirect URL cannot be parsed: %v", err),
+			Fix:     "use a full URL such as http://localhost:8080/callback",
+		}}
+	}
+
+	var issues []redirectIssue
+
+	for _, check := range redirectChecks {
+		if issue := check(raw, u); issue != nil {
+			issues = append(issues, *issue)
+		}
+	}
+
+	return issues
+}
+
+// normalizeRedirectURL returns the redirect URL trimmed, with a lower-case punycode host, http on
+// local hosts and no trailing slash, which is the form to register in the LinkedIn app settings.
+func normalizeRedirectURL(raw string) string {
+	u, err := url.Parse(strings.TrimSpace(raw))
+	if err != nil {
+		return strings.TrimSpace(raw)
+	}
+
+	u.Scheme = strings.ToLower(u.Scheme)
+	if u.Scheme == "https" && isLocalHost(u.Hostname()) {
+		u.Scheme = "http"
+	}
+
+	if host, err := idna.Lookup.ToASCII(u.Host); err == nil {
+		u.Host = host
+	}
+
+	u.Host = strings.ToLower(u.Host)
+
+	if len(u.Path) > 1 {
+		u.Path = strings.TrimRight(u.Path, "/")
+	}
+
+	return u.String()
+}
+
+// checkRedirectWhitespace flags spaces or line breaks pasted around the URL, which LinkedIn treats as part of it.
+func checkRedirectWhitespace(raw string, _ *url.URL) *redirectIssue {
+	if raw == strings.TrimSpace(raw) {
+		return nil
+	}
+
+	return &redirectIssue{
+		Problem: "redirect URL has leading or trailing whitespace",
+		Fix:     "remove the surrounding spaces from linkedin.redirect_url",
+	}
+}
+
+// checkRedirectTrailingSlash flags a trailing slash, which LinkedIn matches exactly and the callback handlers do not serve.
+func checkRedirectTrailingSlash(_ string, u *url.URL) *redirectIssue {
+	if len(u.Path) <= 1 || !strings.HasSuffix(u.Path, "/") {
+		return nil
+	}
+
+	return &redirectIssue{
+		Problem: fmt.Sprintf("redirect URL path %q ends with a slash", u.Path),
+		Fix: fmt.Sprintf("LinkedIn compares redirect URLs character by character; use %s here and in the app's Authorized redirect URLs",
+			strings.TrimRight(u.Path, "/")),
+	}
+}
+
+// checkRedirectScheme flags https on localhost, which the plain-HTTP callback server cannot answer,
+// and http on a public host, which LinkedIn rejects.
+func checkRedirectScheme(_ string, u *url.URL) *redirectIssue {
+	local := isLocalHost(u.Hostname())
+
+	switch {
+	case strings.EqualFold(u.Scheme, "https") && local:
+		return &redirectIssue{
+			Problem: "redirect URL uses https on a local host, but the callback server only speaks http",
+			Fix:     "use http://" + u.Host + u.Path + " in both linkedin.redirect_url and the LinkedIn app settings",
+		}
+	case strings.EqualFold(u.Scheme, "http") && !local && u.Host != "":
+		return &redirectIssue{
+			Problem: "redirect URL uses http on a public host",
+			Fix:     "LinkedIn only accepts http for localhost; serve the callback over https and register https://" + u.Host + u.Path,
+		}
+	}
+
+	return nil
+}
+
+// checkRedirectHost flags non-ASCII host names, which LinkedIn compares in their punycode form.
+func checkRedirectHost(_ string, u *url.URL) *redirectIssue {
+	host := u.Hostname()
+
+	if strings.IndexFunc(host, func(r rune) bool { return r > unicode.MaxASCII }) < 0 {
+		return nil
+	}
+
+	fix := "register and configure the punycode form of the host (xn--...) so both sides match"
+	if ascii, err := idna.Lookup.ToASCII(host); err == nil {
+		fix = fmt.Sprintf("use the punycode host %s in both linkedin.redirect_url and the LinkedIn app settings", ascii)
+	}
+
+	return &redirectIssue{
+		Problem: fmt.Sprintf("redirect URL host %q contains non-ASCII characters", host),
+		Fix:     fix,
+	}
+}
+
+// isLocalHost reports whether host is localhost or a loopback address.
+func isLocalHost(host string) bool {
+	if strings.EqualFold(host, "localhost") {
+		return true
+	}
+
+	ip := net.ParseIP(host)
+
+	return ip != nil && ip.IsLoopback()
+}
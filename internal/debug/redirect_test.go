@@ -0,0 +1,70 @@
+package debug
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestRedirectURLIssues(t *testing.T) {
+	tests := []struct {
+		name    string
+		url     string
+		problem string // Substring of the single expected problem, or empty for none
+	}{
+		{"valid local", "http://localhost:8080/callback", ""},
+		{"valid public", "https://posts.example.com/callback", ""},
+		{"loopback ip", "http://127.0.0.1:8080/callback", ""},
+		{"whitespace", " http://localhost:8080/callback\n", "whitespace"},
+		{"trailing slash", "http://localhost:8080/callback/", "ends with a slash"},
+		{"https on localhost", "https://localhost:8080/callback", "https on a local host"},
+		{"http on public host", "http://posts.example.com/callback", "http on a public host"},
+		{"non-ascii host", "https://bücher.example/callback", "non-ASCII"},
+		{"unparsable", "http://[::1", "cannot be parsed"},
+	}
+
+	for _, tt := range tests {
+		issues := redirectURLIssues(tt.url)
+
+		if tt.problem == "" {
+			if len(issues) != 0 {
+				t.Errorf("%s: issues %+v, want none", tt.name, issues)
+			}
+
+			continue
+		}
+
+		if len(issues) != 1 || !strings.Contains(issues[0].Problem, tt.problem) {
+			t.Errorf("%s: issues %+v, want one about %q", tt.name, issues, tt.problem)
+			continue
+		}
+
+		if issues[0].Fix == "" {
+			t.Errorf("%s: issue without a fix", tt.name)
+		}
+	}
+}
+
+func TestRedirectURLIssuesPunycodeFix(t *testing.T) {
+	issues := redirectURLIssues("https://bücher.example/callback")
+	if len(issues) != 1 || !strings.Contains(issues[0].Fix, "xn--bcher-kva.example") {
+		t.Errorf("issues %+v, want the punycode host in the fix", issues)
+	}
+}
+
+func TestNormalizeRedirectURL(t *testing.T) {
+	tests := []struct {
+		url  string
+		want string
+	}{
+		{" http://localhost:8080/callback/ ", "http://localhost:8080/callback"},
+		{"HTTPS://LocalHost:8080/callback", "http://localhost:8080/callback"},
+		{"https://bücher.example/callback", "https://xn--bcher-kva.example/callback"},
+		{"https://posts.example.com/", "https://posts.example.com/"},
+	}
+
+	for _, tt := range tests {
+		if got := normalizeRedirectURL(tt.url); got != tt.want {
+			t.Errorf("normalizeRedirectURL(%q) = %q, want %q", tt.url, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,151 @@
+// Package email schedules posts from emails in an IMAP mailbox: the subject carries the schedule,
+// the body the content and attachments the media.
+package email
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net"
+	"time"
+
+	"github.com/emersion/go-imap"
+	"github.com/emersion/go-imap/client"
+)
+
+const imapTimeout = 30 * time.Second
+
+// Message is a parsed email.
+type Message struct {
+	UID         uint32
+	From        []string // Sender addresses, lower-cased
+	Subject     string
+	Body        string // Plain-text body
+	Attachments []Attachment
+}
+
+// Attachment is a file attached to an email.
+type Attachment struct {
+	Filename    string
+	ContentType string
+	Data        []byte
+}
+
+// Mailbox lists emails that were not processed yet and marks them processed.
+type Mailbox interface {
+	Unprocessed(ctx context.Context) ([]Message, error)
+	MarkProcessed(ctx context.Context, uids []uint32) error
+}
+
+// IMAPMailbox reads a folder or Gmail label over IMAPS, marking processed emails with a keyword flag.
+type IMAPMailbox struct {
+	Server   string // host:port, e.g. imap.gmail.com:993
+	Username string
+	Password string
+	Folder   string
+	Flag     string // Keyword set on processed emails
+}
+
+// Unprocessed fetches the emails in the folder that do not carry the processed flag.
+func (m *IMAPMailbox) Unprocessed(_ context.Context) ([]Message, error) {
+	c, err := m.connect()
+	if err != nil {
+		return nil, err
+	}
+
+	defer m.logout(c)
+
+	criteria := imap.NewSearchCriteria()
+	criteria.WithoutFlags = []string{m.Flag}
+
+	uids, err := c.UidSearch(criteria)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search %s: %w", m.Folder, err)
+	}
+
+	if len(uids) == 0 {
+		return nil, nil
+	}
+
+	seqset := new(imap.SeqSet)
+	seqset.AddNum(uids...)
+
+	section := &imap.BodySectionName{Peek: true} // Peek leaves the \Seen flag alone
+	fetched := make(chan *imap.Message, len(uids))
+
+	if err := c.UidFetch(seqset, []imap.FetchItem{imap.FetchUid, section.FetchItem()}, fetched); err != nil {
+		return nil, fmt.Errorf("failed to fetch emails: %w", err)
+	}
+
+	var messages []Message
+
+	for msg := range fetched {
+		body := msg.GetBody(section)
+		if body == nil {
+			continue
+		}
+
+		parsed, err := parseMessage(body)
+		if err != nil {
+			log.Printf("⚠️ Skipping unreadable email %d: %v", msg.Uid, err)
+			continue
+		}
+
+		parsed.UID = msg.Uid
+		messages = append(messages, parsed)
+	}
+
+	return messages, nil
+}
+
+// MarkProcessed sets the processed flag on the emails, so later polls skip them.
+func (m *IMAPMailbox) MarkProcessed(_ context.Context, uids []uint32) error {
+	if len(uids) == 0 {
+		return nil
+	}
+
+	c, err := m.connect()
+	if err != nil {
+		return err
+	}
+
+	defer m.logout(c)
+
+	seqset := new(imap.SeqSet)
+	seqset.AddNum(uids...)
+
+	flags := []interface{}{m.Flag, imap.SeenFlag}
+	if err := c.UidStore(seqset, imap.FormatFlagsOp(imap.AddFlags, true), flags, nil); err != nil {
+		return fmt.Errorf("failed to flag processed emails: %w", err)
+	}
+
+	return nil
+}
+
+// connect logs in and selects the folder.
+func (m *IMAPMailbox) connect() (*client.Client, error) {
+	c, err := client.DialWithDialerTLS(&net.Dialer{Timeout: imapTimeout}, m.Server, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to %s: %w", m.Server, err)
+	}
+
+	c.Timeout = imapTimeout
+
+	if err := c.Login(m.Username, m.Password); err != nil {
+		m.logout(c)
+		return nil, fmt.Errorf("IMAP login failed: %w", err)
+	}
+
+	if _, err := c.Select(m.Folder, false); err != nil {
+		m.logout(c)
+		return nil, fmt.Errorf("failed to open %s: %w", m.Folder, err)
+	}
+
+	return c, nil
+}
+
+func (m *IMAPMailbox) logout(c *client.Client) {
+	if err := c.Logout(); err != nil {
+		log.Printf("Warning: IMAP logout failed: %v", err)
+	}
+}
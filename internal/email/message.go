@@ -0,0 +1,111 @@
+package email
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"path/filepath"
+	"strings"
+
+	_ "github.com/emersion/go-message/charset" // Decode non-UTF-8 emails
+	"github.com/emersion/go-message/mail"
+)
+
+// maxAttachmentSize bounds each attachment read into memory.
+const maxAttachmentSize = 200 << 20
+
+// signatureDelimiter starts an email signature, which is left out of the post.
+const signatureDelimiter = "\n-- \n"
+
+// parseMessage reads the sender, subject, plain-text body and attachments of a raw email.
+func parseMessage(r io.Reader) (Message, error) {
+	reader, err := mail.CreateReader(r)
+	if err != nil {
+		return Message{}, fmt.Errorf("failed to parse email: %w", err)
+	}
+
+	var msg Message
+
+	if msg.Subject, err = reader.Header.Subject(); err != nil {
+		return Message{}, fmt.Errorf("failed to read subject: %w", err)
+	}
+
+	from, err := reader.Header.AddressList("From")
+	if err != nil {
+		return Message{}, fmt.Errorf("failed to read sender: %w", err)
+	}
+
+	for _, address := range from {
+		msg.From = append(msg.From, strings.ToLower(address.Address))
+	}
+
+	for {
+		part, err := reader.NextPart()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		if err != nil {
+			return Message{}, fmt.Errorf("failed to read email part: %w", err)
+		}
+
+		switch header := part.Header.(type) {
+		case *mail.InlineHeader:
+			contentType, _, _ := header.ContentType()
+			if msg.Body != "" || (contentType != "" && contentType != "text/plain") {
+				continue
+			}
+
+			body, err := io.ReadAll(part.Body)
+			if err != nil {
+				return Message{}, fmt.Errorf("failed to read body: %w", err)
+			}
+
+			msg.Body = cleanBody(string(body))
+		case *mail.AttachmentHeader:
+			attachment, err := readAttachment(header, part.Body)
+			if err != nil {
+				return Message{}, err
+			}
+
+			msg.Attachments = append(msg.Attachments, attachment)
+		}
+	}
+
+	return msg, nil
+}
+
+// readAttachment reads an attachment, guessing the type from its name when the sender sent none.
+func readAttachment(header *mail.AttachmentHeader, body io.Reader) (Attachment, error) {
+	filename, _ := header.Filename()
+	contentType, _, _ := header.ContentType()
+
+	if contentType == "" || contentType == "application/octet-stream" {
+		if guessed, _, err := mime.ParseMediaType(mime.TypeByExtension(strings.ToLower(filepath.Ext(filename)))); err == nil {
+			contentType = guessed
+		}
+	}
+
+	data, err := io.ReadAll(io.LimitReader(body, maxAttachmentSize+1))
+	if err != nil {
+		return Attachment{}, fmt.Errorf("failed to read attachment %q: %w", filename, err)
+	}
+
+	if len(data) > maxAttachmentSize {
+		return Attachment{}, fmt.Errorf("attachment %q is larger than %d MB", filename, maxAttachmentSize>>20)
+	}
+
+	return Attachment{Filename: filename, ContentType: contentType, Data: data}, nil
+}
+
+// cleanBody normalizes line endings and drops the signature and surrounding whitespace.
+func cleanBody(body string) string {
+	body = "\n" + strings.ReplaceAll(body, "\r\n", "\n")
+
+	if i := strings.Index(body, signatureDelimiter); i >= 0 {
+		body = body[:i]
+	}
+
+	return strings.TrimSpace(body)
+}
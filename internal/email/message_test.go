@@ -0,0 +1,47 @@
+package email
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestParseMessage(t *testing.T) {
+	raw := strings.Join([]string{
+		"From: Me <Me@Example.com>",
+		"Subject: tomorrow 9am",
+		"MIME-Version: 1.0",
+		`Content-Type: multipart/mixed; boundary="b"`,
+		"",
+		"--b",
+		"Content-Type: text/plain; charset=utf-8",
+		"",
+		"Shipping the new release today!",
+		"",
+		"-- ",
+		"Me",
+		"--b",
+		"Content-Type: application/octet-stream",
+		`Content-Disposition: attachment; filename="chart.png"`,
+		"",
+		"data",
+		"--b--",
+		"",
+	}, "\r\n")
+
+	msg, err := parseMessage(strings.NewReader(raw))
+	if err != nil {
+		t.Fatalf("parseMessage: %v", err)
+	}
+
+	if msg.Subject != "tomorrow 9am" || len(msg.From) != 1 || msg.From[0] != "me@example.com" {
+		t.Errorf("subject %q from %v", msg.Subject, msg.From)
+	}
+
+	if msg.Body != "Shipping the new release today!" {
+		t.Errorf("body = %q, want it without the signature", msg.Body)
+	}
+
+	if len(msg.Attachments) != 1 || msg.Attachments[0].ContentType != "image/png" || string(msg.Attachments[0].Data) != "data" {
+		t.Errorf("attachments = %+v, want the image typed from its name", msg.Attachments)
+	}
+}
@@ -0,0 +1,305 @@
+package email
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"PostedIn/internal/config"
+	"PostedIn/internal/models"
+	"PostedIn/internal/scheduler"
+	"PostedIn/internal/timezone"
+)
+
+const (
+	defaultPollInterval   = 15 * time.Minute
+	defaultFolder         = "INBOX"
+	defaultProcessedFlag  = "$PostedIn"
+	defaultAttachmentsDir = "email_attachments"
+	attachmentsDirPerm    = 0o700
+	attachmentFilePerm    = 0o600
+)
+
+// PostAdder stores new posts; *scheduler.Scheduler satisfies it.
+type PostAdder interface {
+	Add(post models.Post, cfg *config.Config) (models.Post, error)
+}
+
+// Poller turns new emails into scheduled posts and marks them processed.
+type Poller struct {
+	mailbox Mailbox
+	adder   PostAdder
+	cfg     *config.Config
+	onAdded func(models.Post)
+}
+
+// NewPoller creates a poller reading emails from mailbox and storing posts through adder.
+func NewPoller(mailbox Mailbox, adder PostAdder, cfg *config.Config) *Poller {
+	return &Poller{
+		mailbox: mailbox,
+		adder:   adder,
+		cfg:     cfg,
+	}
+}
+
+// NewPollerFromConfig creates a poller for the IMAP mailbox configured in cfg, or nil when email ingestion is disabled.
+func NewPollerFromConfig(cfg *config.Config, adder PostAdder) (*Poller, error) {
+	if !cfg.Email.Enabled {
+		return nil, nil
+	}
+
+	if cfg.Email.Server == "" || cfg.Email.Username == "" || cfg.Email.Password == "" {
+		return nil, fmt.Errorf("email.server, email.username and email.password are required when email ingestion is enabled")
+	}
+
+	if _, _, err := net.SplitHostPort(cfg.Email.Server); err != nil {
+		return nil, fmt.Errorf("email.server must be host:port, e.g. imap.gmail.com:993: %w", err)
+	}
+
+	folder := cfg.Email.Mailbox
+	if folder == "" {
+		folder = defaultFolder
+	}
+
+	mailbox := &IMAPMailbox{
+		Server:   cfg.Email.Server,
+		Username: cfg.Email.Username,
+		Password: cfg.Email.Password,
+		Folder:   folder,
+		Flag:     processedFlag(cfg),
+	}
+
+	return NewPoller(mailbox, adder, cfg), nil
+}
+
+// OnAdded registers a callback invoked for every post created from an email.
+func (p *Poller) OnAdded(fn func(models.Post)) {
+	p.onAdded = fn
+}
+
+// Interval returns the configured poll interval.
+func (p *Poller) Interval() time.Duration {
+	if p.cfg.Email.PollIntervalMinutes > 0 {
+		return time.Duration(p.cfg.Email.PollIntervalMinutes) * time.Minute
+	}
+
+	return defaultPollInterval
+}
+
+// Run polls immediately and then on every poll interval until ctx is cancelled.
+func (p *Poller) Run(ctx context.Context) {
+	interval := p.Interval()
+	log.Printf("📧 Email ingestion started (every %v)", interval)
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := p.Poll(ctx); err != nil {
+			log.Printf("❌ Email ingestion failed: %v", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Poll creates posts from unprocessed emails and returns how many were created.
+// Emails that do not match the rules or cannot become a post are logged, marked processed and
+// skipped; emails whose post could not be stored stay unprocessed and are retried.
+func (p *Poller) Poll(ctx context.Context) (int, error) {
+	messages, err := p.mailbox.Unprocessed(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	now, err := p.cfg.Now()
+	if err != nil {
+		now = time.Now()
+	}
+
+	var (
+		processed []uint32
+		addErr    error
+		created   int
+	)
+
+	for _, msg := range messages {
+		if !p.matches(msg) {
+			continue
+		}
+
+		post, err := p.buildPost(msg, now)
+		if err != nil {
+			log.Printf("⚠️ Skipping email %q: %v", msg.Subject, err)
+			processed = append(processed, msg.UID)
+
+			continue
+		}
+
+		added, err := p.adder.Add(post, p.cfg)
+		if err != nil {
+			addErr = fmt.Errorf("failed to add post from email %q: %w", msg.Subject, err)
+			break
+		}
+
+		processed = append(processed, msg.UID)
+		created++
+
+		if p.onAdded != nil {
+			p.onAdded(added)
+		}
+	}
+
+	if err := p.mailbox.MarkProcessed(ctx, processed); err != nil {
+		return created, err
+	}
+
+	if created > 0 {
+		log.Printf("📧 Scheduled %d post(s) from email", created)
+	}
+
+	return created, addErr
+}
+
+// matches reports whether the email passes the sender and subject rules; others are left untouched.
+func (p *Poller) matches(msg Message) bool {
+	if prefix := p.cfg.Email.SubjectPrefix; prefix != "" && !hasPrefixFold(strings.TrimSpace(msg.Subject), prefix) {
+		return false
+	}
+
+	allowed := p.cfg.Email.AllowedSenders
+	if len(allowed) == 0 {
+		return true
+	}
+
+	for _, sender := range msg.From {
+		if slices.ContainsFunc(allowed, func(a string) bool { return strings.EqualFold(a, sender) }) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// buildPost maps an email onto a post: the subject is the schedule, the body the content and the
+// first image or video attachment the media.
+func (p *Poller) buildPost(msg Message, now time.Time) (models.Post, error) {
+	if msg.Body == "" {
+		return models.Post{}, fmt.Errorf("email has no plain-text body")
+	}
+
+	scheduledAt, err := p.schedule(msg.Subject, now)
+	if err != nil {
+		return models.Post{}, err
+	}
+
+	if scheduledAt.Before(now) {
+		return models.Post{}, fmt.Errorf("scheduled time %s is in the past", scheduledAt.Format("2006-01-02 15:04"))
+	}
+
+	post := models.Post{
+		Content:     msg.Body,
+		ScheduledAt: scheduledAt,
+		PostType:    models.PostTypeText,
+	}
+
+	for _, attachment := range msg.Attachments {
+		isImage := strings.HasPrefix(attachment.ContentType, "image/")
+		isVideo := attachment.ContentType == "video/mp4"
+
+		if (!isImage && !isVideo) || post.ImagePath != "" || post.VideoPath != "" {
+			continue
+		}
+
+		path, err := p.saveAttachment(msg.UID, attachment)
+		if err != nil {
+			return models.Post{}, err
+		}
+
+		if isVideo {
+			post.PostType = models.PostTypeVideo
+			post.VideoPath = path
+		} else {
+			post.ImagePath = path
+		}
+	}
+
+	// Validate here so an email that can never become a post is skipped rather than retried
+	if err := scheduler.NormalizePost(&post, p.cfg); err != nil {
+		return models.Post{}, err
+	}
+
+	return post, nil
+}
+
+// schedule reads the time from the subject without the prefix, falling back to email.default_schedule.
+func (p *Poller) schedule(subject string, now time.Time) (time.Time, error) {
+	hint := strings.TrimSpace(subject)
+	if prefix := p.cfg.Email.SubjectPrefix; prefix != "" {
+		hint = strings.TrimSpace(hint[len(prefix):])
+	}
+
+	scheduledAt, err := timezone.ParseSchedule(hint, now)
+	if err == nil {
+		return scheduledAt, nil
+	}
+
+	if p.cfg.Email.DefaultSchedule == "" {
+		return time.Time{}, fmt.Errorf("subject holds no schedule and email.default_schedule is not set: %w", err)
+	}
+
+	scheduledAt, err = timezone.ParseSchedule(p.cfg.Email.DefaultSchedule, now)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid email.default_schedule: %w", err)
+	}
+
+	return scheduledAt, nil
+}
+
+// saveAttachment writes an attachment under the attachments directory, prefixed with the email UID.
+func (p *Poller) saveAttachment(uid uint32, attachment Attachment) (string, error) {
+	dir := p.cfg.Email.AttachmentsDir
+	if dir == "" {
+		dir = defaultAttachmentsDir
+	}
+
+	if err := os.MkdirAll(dir, attachmentsDirPerm); err != nil {
+		return "", fmt.Errorf("failed to create attachments directory: %w", err)
+	}
+
+	name := filepath.Base(attachment.Filename)
+	if name == "." || name == string(filepath.Separator) {
+		name = "attachment"
+	}
+
+	path := filepath.Join(dir, fmt.Sprintf("%d-%s", uid, name))
+	if err := os.WriteFile(path, attachment.Data, attachmentFilePerm); err != nil {
+		return "", fmt.Errorf("failed to save attachment %q: %w", name, err)
+	}
+
+	return path, nil
+}
+
+// processedFlag returns the IMAP keyword marking processed emails.
+func processedFlag(cfg *config.Config) string {
+	if cfg.Email.ProcessedFlag != "" {
+		return cfg.Email.ProcessedFlag
+	}
+
+	return defaultProcessedFlag
+}
+
+// hasPrefixFold reports whether s starts with prefix, ignoring case.
+func hasPrefixFold(s, prefix string) bool {
+	return len(s) >= len(prefix) && strings.EqualFold(s[:len(prefix)], prefix)
+}
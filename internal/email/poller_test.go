@@ -0,0 +1,265 @@
+package email
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"PostedIn/internal/config"
+	"PostedIn/internal/models"
+)
+
+// pngHeader is enough of a PNG file for content type detection.
+const pngHeader = "\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR"
+
+// fakeMailbox serves fixed emails in place of an IMAP server, hiding those marked processed.
+type fakeMailbox struct {
+	messages  []Message
+	processed map[uint32]bool
+	err       error
+}
+
+func (f *fakeMailbox) Unprocessed(context.Context) ([]Message, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+
+	var messages []Message
+
+	for _, msg := range f.messages {
+		if !f.processed[msg.UID] {
+			messages = append(messages, msg)
+		}
+	}
+
+	return messages, nil
+}
+
+func (f *fakeMailbox) MarkProcessed(_ context.Context, uids []uint32) error {
+	if f.processed == nil {
+		f.processed = make(map[uint32]bool)
+	}
+
+	for _, uid := range uids {
+		f.processed[uid] = true
+	}
+
+	return nil
+}
+
+// fakeAdder records added posts, failing for content listed in fail.
+type fakeAdder struct {
+	added []models.Post
+	fail  map[string]bool
+}
+
+func (f *fakeAdder) Add(post models.Post, _ *config.Config) (models.Post, error) {
+	if f.fail[post.Content] {
+		return models.Post{}, errors.New("store unavailable")
+	}
+
+	post.ID = len(f.added) + 1
+	f.added = append(f.added, post)
+
+	return post, nil
+}
+
+func emailConfig(t *testing.T) *config.Config {
+	t.Helper()
+
+	return &config.Config{
+		Timezone: config.TimezoneConfig{Location: "UTC"},
+		Email: config.EmailConfig{
+			Enabled:        true,
+			AttachmentsDir: filepath.Join(t.TempDir(), "attachments"),
+		},
+	}
+}
+
+func future(days int) string {
+	return time.Now().UTC().AddDate(0, 0, days).Format("2006-01-02") + " 09:00"
+}
+
+func TestPollCreatesPostsOnce(t *testing.T) {
+	cfg := emailConfig(t)
+	mailbox := &fakeMailbox{messages: []Message{
+		{UID: 1, Subject: future(1), Body: "first post"},
+		{UID: 2, Subject: "2000-01-01 09:00", Body: "too late"},
+		{UID: 3, Subject: future(1)},
+		{UID: 4, Subject: future(2), Body: "second post"},
+	}}
+	adder := &fakeAdder{}
+
+	var notified []int
+
+	poller := NewPoller(mailbox, adder, cfg)
+	poller.OnAdded(func(post models.Post) { notified = append(notified, post.ID) })
+
+	created, err := poller.Poll(context.Background())
+	if err != nil {
+		t.Fatalf("Poll: %v", err)
+	}
+
+	if created != 2 || len(adder.added) != 2 {
+		t.Fatalf("created %d posts (%d added), want 2", created, len(adder.added))
+	}
+
+	if adder.added[0].Content != "first post" || adder.added[1].Content != "second post" {
+		t.Errorf("added %q and %q", adder.added[0].Content, adder.added[1].Content)
+	}
+
+	if want := future(1); adder.added[0].ScheduledAt.Format("2006-01-02 15:04") != want {
+		t.Errorf("scheduled at %v, want %s from the subject", adder.added[0].ScheduledAt, want)
+	}
+
+	if len(notified) != 2 {
+		t.Errorf("OnAdded called %d times, want 2", len(notified))
+	}
+
+	// Emails that can never become a post are marked processed too, so they are not retried
+	for _, uid := range []uint32{1, 2, 3, 4} {
+		if !mailbox.processed[uid] {
+			t.Errorf("email %d was not marked processed", uid)
+		}
+	}
+
+	if created, err := poller.Poll(context.Background()); err != nil || created != 0 {
+		t.Fatalf("second Poll = %d, %v, want processed emails skipped", created, err)
+	}
+
+	mailbox.messages = append(mailbox.messages, Message{UID: 5, Subject: future(3), Body: "new email"})
+
+	if created, err := poller.Poll(context.Background()); err != nil || created != 1 {
+		t.Fatalf("Poll after a new email = %d, %v, want 1", created, err)
+	}
+}
+
+func TestPollRetriesFailedAdd(t *testing.T) {
+	mailbox := &fakeMailbox{messages: []Message{
+		{UID: 1, Subject: future(1), Body: "ok"},
+		{UID: 2, Subject: future(1), Body: "flaky"},
+		{UID: 3, Subject: future(1), Body: "after"},
+	}}
+	adder := &fakeAdder{fail: map[string]bool{"flaky": true}}
+	poller := NewPoller(mailbox, adder, emailConfig(t))
+
+	created, err := poller.Poll(context.Background())
+	if err == nil {
+		t.Fatal("Poll succeeded despite a failing add")
+	}
+
+	if created != 1 || mailbox.processed[2] || mailbox.processed[3] {
+		t.Fatalf("created %d, processed %v, want only the first email done", created, mailbox.processed)
+	}
+
+	delete(adder.fail, "flaky")
+
+	if created, err := poller.Poll(context.Background()); err != nil || created != 2 {
+		t.Fatalf("retry Poll = %d, %v, want the failed email and the one after it", created, err)
+	}
+}
+
+func TestPollRules(t *testing.T) {
+	cfg := emailConfig(t)
+	cfg.Email.SubjectPrefix = "[post]"
+	cfg.Email.AllowedSenders = []string{"Me@Example.com"}
+	cfg.Email.DefaultSchedule = "tomorrow 9am"
+
+	mailbox := &fakeMailbox{messages: []Message{
+		{UID: 1, From: []string{"me@example.com"}, Subject: "[POST] " + future(2), Body: "with time"},
+		{UID: 2, From: []string{"me@example.com"}, Subject: "[post] no time here", Body: "default time"},
+		{UID: 3, From: []string{"me@example.com"}, Subject: "lunch?", Body: "not a post"},
+		{UID: 4, From: []string{"stranger@example.com"}, Subject: "[post] " + future(2), Body: "spoofed"},
+	}}
+	adder := &fakeAdder{}
+
+	if _, err := NewPoller(mailbox, adder, cfg).Poll(context.Background()); err != nil {
+		t.Fatalf("Poll: %v", err)
+	}
+
+	if len(adder.added) != 2 || adder.added[0].Content != "with time" || adder.added[1].Content != "default time" {
+		t.Fatalf("added %+v, want the two matching emails", adder.added)
+	}
+
+	if want := time.Now().UTC().AddDate(0, 0, 1).Format("2006-01-02") + " 09:00"; adder.added[1].ScheduledAt.Format("2006-01-02 15:04") != want {
+		t.Errorf("default schedule = %v, want %s", adder.added[1].ScheduledAt, want)
+	}
+
+	// Emails that do not match are left for the owner to read
+	if mailbox.processed[3] || mailbox.processed[4] {
+		t.Errorf("processed %v, want non-matching emails left untouched", mailbox.processed)
+	}
+}
+
+func TestPollSavesImageAttachment(t *testing.T) {
+	cfg := emailConfig(t)
+	mailbox := &fakeMailbox{messages: []Message{{
+		UID:     7,
+		Subject: future(1),
+		Body:    "with a chart",
+		Attachments: []Attachment{
+			{Filename: "notes.txt", ContentType: "text/plain", Data: []byte("ignored")},
+			{Filename: "../chart.png", ContentType: "image/png", Data: []byte(pngHeader)},
+		},
+	}}}
+	adder := &fakeAdder{}
+
+	if _, err := NewPoller(mailbox, adder, cfg).Poll(context.Background()); err != nil {
+		t.Fatalf("Poll: %v", err)
+	}
+
+	if len(adder.added) != 1 {
+		t.Fatalf("added %d posts, want 1", len(adder.added))
+	}
+
+	want, err := filepath.Abs(filepath.Join(cfg.Email.AttachmentsDir, "7-chart.png"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if adder.added[0].ImagePath != want {
+		t.Errorf("image path = %q, want %q inside the attachments directory", adder.added[0].ImagePath, want)
+	}
+}
+
+func TestPollMailboxError(t *testing.T) {
+	mailbox := &fakeMailbox{err: errors.New("connection refused")}
+
+	if _, err := NewPoller(mailbox, &fakeAdder{}, emailConfig(t)).Poll(context.Background()); err == nil {
+		t.Fatal("Poll ignored a mailbox error")
+	}
+}
+
+func TestNewPollerFromConfig(t *testing.T) {
+	cfg := emailConfig(t)
+	cfg.Email.Enabled = false
+
+	if poller, err := NewPollerFromConfig(cfg, &fakeAdder{}); err != nil || poller != nil {
+		t.Fatalf("disabled email = %v, %v, want no poller", poller, err)
+	}
+
+	cfg = emailConfig(t)
+	cfg.Email.Server, cfg.Email.Username, cfg.Email.Password = "imap.example.com", "me", "secret"
+
+	if _, err := NewPollerFromConfig(cfg, &fakeAdder{}); err == nil {
+		t.Fatal("NewPollerFromConfig accepted a server without a port")
+	}
+
+	cfg.Email.Server = "imap.example.com:993"
+	cfg.Email.PollIntervalMinutes = 5
+
+	poller, err := NewPollerFromConfig(cfg, &fakeAdder{})
+	if err != nil {
+		t.Fatalf("NewPollerFromConfig: %v", err)
+	}
+
+	if poller.Interval() != 5*time.Minute {
+		t.Errorf("Interval = %v, want 5m", poller.Interval())
+	}
+
+	if mailbox, ok := poller.mailbox.(*IMAPMailbox); !ok || mailbox.Folder != defaultFolder || mailbox.Flag != defaultProcessedFlag {
+		t.Errorf("mailbox = %+v, want the default folder and flag", poller.mailbox)
+	}
+}
@@ -0,0 +1,123 @@
+package grpcapi
+
+import (
+	"time"
+
+	"PostedIn/internal/api"
+	"PostedIn/internal/grpcapi/schedulerpb"
+	"PostedIn/internal/models"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// postToProto converts a post to its protobuf message.
+func postToProto(post models.Post) *schedulerpb.Post {
+	msg := &schedulerpb.Post{
+		Id:                 int64(post.ID),
+		Content:            post.Content,
+		ScheduledAt:        timestamp(post.ScheduledAt),
+		Status:             post.Status,
+		CreatedAt:          timestamp(post.CreatedAt),
+		CronEntryId:        int64(post.CronEntryID),
+		PostType:           post.PostType,
+		Language:           post.Language,
+		Variants:           post.Variants,
+		TargetLanguage:     post.TargetLanguage,
+		DependsOn:          int64(post.DependsOn),
+		OffsetMinutes:      int32(post.OffsetMinutes),
+		ApiVersion:         post.APIVersion,
+		ImagePath:          post.ImagePath,
+		ImageAltText:       post.ImageAltText,
+		DeferredCount:      int32(post.DeferredCount),
+		VideoPath:          post.VideoPath,
+		VideoTitle:         post.VideoTitle,
+		LastError:          post.LastError,
+		RequestId:          post.RequestID,
+		Audience:           post.Audience,
+		Event:              post.Event,
+		EventOffsetMinutes: int32(post.EventOffsetMinutes),
+		Tags:               post.Tags,
+		PostUrn:            post.PostURN,
+		ResultsOf:          int64(post.ResultsOf),
+		Attempts:           int32(post.Attempts),
+		Recurrence:         post.Recurrence,
+		Account:            post.Account,
+		AuthorType:         post.AuthorType,
+		OrganizationUrn:    post.OrganizationURN,
+		Visibility:         post.Visibility,
+	}
+
+	if post.Poll != nil {
+		msg.Poll = &schedulerpb.Poll{
+			Question:        post.Poll.Question,
+			Options:         post.Poll.Options,
+			Duration:        post.Poll.Duration,
+			ResultsTemplate: post.Poll.ResultsTemplate,
+		}
+	}
+
+	if post.PublishedAt != nil {
+		msg.PublishedAt = timestamppb.New(*post.PublishedAt)
+	}
+
+	for _, change := range post.History {
+		msg.History = append(msg.History, &schedulerpb.StatusChange{
+			Status: change.Status,
+			At:     timestamp(change.At),
+			Note:   change.Note,
+		})
+	}
+
+	return msg
+}
+
+// postRequestFromProto converts a create request to the REST API's request, so both share validation.
+func postRequestFromProto(req *schedulerpb.CreatePostRequest) api.PostRequest {
+	postReq := api.PostRequest{
+		Content:            req.GetContent(),
+		ContentFile:        req.GetContentFile(),
+		ScheduledAt:        req.GetScheduledAt(),
+		Language:           req.GetLanguage(),
+		Variants:           req.GetVariants(),
+		TargetLanguage:     req.GetTargetLanguage(),
+		DependsOn:          int(req.GetDependsOn()),
+		OffsetMinutes:      int(req.GetOffsetMinutes()),
+		APIVersion:         req.GetApiVersion(),
+		ImagePath:          req.GetImagePath(),
+		ImageAltText:       req.GetImageAltText(),
+		VideoPath:          req.GetVideoPath(),
+		VideoTitle:         req.GetVideoTitle(),
+		Audience:           req.GetAudience(),
+		Event:              req.GetEvent(),
+		EventOffsetMinutes: int(req.GetEventOffsetMinutes()),
+		Tags:               req.GetTags(),
+		Recurrence:         req.GetRecurrence(),
+		Draft:              req.GetDraft(),
+		Account:            req.GetAccount(),
+		AuthorType:         req.GetAuthorType(),
+		OrganizationURN:    req.GetOrganizationUrn(),
+		Visibility:         req.GetVisibility(),
+		Recipe:             req.GetRecipe(),
+		Vars:               req.GetVars(),
+	}
+
+	if poll := req.GetPoll(); poll != nil {
+		postReq.Poll = &api.PollRequest{
+			Question:        poll.GetQuestion(),
+			Options:         poll.GetOptions(),
+			Duration:        poll.GetDuration(),
+			ResultsTemplate: poll.GetResultsTemplate(),
+		}
+	}
+
+	return postReq
+}
+
+// timestamp converts a time, leaving zero times unset.
+func timestamp(t time.Time) *timestamppb.Timestamp {
+	if t.IsZero() {
+		return nil
+	}
+
+	return timestamppb.New(t)
+}
@@ -0,0 +1,4 @@
+// Package schedulerpb holds the protobuf messages and gRPC service generated from scheduler.proto.
+package schedulerpb
+
+//go:generate protoc --go_out=. --go_opt=paths=source_relative --go-grpc_out=. --go-grpc_opt=paths=source_relative scheduler.proto
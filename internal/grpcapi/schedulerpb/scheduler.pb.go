@@ -0,0 +1,1332 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v5.29.3
+// source: scheduler.proto
+
+package schedulerpb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Post mirrors models.Post.
+type Post struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Id                 int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Content            string                 `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	ScheduledAt        *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=scheduled_at,json=scheduledAt,proto3" json:"scheduled_at,omitempty"`
+	Status             string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	CreatedAt          *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	CronEntryId        int64                  `protobuf:"varint,6,opt,name=cron_entry_id,json=cronEntryId,proto3" json:"cron_entry_id,omitempty"`
+	PostType           string                 `protobuf:"bytes,7,opt,name=post_type,json=postType,proto3" json:"post_type,omitempty"`
+	Poll               *Poll                  `protobuf:"bytes,8,opt,name=poll,proto3" json:"poll,omitempty"`
+	Language           string                 `protobuf:"bytes,9,opt,name=language,proto3" json:"language,omitempty"`
+	Variants           map[string]string      `protobuf:"bytes,10,rep,name=variants,proto3" json:"variants,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	TargetLanguage     string                 `protobuf:"bytes,11,opt,name=target_language,json=targetLanguage,proto3" json:"target_language,omitempty"`
+	DependsOn          int64                  `protobuf:"varint,12,opt,name=depends_on,json=dependsOn,proto3" json:"depends_on,omitempty"`
+	OffsetMinutes      int32                  `protobuf:"varint,13,opt,name=offset_minutes,json=offsetMinutes,proto3" json:"offset_minutes,omitempty"`
+	PublishedAt        *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=published_at,json=publishedAt,proto3" json:"published_at,omitempty"`
+	ApiVersion         string                 `protobuf:"bytes,15,opt,name=api_version,json=apiVersion,proto3" json:"api_version,omitempty"`
+	ImagePath          string                 `protobuf:"bytes,16,opt,name=image_path,json=imagePath,proto3" json:"image_path,omitempty"`
+	ImageAltText       string                 `protobuf:"bytes,17,opt,name=image_alt_text,json=imageAltText,proto3" json:"image_alt_text,omitempty"`
+	DeferredCount      int32                  `protobuf:"varint,18,opt,name=deferred_count,json=deferredCount,proto3" json:"deferred_count,omitempty"`
+	VideoPath          string                 `protobuf:"bytes,19,opt,name=video_path,json=videoPath,proto3" json:"video_path,omitempty"`
+	VideoTitle         string                 `protobuf:"bytes,20,opt,name=video_title,json=videoTitle,proto3" json:"video_title,omitempty"`
+	LastError          string                 `protobuf:"bytes,21,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	RequestId          string                 `protobuf:"bytes,22,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	Audience           string                 `protobuf:"bytes,23,opt,name=audience,proto3" json:"audience,omitempty"`
+	Event              string                 `protobuf:"bytes,24,opt,name=event,proto3" json:"event,omitempty"`
+	EventOffsetMinutes int32                  `protobuf:"varint,25,opt,name=event_offset_minutes,json=eventOffsetMinutes,proto3" json:"event_offset_minutes,omitempty"`
+	Tags               []string               `protobuf:"bytes,26,rep,name=tags,proto3" json:"tags,omitempty"`
+	History            []*StatusChange        `protobuf:"bytes,27,rep,name=history,proto3" json:"history,omitempty"`
+	PostUrn            string                 `protobuf:"bytes,28,opt,name=post_urn,json=postUrn,proto3" json:"post_urn,omitempty"`
+	ResultsOf          int64                  `protobuf:"varint,29,opt,name=results_of,json=resultsOf,proto3" json:"results_of,omitempty"`
+	Attempts           int32                  `protobuf:"varint,30,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	Recurrence         string                 `protobuf:"bytes,31,opt,name=recurrence,proto3" json:"recurrence,omitempty"`
+	Account            string                 `protobuf:"bytes,32,opt,name=account,proto3" json:"account,omitempty"`
+	AuthorType         string                 `protobuf:"bytes,33,opt,name=author_type,json=authorType,proto3" json:"author_type,omitempty"`
+	OrganizationUrn    string                 `protobuf:"bytes,34,opt,name=organization_urn,json=organizationUrn,proto3" json:"organization_urn,omitempty"`
+	Visibility         string                 `protobuf:"bytes,35,opt,name=visibility,proto3" json:"visibility,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *Post) Reset() {
+	*x = Post{}
+	mi := &file_scheduler_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Post) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Post) ProtoMessage() {}
+
+func (x *Post) ProtoReflect() protoreflect.Message {
+	mi := &file_scheduler_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Post.ProtoReflect.Descriptor instead.
+func (*Post) Descriptor() ([]byte, []int) {
+	return file_scheduler_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Post) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Post) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *Post) GetScheduledAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ScheduledAt
+	}
+	return nil
+}
+
+func (x *Post) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Post) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Post) GetCronEntryId() int64 {
+	if x != nil {
+		return x.CronEntryId
+	}
+	return 0
+}
+
+func (x *Post) GetPostType() string {
+	if x != nil {
+		return x.PostType
+	}
+	return ""
+}
+
+func (x *Post) GetPoll() *Poll {
+	if x != nil {
+		return x.Poll
+	}
+	return nil
+}
+
+func (x *Post) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
+func (x *Post) GetVariants() map[string]string {
+	if x != nil {
+		return x.Variants
+	}
+	return nil
+}
+
+func (x *Post) GetTargetLanguage() string {
+	if x != nil {
+		return x.TargetLanguage
+	}
+	return ""
+}
+
+func (x *Post) GetDependsOn() int64 {
+	if x != nil {
+		return x.DependsOn
+	}
+	return 0
+}
+
+func (x *Post) GetOffsetMinutes() int32 {
+	if x != nil {
+		return x.OffsetMinutes
+	}
+	return 0
+}
+
+func (x *Post) GetPublishedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PublishedAt
+	}
+	return nil
+}
+
+func (x *Post) GetApiVersion() string {
+	if x != nil {
+		return x.ApiVersion
+	}
+	return ""
+}
+
+func (x *Post) GetImagePath() string {
+	if x != nil {
+		return x.ImagePath
+	}
+	return ""
+}
+
+func (x *Post) GetImageAltText() string {
+	if x != nil {
+		return x.ImageAltText
+	}
+	return ""
+}
+
+func (x *Post) GetDeferredCount() int32 {
+	if x != nil {
+		return x.DeferredCount
+	}
+	return 0
+}
+
+func (x *Post) GetVideoPath() string {
+	if x != nil {
+		return x.VideoPath
+	}
+	return ""
+}
+
+func (x *Post) GetVideoTitle() string {
+	if x != nil {
+		return x.VideoTitle
+	}
+	return ""
+}
+
+func (x *Post) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+func (x *Post) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *Post) GetAudience() string {
+	if x != nil {
+		return x.Audience
+	}
+	return ""
+}
+
+func (x *Post) GetEvent() string {
+	if x != nil {
+		return x.Event
+	}
+	return ""
+}
+
+func (x *Post) GetEventOffsetMinutes() int32 {
+	if x != nil {
+		return x.EventOffsetMinutes
+	}
+	return 0
+}
+
+func (x *Post) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *Post) GetHistory() []*StatusChange {
+	if x != nil {
+		return x.History
+	}
+	return nil
+}
+
+func (x *Post) GetPostUrn() string {
+	if x != nil {
+		return x.PostUrn
+	}
+	return ""
+}
+
+func (x *Post) GetResultsOf() int64 {
+	if x != nil {
+		return x.ResultsOf
+	}
+	return 0
+}
+
+func (x *Post) GetAttempts() int32 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *Post) GetRecurrence() string {
+	if x != nil {
+		return x.Recurrence
+	}
+	return ""
+}
+
+func (x *Post) GetAccount() string {
+	if x != nil {
+		return x.Account
+	}
+	return ""
+}
+
+func (x *Post) GetAuthorType() string {
+	if x != nil {
+		return x.AuthorType
+	}
+	return ""
+}
+
+func (x *Post) GetOrganizationUrn() string {
+	if x != nil {
+		return x.OrganizationUrn
+	}
+	return ""
+}
+
+func (x *Post) GetVisibility() string {
+	if x != nil {
+		return x.Visibility
+	}
+	return ""
+}
+
+// Poll mirrors models.Poll.
+type Poll struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Question        string                 `protobuf:"bytes,1,opt,name=question,proto3" json:"question,omitempty"`
+	Options         []string               `protobuf:"bytes,2,rep,name=options,proto3" json:"options,omitempty"`
+	Duration        string                 `protobuf:"bytes,3,opt,name=duration,proto3" json:"duration,omitempty"`
+	ResultsTemplate string                 `protobuf:"bytes,4,opt,name=results_template,json=resultsTemplate,proto3" json:"results_template,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *Poll) Reset() {
+	*x = Poll{}
+	mi := &file_scheduler_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Poll) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Poll) ProtoMessage() {}
+
+func (x *Poll) ProtoReflect() protoreflect.Message {
+	mi := &file_scheduler_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Poll.ProtoReflect.Descriptor instead.
+func (*Poll) Descriptor() ([]byte, []int) {
+	return file_scheduler_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Poll) GetQuestion() string {
+	if x != nil {
+		return x.Question
+	}
+	return ""
+}
+
+func (x *Poll) GetOptions() []string {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+func (x *Poll) GetDuration() string {
+	if x != nil {
+		return x.Duration
+	}
+	return ""
+}
+
+func (x *Poll) GetResultsTemplate() string {
+	if x != nil {
+		return x.ResultsTemplate
+	}
+	return ""
+}
+
+// StatusChange mirrors models.StatusChange.
+type StatusChange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	At            *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=at,proto3" json:"at,omitempty"`
+	Note          string                 `protobuf:"bytes,3,opt,name=note,proto3" json:"note,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StatusChange) Reset() {
+	*x = StatusChange{}
+	mi := &file_scheduler_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StatusChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatusChange) ProtoMessage() {}
+
+func (x *StatusChange) ProtoReflect() protoreflect.Message {
+	mi := &file_scheduler_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatusChange.ProtoReflect.Descriptor instead.
+func (*StatusChange) Descriptor() ([]byte, []int) {
+	return file_scheduler_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *StatusChange) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *StatusChange) GetAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.At
+	}
+	return nil
+}
+
+func (x *StatusChange) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+// CreatePostRequest mirrors the REST create request; scheduled_at accepts the same
+// 'YYYY-MM-DD HH:MM' times and phrases such as "tomorrow 9am".
+type CreatePostRequest struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Content            string                 `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
+	ContentFile        string                 `protobuf:"bytes,2,opt,name=content_file,json=contentFile,proto3" json:"content_file,omitempty"`
+	ScheduledAt        string                 `protobuf:"bytes,3,opt,name=scheduled_at,json=scheduledAt,proto3" json:"scheduled_at,omitempty"`
+	Poll               *Poll                  `protobuf:"bytes,4,opt,name=poll,proto3" json:"poll,omitempty"`
+	Language           string                 `protobuf:"bytes,5,opt,name=language,proto3" json:"language,omitempty"`
+	Variants           map[string]string      `protobuf:"bytes,6,rep,name=variants,proto3" json:"variants,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	TargetLanguage     string                 `protobuf:"bytes,7,opt,name=target_language,json=targetLanguage,proto3" json:"target_language,omitempty"`
+	DependsOn          int64                  `protobuf:"varint,8,opt,name=depends_on,json=dependsOn,proto3" json:"depends_on,omitempty"`
+	OffsetMinutes      int32                  `protobuf:"varint,9,opt,name=offset_minutes,json=offsetMinutes,proto3" json:"offset_minutes,omitempty"`
+	ApiVersion         string                 `protobuf:"bytes,10,opt,name=api_version,json=apiVersion,proto3" json:"api_version,omitempty"`
+	ImagePath          string                 `protobuf:"bytes,11,opt,name=image_path,json=imagePath,proto3" json:"image_path,omitempty"`
+	ImageAltText       string                 `protobuf:"bytes,12,opt,name=image_alt_text,json=imageAltText,proto3" json:"image_alt_text,omitempty"`
+	VideoPath          string                 `protobuf:"bytes,13,opt,name=video_path,json=videoPath,proto3" json:"video_path,omitempty"`
+	VideoTitle         string                 `protobuf:"bytes,14,opt,name=video_title,json=videoTitle,proto3" json:"video_title,omitempty"`
+	Audience           string                 `protobuf:"bytes,15,opt,name=audience,proto3" json:"audience,omitempty"`
+	Event              string                 `protobuf:"bytes,16,opt,name=event,proto3" json:"event,omitempty"`
+	EventOffsetMinutes int32                  `protobuf:"varint,17,opt,name=event_offset_minutes,json=eventOffsetMinutes,proto3" json:"event_offset_minutes,omitempty"`
+	Tags               []string               `protobuf:"bytes,18,rep,name=tags,proto3" json:"tags,omitempty"`
+	Recurrence         string                 `protobuf:"bytes,19,opt,name=recurrence,proto3" json:"recurrence,omitempty"`
+	Draft              bool                   `protobuf:"varint,20,opt,name=draft,proto3" json:"draft,omitempty"`
+	Account            string                 `protobuf:"bytes,21,opt,name=account,proto3" json:"account,omitempty"`
+	AuthorType         string                 `protobuf:"bytes,22,opt,name=author_type,json=authorType,proto3" json:"author_type,omitempty"`
+	OrganizationUrn    string                 `protobuf:"bytes,23,opt,name=organization_urn,json=organizationUrn,proto3" json:"organization_urn,omitempty"`
+	Recipe             string                 `protobuf:"bytes,24,opt,name=recipe,proto3" json:"recipe,omitempty"`
+	Vars               map[string]string      `protobuf:"bytes,25,rep,name=vars,proto3" json:"vars,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Visibility         string                 `protobuf:"bytes,26,opt,name=visibility,proto3" json:"visibility,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *CreatePostRequest) Reset() {
+	*x = CreatePostRequest{}
+	mi := &file_scheduler_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePostRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePostRequest) ProtoMessage() {}
+
+func (x *CreatePostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_scheduler_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePostRequest.ProtoReflect.Descriptor instead.
+func (*CreatePostRequest) Descriptor() ([]byte, []int) {
+	return file_scheduler_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CreatePostRequest) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *CreatePostRequest) GetContentFile() string {
+	if x != nil {
+		return x.ContentFile
+	}
+	return ""
+}
+
+func (x *CreatePostRequest) GetScheduledAt() string {
+	if x != nil {
+		return x.ScheduledAt
+	}
+	return ""
+}
+
+func (x *CreatePostRequest) GetPoll() *Poll {
+	if x != nil {
+		return x.Poll
+	}
+	return nil
+}
+
+func (x *CreatePostRequest) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
+func (x *CreatePostRequest) GetVariants() map[string]string {
+	if x != nil {
+		return x.Variants
+	}
+	return nil
+}
+
+func (x *CreatePostRequest) GetTargetLanguage() string {
+	if x != nil {
+		return x.TargetLanguage
+	}
+	return ""
+}
+
+func (x *CreatePostRequest) GetDependsOn() int64 {
+	if x != nil {
+		return x.DependsOn
+	}
+	return 0
+}
+
+func (x *CreatePostRequest) GetOffsetMinutes() int32 {
+	if x != nil {
+		return x.OffsetMinutes
+	}
+	return 0
+}
+
+func (x *CreatePostRequest) GetApiVersion() string {
+	if x != nil {
+		return x.ApiVersion
+	}
+	return ""
+}
+
+func (x *CreatePostRequest) GetImagePath() string {
+	if x != nil {
+		return x.ImagePath
+	}
+	return ""
+}
+
+func (x *CreatePostRequest) GetImageAltText() string {
+	if x != nil {
+		return x.ImageAltText
+	}
+	return ""
+}
+
+func (x *CreatePostRequest) GetVideoPath() string {
+	if x != nil {
+		return x.VideoPath
+	}
+	return ""
+}
+
+func (x *CreatePostRequest) GetVideoTitle() string {
+	if x != nil {
+		return x.VideoTitle
+	}
+	return ""
+}
+
+func (x *CreatePostRequest) GetAudience() string {
+	if x != nil {
+		return x.Audience
+	}
+	return ""
+}
+
+func (x *CreatePostRequest) GetEvent() string {
+	if x != nil {
+		return x.Event
+	}
+	return ""
+}
+
+func (x *CreatePostRequest) GetEventOffsetMinutes() int32 {
+	if x != nil {
+		return x.EventOffsetMinutes
+	}
+	return 0
+}
+
+func (x *CreatePostRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *CreatePostRequest) GetRecurrence() string {
+	if x != nil {
+		return x.Recurrence
+	}
+	return ""
+}
+
+func (x *CreatePostRequest) GetDraft() bool {
+	if x != nil {
+		return x.Draft
+	}
+	return false
+}
+
+func (x *CreatePostRequest) GetAccount() string {
+	if x != nil {
+		return x.Account
+	}
+	return ""
+}
+
+func (x *CreatePostRequest) GetAuthorType() string {
+	if x != nil {
+		return x.AuthorType
+	}
+	return ""
+}
+
+func (x *CreatePostRequest) GetOrganizationUrn() string {
+	if x != nil {
+		return x.OrganizationUrn
+	}
+	return ""
+}
+
+func (x *CreatePostRequest) GetRecipe() string {
+	if x != nil {
+		return x.Recipe
+	}
+	return ""
+}
+
+func (x *CreatePostRequest) GetVars() map[string]string {
+	if x != nil {
+		return x.Vars
+	}
+	return nil
+}
+
+func (x *CreatePostRequest) GetVisibility() string {
+	if x != nil {
+		return x.Visibility
+	}
+	return ""
+}
+
+type ListPostsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"` // Only return posts with this status; empty returns all
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPostsRequest) Reset() {
+	*x = ListPostsRequest{}
+	mi := &file_scheduler_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPostsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPostsRequest) ProtoMessage() {}
+
+func (x *ListPostsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_scheduler_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPostsRequest.ProtoReflect.Descriptor instead.
+func (*ListPostsRequest) Descriptor() ([]byte, []int) {
+	return file_scheduler_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListPostsRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type ListPostsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Posts         []*Post                `protobuf:"bytes,1,rep,name=posts,proto3" json:"posts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPostsResponse) Reset() {
+	*x = ListPostsResponse{}
+	mi := &file_scheduler_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPostsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPostsResponse) ProtoMessage() {}
+
+func (x *ListPostsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_scheduler_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPostsResponse.ProtoReflect.Descriptor instead.
+func (*ListPostsResponse) Descriptor() ([]byte, []int) {
+	return file_scheduler_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ListPostsResponse) GetPosts() []*Post {
+	if x != nil {
+		return x.Posts
+	}
+	return nil
+}
+
+type GetPostRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPostRequest) Reset() {
+	*x = GetPostRequest{}
+	mi := &file_scheduler_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPostRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPostRequest) ProtoMessage() {}
+
+func (x *GetPostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_scheduler_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPostRequest.ProtoReflect.Descriptor instead.
+func (*GetPostRequest) Descriptor() ([]byte, []int) {
+	return file_scheduler_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetPostRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type DeletePostRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeletePostRequest) Reset() {
+	*x = DeletePostRequest{}
+	mi := &file_scheduler_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeletePostRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePostRequest) ProtoMessage() {}
+
+func (x *DeletePostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_scheduler_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePostRequest.ProtoReflect.Descriptor instead.
+func (*DeletePostRequest) Descriptor() ([]byte, []int) {
+	return file_scheduler_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *DeletePostRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type DeletePostResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DeletedId     int64                  `protobuf:"varint,1,opt,name=deleted_id,json=deletedId,proto3" json:"deleted_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeletePostResponse) Reset() {
+	*x = DeletePostResponse{}
+	mi := &file_scheduler_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeletePostResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePostResponse) ProtoMessage() {}
+
+func (x *DeletePostResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_scheduler_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePostResponse.ProtoReflect.Descriptor instead.
+func (*DeletePostResponse) Descriptor() ([]byte, []int) {
+	return file_scheduler_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *DeletePostResponse) GetDeletedId() int64 {
+	if x != nil {
+		return x.DeletedId
+	}
+	return 0
+}
+
+type PublishPostRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PublishPostRequest) Reset() {
+	*x = PublishPostRequest{}
+	mi := &file_scheduler_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PublishPostRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PublishPostRequest) ProtoMessage() {}
+
+func (x *PublishPostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_scheduler_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PublishPostRequest.ProtoReflect.Descriptor instead.
+func (*PublishPostRequest) Descriptor() ([]byte, []int) {
+	return file_scheduler_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *PublishPostRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type GetStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetStatusRequest) Reset() {
+	*x = GetStatusRequest{}
+	mi := &file_scheduler_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStatusRequest) ProtoMessage() {}
+
+func (x *GetStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_scheduler_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStatusRequest.ProtoReflect.Descriptor instead.
+func (*GetStatusRequest) Descriptor() ([]byte, []int) {
+	return file_scheduler_proto_rawDescGZIP(), []int{10}
+}
+
+type StatusResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Running       bool                   `protobuf:"varint,1,opt,name=running,proto3" json:"running,omitempty"`
+	Enabled       bool                   `protobuf:"varint,2,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	Paused        bool                   `protobuf:"varint,3,opt,name=paused,proto3" json:"paused,omitempty"`
+	NextRun       *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=next_run,json=nextRun,proto3" json:"next_run,omitempty"` // Unset when no timer is armed
+	NextPostId    int64                  `protobuf:"varint,5,opt,name=next_post_id,json=nextPostId,proto3" json:"next_post_id,omitempty"`
+	ArmedTimers   int32                  `protobuf:"varint,6,opt,name=armed_timers,json=armedTimers,proto3" json:"armed_timers,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StatusResponse) Reset() {
+	*x = StatusResponse{}
+	mi := &file_scheduler_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatusResponse) ProtoMessage() {}
+
+func (x *StatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_scheduler_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatusResponse.ProtoReflect.Descriptor instead.
+func (*StatusResponse) Descriptor() ([]byte, []int) {
+	return file_scheduler_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *StatusResponse) GetRunning() bool {
+	if x != nil {
+		return x.Running
+	}
+	return false
+}
+
+func (x *StatusResponse) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *StatusResponse) GetPaused() bool {
+	if x != nil {
+		return x.Paused
+	}
+	return false
+}
+
+func (x *StatusResponse) GetNextRun() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NextRun
+	}
+	return nil
+}
+
+func (x *StatusResponse) GetNextPostId() int64 {
+	if x != nil {
+		return x.NextPostId
+	}
+	return 0
+}
+
+func (x *StatusResponse) GetArmedTimers() int32 {
+	if x != nil {
+		return x.ArmedTimers
+	}
+	return 0
+}
+
+var File_scheduler_proto protoreflect.FileDescriptor
+
+const file_scheduler_proto_rawDesc = "" +
+	"\n" +
+	"\x0fscheduler.proto\x12\vpostedin.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xa2\n" +
+	"\n" +
+	"\x04Post\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x18\n" +
+	"\acontent\x18\x02 \x01(\tR\acontent\x12=\n" +
+	"\fscheduled_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\vscheduledAt\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\tR\x06status\x129\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12\"\n" +
+	"\rcron_entry_id\x18\x06 \x01(\x03R\vcronEntryId\x12\x1b\n" +
+	"\tpost_type\x18\a \x01(\tR\bpostType\x12%\n" +
+	"\x04poll\x18\b \x01(\v2\x11.postedin.v1.PollR\x04poll\x12\x1a\n" +
+	"\blanguage\x18\t \x01(\tR\blanguage\x12;\n" +
+	"\bvariants\x18\n" +
+	" \x03(\v2\x1f.postedin.v1.Post.VariantsEntryR\bvariants\x12'\n" +
+	"\x0ftarget_language\x18\v \x01(\tR\x0etargetLanguage\x12\x1d\n" +
+	"\n" +
+	"depends_on\x18\f \x01(\x03R\tdependsOn\x12%\n" +
+	"\x0eoffset_minutes\x18\r \x01(\x05R\roffsetMinutes\x12=\n" +
+	"\fpublished_at\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampR\vpublishedAt\x12\x1f\n" +
+	"\vapi_version\x18\x0f \x01(\tR\n" +
+	"apiVersion\x12\x1d\n" +
+	"\n" +
+	"image_path\x18\x10 \x01(\tR\timagePath\x12$\n" +
+	"\x0eimage_alt_text\x18\x11 \x01(\tR\fimageAltText\x12%\n" +
+	"\x0edeferred_count\x18\x12 \x01(\x05R\rdeferredCount\x12\x1d\n" +
+	"\n" +
+	"video_path\x18\x13 \x01(\tR\tvideoPath\x12\x1f\n" +
+	"\vvideo_title\x18\x14 \x01(\tR\n" +
+	"videoTitle\x12\x1d\n" +
+	"\n" +
+	"last_error\x18\x15 \x01(\tR\tlastError\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x16 \x01(\tR\trequestId\x12\x1a\n" +
+	"\baudience\x18\x17 \x01(\tR\baudience\x12\x14\n" +
+	"\x05event\x18\x18 \x01(\tR\x05event\x120\n" +
+	"\x14event_offset_minutes\x18\x19 \x01(\x05R\x12eventOffsetMinutes\x12\x12\n" +
+	"\x04tags\x18\x1a \x03(\tR\x04tags\x123\n" +
+	"\ahistory\x18\x1b \x03(\v2\x19.postedin.v1.StatusChangeR\ahistory\x12\x19\n" +
+	"\bpost_urn\x18\x1c \x01(\tR\apostUrn\x12\x1d\n" +
+	"\n" +
+	"results_of\x18\x1d \x01(\x03R\tresultsOf\x12\x1a\n" +
+	"\battempts\x18\x1e \x01(\x05R\battempts\x12\x1e\n" +
+	"\n" +
+	"recurrence\x18\x1f \x01(\tR\n" +
+	"recurrence\x12\x18\n" +
+	"\aaccount\x18  \x01(\tR\aaccount\x12\x1f\n" +
+	"\vauthor_type\x18! \x01(\tR\n" +
+	"authorType\x12)\n" +
+	"\x10organization_urn\x18\" \x01(\tR\x0forganizationUrn\x12\x1e\n" +
+	"\n" +
+	"visibility\x18# \x01(\tR\n" +
+	"visibility\x1a;\n" +
+	"\rVariantsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x83\x01\n" +
+	"\x04Poll\x12\x1a\n" +
+	"\bquestion\x18\x01 \x01(\tR\bquestion\x12\x18\n" +
+	"\aoptions\x18\x02 \x03(\tR\aoptions\x12\x1a\n" +
+	"\bduration\x18\x03 \x01(\tR\bduration\x12)\n" +
+	"\x10results_template\x18\x04 \x01(\tR\x0fresultsTemplate\"f\n" +
+	"\fStatusChange\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\x12*\n" +
+	"\x02at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x02at\x12\x12\n" +
+	"\x04note\x18\x03 \x01(\tR\x04note\"\x95\b\n" +
+	"\x11CreatePostRequest\x12\x18\n" +
+	"\acontent\x18\x01 \x01(\tR\acontent\x12!\n" +
+	"\fcontent_file\x18\x02 \x01(\tR\vcontentFile\x12!\n" +
+	"\fscheduled_at\x18\x03 \x01(\tR\vscheduledAt\x12%\n" +
+	"\x04poll\x18\x04 \x01(\v2\x11.postedin.v1.PollR\x04poll\x12\x1a\n" +
+	"\blanguage\x18\x05 \x01(\tR\blanguage\x12H\n" +
+	"\bvariants\x18\x06 \x03(\v2,.postedin.v1.CreatePostRequest.VariantsEntryR\bvariants\x12'\n" +
+	"\x0ftarget_language\x18\a \x01(\tR\x0etargetLanguage\x12\x1d\n" +
+	"\n" +
+	"depends_on\x18\b \x01(\x03R\tdependsOn\x12%\n" +
+	"\x0eoffset_minutes\x18\t \x01(\x05R\roffsetMinutes\x12\x1f\n" +
+	"\vapi_version\x18\n" +
+	" \x01(\tR\n" +
+	"apiVersion\x12\x1d\n" +
+	"\n" +
+	"image_path\x18\v \x01(\tR\timagePath\x12$\n" +
+	"\x0eimage_alt_text\x18\f \x01(\tR\fimageAltText\x12\x1d\n" +
+	"\n" +
+	"video_path\x18\r \x01(\tR\tvideoPath\x12\x1f\n" +
+	"\vvideo_title\x18\x0e \x01(\tR\n" +
+	"videoTitle\x12\x1a\n" +
+	"\baudience\x18\x0f \x01(\tR\baudience\x12\x14\n" +
+	"\x05event\x18\x10 \x01(\tR\x05event\x120\n" +
+	"\x14event_offset_minutes\x18\x11 \x01(\x05R\x12eventOffsetMinutes\x12\x12\n" +
+	"\x04tags\x18\x12 \x03(\tR\x04tags\x12\x1e\n" +
+	"\n" +
+	"recurrence\x18\x13 \x01(\tR\n" +
+	"recurrence\x12\x14\n" +
+	"\x05draft\x18\x14 \x01(\bR\x05draft\x12\x18\n" +
+	"\aaccount\x18\x15 \x01(\tR\aaccount\x12\x1f\n" +
+	"\vauthor_type\x18\x16 \x01(\tR\n" +
+	"authorType\x12)\n" +
+	"\x10organization_urn\x18\x17 \x01(\tR\x0forganizationUrn\x12\x16\n" +
+	"\x06recipe\x18\x18 \x01(\tR\x06recipe\x12<\n" +
+	"\x04vars\x18\x19 \x03(\v2(.postedin.v1.CreatePostRequest.VarsEntryR\x04vars\x12\x1e\n" +
+	"\n" +
+	"visibility\x18\x1a \x01(\tR\n" +
+	"visibility\x1a;\n" +
+	"\rVariantsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a7\n" +
+	"\tVarsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"*\n" +
+	"\x10ListPostsRequest\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\"<\n" +
+	"\x11ListPostsResponse\x12'\n" +
+	"\x05posts\x18\x01 \x03(\v2\x11.postedin.v1.PostR\x05posts\" \n" +
+	"\x0eGetPostRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"#\n" +
+	"\x11DeletePostRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"3\n" +
+	"\x12DeletePostResponse\x12\x1d\n" +
+	"\n" +
+	"deleted_id\x18\x01 \x01(\x03R\tdeletedId\"$\n" +
+	"\x12PublishPostRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"\x12\n" +
+	"\x10GetStatusRequest\"\xd8\x01\n" +
+	"\x0eStatusResponse\x12\x18\n" +
+	"\arunning\x18\x01 \x01(\bR\arunning\x12\x18\n" +
+	"\aenabled\x18\x02 \x01(\bR\aenabled\x12\x16\n" +
+	"\x06paused\x18\x03 \x01(\bR\x06paused\x125\n" +
+	"\bnext_run\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\anextRun\x12 \n" +
+	"\fnext_post_id\x18\x05 \x01(\x03R\n" +
+	"nextPostId\x12!\n" +
+	"\farmed_timers\x18\x06 \x01(\x05R\varmedTimers2\xae\x03\n" +
+	"\tScheduler\x12?\n" +
+	"\n" +
+	"CreatePost\x12\x1e.postedin.v1.CreatePostRequest\x1a\x11.postedin.v1.Post\x12J\n" +
+	"\tListPosts\x12\x1d.postedin.v1.ListPostsRequest\x1a\x1e.postedin.v1.ListPostsResponse\x129\n" +
+	"\aGetPost\x12\x1b.postedin.v1.GetPostRequest\x1a\x11.postedin.v1.Post\x12M\n" +
+	"\n" +
+	"DeletePost\x12\x1e.postedin.v1.DeletePostRequest\x1a\x1f.postedin.v1.DeletePostResponse\x12A\n" +
+	"\vPublishPost\x12\x1f.postedin.v1.PublishPostRequest\x1a\x11.postedin.v1.Post\x12G\n" +
+	"\tGetStatus\x12\x1d.postedin.v1.GetStatusRequest\x1a\x1b.postedin.v1.StatusResponseB'Z%PostedIn/internal/grpcapi/schedulerpbb\x06proto3"
+
+var (
+	file_scheduler_proto_rawDescOnce sync.Once
+	file_scheduler_proto_rawDescData []byte
+)
+
+func file_scheduler_proto_rawDescGZIP() []byte {
+	file_scheduler_proto_rawDescOnce.Do(func() {
+		file_scheduler_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_scheduler_proto_rawDesc), len(file_scheduler_proto_rawDesc)))
+	})
+	return file_scheduler_proto_rawDescData
+}
+
+var file_scheduler_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
+var file_scheduler_proto_goTypes = []any{
+	(*Post)(nil),                  // 0: postedin.v1.Post
+	(*Poll)(nil),                  // 1: postedin.v1.Poll
+	(*StatusChange)(nil),          // 2: postedin.v1.StatusChange
+	(*CreatePostRequest)(nil),     // 3: postedin.v1.CreatePostRequest
+	(*ListPostsRequest)(nil),      // 4: postedin.v1.ListPostsRequest
+	(*ListPostsResponse)(nil),     // 5: postedin.v1.ListPostsResponse
+	(*GetPostRequest)(nil),        // 6: postedin.v1.GetPostRequest
+	(*DeletePostRequest)(nil),     // 7: postedin.v1.DeletePostRequest
+	(*DeletePostResponse)(nil),    // 8: postedin.v1.DeletePostResponse
+	(*PublishPostRequest)(nil),    // 9: postedin.v1.PublishPostRequest
+	(*GetStatusRequest)(nil),      // 10: postedin.v1.GetStatusRequest
+	(*StatusResponse)(nil),        // 11: postedin.v1.StatusResponse
+	nil,                           // 12: postedin.v1.Post.VariantsEntry
+	nil,                           // 13: postedin.v1.CreatePostRequest.VariantsEntry
+	nil,                           // 14: postedin.v1.CreatePostRequest.VarsEntry
+	(*timestamppb.Timestamp)(nil), // 15: google.protobuf.Timestamp
+}
+var file_scheduler_proto_depIdxs = []int32{
+	15, // 0: postedin.v1.Post.scheduled_at:type_name -> google.protobuf.Timestamp
+	15, // 1: postedin.v1.Post.created_at:type_name -> google.protobuf.Timestamp
+	1,  // 2: postedin.v1.Post.poll:type_name -> postedin.v1.Poll
+	12, // 3: postedin.v1.Post.variants:type_name -> postedin.v1.Post.VariantsEntry
+	15, // 4: postedin.v1.Post.published_at:type_name -> google.protobuf.Timestamp
+	2,  // 5: postedin.v1.Post.history:type_name -> postedin.v1.StatusChange
+	15, // 6: postedin.v1.StatusChange.at:type_name -> google.protobuf.Timestamp
+	1,  // 7: postedin.v1.CreatePostRequest.poll:type_name -> postedin.v1.Poll
+	13, // 8: postedin.v1.CreatePostRequest.variants:type_name -> postedin.v1.CreatePostRequest.VariantsEntry
+	14, // 9: postedin.v1.CreatePostRequest.vars:type_name -> postedin.v1.CreatePostRequest.VarsEntry
+	0,  // 10: postedin.v1.ListPostsResponse.posts:type_name -> postedin.v1.Post
+	15, // 11: postedin.v1.StatusResponse.next_run:type_name -> google.protobuf.Timestamp
+	3,  // 12: postedin.v1.Scheduler.CreatePost:input_type -> postedin.v1.CreatePostRequest
+	4,  // 13: postedin.v1.Scheduler.ListPosts:input_type -> postedin.v1.ListPostsRequest
+	6,  // 14: postedin.v1.Scheduler.GetPost:input_type -> postedin.v1.GetPostRequest
+	7,  // 15: postedin.v1.Scheduler.DeletePost:input_type -> postedin.v1.DeletePostRequest
+	9,  // 16: postedin.v1.Scheduler.PublishPost:input_type -> postedin.v1.PublishPostRequest
+	10, // 17: postedin.v1.Scheduler.GetStatus:input_type -> postedin.v1.GetStatusRequest
+	0,  // 18: postedin.v1.Scheduler.CreatePost:output_type -> postedin.v1.Post
+	5,  // 19: postedin.v1.Scheduler.ListPosts:output_type -> postedin.v1.ListPostsResponse
+	0,  // 20: postedin.v1.Scheduler.GetPost:output_type -> postedin.v1.Post
+	8,  // 21: postedin.v1.Scheduler.DeletePost:output_type -> postedin.v1.DeletePostResponse
+	0,  // 22: postedin.v1.Scheduler.PublishPost:output_type -> postedin.v1.Post
+	11, // 23: postedin.v1.Scheduler.GetStatus:output_type -> postedin.v1.StatusResponse
+	18, // [18:24] is the sub-list for method output_type
+	12, // [12:18] is the sub-list for method input_type
+	12, // [12:12] is the sub-list for extension type_name
+	12, // [12:12] is the sub-list for extension extendee
+	0,  // [0:12] is the sub-list for field type_name
+}
+
+func init() { file_scheduler_proto_init() }
+func file_scheduler_proto_init() {
+	if File_scheduler_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_scheduler_proto_rawDesc), len(file_scheduler_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   15,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_scheduler_proto_goTypes,
+		DependencyIndexes: file_scheduler_proto_depIdxs,
+		MessageInfos:      file_scheduler_proto_msgTypes,
+	}.Build()
+	File_scheduler_proto = out.File
+	file_scheduler_proto_goTypes = nil
+	file_scheduler_proto_depIdxs = nil
+}
@@ -0,0 +1,193 @@
+// Package grpcapi serves the scheduler over gRPC for service-to-service integrations,
+// sharing the create, publish and timer logic of the REST API.
+package grpcapi
+
+import (
+	"context"
+	"errors"
+	"sort"
+
+	"PostedIn/internal/api"
+	"PostedIn/internal/config"
+	"PostedIn/internal/cron"
+	"PostedIn/internal/grpcapi/schedulerpb"
+	"PostedIn/internal/models"
+	"PostedIn/internal/scheduler"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Server implements the Scheduler gRPC service.
+type Server struct {
+	schedulerpb.UnimplementedSchedulerServer
+
+	config        *config.Config
+	scheduler     *scheduler.Scheduler
+	cronScheduler *cron.Scheduler
+	router        *api.Router // Validates and creates posts the same way as POST /api/posts
+}
+
+// NewServer creates a gRPC service backed by the same scheduler, auto-scheduler and router as the REST API.
+func NewServer(cfg *config.Config, sched *scheduler.Scheduler, cronSched *cron.Scheduler, router *api.Router) *Server {
+	return &Server{
+		config:        cfg,
+		scheduler:     sched,
+		cronScheduler: cronSched,
+		router:        router,
+	}
+}
+
+// Register registers the service on a gRPC server.
+func (s *Server) Register(registrar grpc.ServiceRegistrar) {
+	schedulerpb.RegisterSchedulerServer(registrar, s)
+}
+
+// CreatePost validates and schedules a post.
+func (s *Server) CreatePost(_ context.Context, req *schedulerpb.CreatePostRequest) (*schedulerpb.Post, error) {
+	created, err := s.router.CreatePost(postRequestFromProto(req))
+
+	var requestErr *api.RequestError
+	if errors.As(err, &requestErr) || errors.Is(err, scheduler.ErrInvalidDependency) {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return postToProto(created), nil
+}
+
+// ListPosts returns the posts ordered by scheduled time, optionally filtered by status.
+func (s *Server) ListPosts(_ context.Context, req *schedulerpb.ListPostsRequest) (*schedulerpb.ListPostsResponse, error) {
+	posts := make([]models.Post, 0)
+
+	for _, post := range s.scheduler.GetPosts() {
+		if req.GetStatus() == "" || post.Status == req.GetStatus() {
+			posts = append(posts, post)
+		}
+	}
+
+	sort.SliceStable(posts, func(i, j int) bool { return posts[i].ScheduledAt.Before(posts[j].ScheduledAt) })
+
+	resp := &schedulerpb.ListPostsResponse{Posts: make([]*schedulerpb.Post, 0, len(posts))}
+	for _, post := range posts {
+		resp.Posts = append(resp.Posts, postToProto(post))
+	}
+
+	return resp, nil
+}
+
+// GetPost returns a single post.
+func (s *Server) GetPost(_ context.Context, req *schedulerpb.GetPostRequest) (*schedulerpb.Post, error) {
+	post, err := s.findPost(req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	return postToProto(post), nil
+}
+
+// DeletePost deletes a post and stops its timer.
+func (s *Server) DeletePost(_ context.Context, req *schedulerpb.DeletePostRequest) (*schedulerpb.DeletePostResponse, error) {
+	id, err := postID(req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.scheduler.DeletePost(id); err != nil {
+		return nil, statusFromError(err)
+	}
+
+	if s.cronScheduler != nil {
+		s.cronScheduler.RemovePostTimers([]int{id})
+	}
+
+	return &schedulerpb.DeletePostResponse{DeletedId: int64(id)}, nil
+}
+
+// PublishPost publishes a scheduled post right away and returns it with its new status.
+func (s *Server) PublishPost(ctx context.Context, req *schedulerpb.PublishPostRequest) (*schedulerpb.Post, error) {
+	id, err := postID(req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.scheduler.PublishToLinkedIn(ctx, id, s.config); err != nil {
+		return nil, statusFromError(err)
+	}
+
+	return s.GetPost(ctx, &schedulerpb.GetPostRequest{Id: req.GetId()})
+}
+
+// GetStatus reports the state of the auto-scheduler.
+func (s *Server) GetStatus(_ context.Context, _ *schedulerpb.GetStatusRequest) (*schedulerpb.StatusResponse, error) {
+	resp := &schedulerpb.StatusResponse{Paused: s.scheduler.IsPaused()}
+
+	if s.cronScheduler == nil {
+		return resp, nil
+	}
+
+	cronStatus := s.cronScheduler.GetStatus()
+
+	if running, ok := cronStatus["running"].(bool); ok {
+		resp.Running = running
+	}
+
+	if enabled, ok := cronStatus["enabled"].(bool); ok {
+		resp.Enabled = enabled
+	}
+
+	if entries, ok := cronStatus["entries"].(int); ok {
+		resp.ArmedTimers = int32(entries)
+	}
+
+	if nextID, nextRun := s.cronScheduler.NextPost(); !nextRun.IsZero() {
+		resp.NextPostId = int64(nextID)
+		resp.NextRun = timestamppb.New(nextRun)
+	}
+
+	return resp, nil
+}
+
+// findPost returns the post with the given ID or a NotFound status.
+func (s *Server) findPost(rawID int64) (models.Post, error) {
+	id, err := postID(rawID)
+	if err != nil {
+		return models.Post{}, err
+	}
+
+	for _, post := range s.scheduler.GetPosts() {
+		if post.ID == id {
+			return post, nil
+		}
+	}
+
+	return models.Post{}, status.Errorf(codes.NotFound, "post %d: %v", id, scheduler.ErrPostNotFound)
+}
+
+// postID validates a post ID from a request.
+func postID(id int64) (int, error) {
+	if id <= 0 {
+		return 0, status.Error(codes.InvalidArgument, "invalid post ID")
+	}
+
+	return int(id), nil
+}
+
+// statusFromError maps scheduler errors onto gRPC status codes.
+func statusFromError(err error) error {
+	switch {
+	case errors.Is(err, scheduler.ErrPostNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, scheduler.ErrPublishingPaused):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, scheduler.ErrPublishDeferred):
+		return status.Error(codes.Unavailable, err.Error())
+	default:
+		return status.Error(codes.Internal, err.Error())
+	}
+}
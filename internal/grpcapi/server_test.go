@@ -0,0 +1,259 @@
+package grpcapi
+
+import (
+	"context"
+	"net"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"PostedIn/internal/api"
+	"PostedIn/internal/config"
+	"PostedIn/internal/cron"
+	"PostedIn/internal/grpcapi/schedulerpb"
+	"PostedIn/internal/models"
+	"PostedIn/internal/scheduler"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// newTestClient serves the service in-process over a scheduler saving to a temporary posts file
+// and returns a client connected to it. A nil cronSched runs without the auto-scheduler.
+func newTestClient(t *testing.T, cfg *config.Config, sched *scheduler.Scheduler, cronSched *cron.Scheduler) schedulerpb.SchedulerClient {
+	t.Helper()
+
+	listener := bufconn.Listen(1 << 20)
+	server := grpc.NewServer()
+	NewServer(cfg, sched, cronSched, api.NewRouter(cfg, sched, cronSched)).Register(server)
+
+	go func() { _ = server.Serve(listener) }()
+
+	t.Cleanup(server.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return listener.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Cleanup(func() { _ = conn.Close() })
+
+	return schedulerpb.NewSchedulerClient(conn)
+}
+
+// testStore returns a dry-run UTC config and a scheduler saving to a temporary posts file.
+func testStore(t *testing.T) (*config.Config, *scheduler.Scheduler) {
+	t.Helper()
+
+	cfg := &config.Config{Timezone: config.TimezoneConfig{Location: "UTC"}, DryRun: true}
+	sched := scheduler.NewScheduler(filepath.Join(t.TempDir(), "posts.json"))
+	sched.LoadSwitches(cfg)
+
+	return cfg, sched
+}
+
+func assertCode(t *testing.T, err error, want codes.Code) {
+	t.Helper()
+
+	if got := status.Code(err); got != want {
+		t.Fatalf("code = %v (%v), want %v", got, err, want)
+	}
+}
+
+func TestCreateListGetDelete(t *testing.T) {
+	cfg, sched := testStore(t)
+	client := newTestClient(t, cfg, sched, nil)
+	ctx := context.Background()
+
+	scheduledAt := time.Now().UTC().Add(48 * time.Hour).Truncate(time.Minute)
+
+	created, err := client.CreatePost(ctx, &schedulerpb.CreatePostRequest{
+		Content:     "hello over gRPC",
+		ScheduledAt: scheduledAt.Format("2006-01-02 15:04"),
+		Tags:        []string{"launch"},
+	})
+	if err != nil {
+		t.Fatalf("CreatePost: %v", err)
+	}
+
+	if created.GetId() == 0 || created.GetStatus() != models.StatusScheduled || !created.GetScheduledAt().AsTime().Equal(scheduledAt) {
+		t.Fatalf("created %+v, want a scheduled post at %v", created, scheduledAt)
+	}
+
+	if len(sched.GetPosts()) != 1 {
+		t.Fatalf("scheduler holds %d posts, want the created one", len(sched.GetPosts()))
+	}
+
+	got, err := client.GetPost(ctx, &schedulerpb.GetPostRequest{Id: created.GetId()})
+	if err != nil {
+		t.Fatalf("GetPost: %v", err)
+	}
+
+	if got.GetContent() != "hello over gRPC" || len(got.GetTags()) != 1 || got.GetTags()[0] != "launch" {
+		t.Errorf("GetPost = %+v", got)
+	}
+
+	list, err := client.ListPosts(ctx, &schedulerpb.ListPostsRequest{Status: models.StatusScheduled})
+	if err != nil || len(list.GetPosts()) != 1 {
+		t.Fatalf("ListPosts(scheduled) = %v, %v, want the post", list, err)
+	}
+
+	list, err = client.ListPosts(ctx, &schedulerpb.ListPostsRequest{Status: models.StatusPosted})
+	if err != nil || len(list.GetPosts()) != 0 {
+		t.Fatalf("ListPosts(posted) = %v, %v, want none", list, err)
+	}
+
+	deleted, err := client.DeletePost(ctx, &schedulerpb.DeletePostRequest{Id: created.GetId()})
+	if err != nil || deleted.GetDeletedId() != created.GetId() {
+		t.Fatalf("DeletePost = %v, %v", deleted, err)
+	}
+
+	_, err = client.GetPost(ctx, &schedulerpb.GetPostRequest{Id: created.GetId()})
+	assertCode(t, err, codes.NotFound)
+}
+
+func TestListPostsOrdersByScheduledTime(t *testing.T) {
+	cfg, sched := testStore(t)
+	client := newTestClient(t, cfg, sched, nil)
+
+	for _, delay := range []time.Duration{3 * time.Hour, time.Hour, 2 * time.Hour} {
+		if _, err := sched.Add(models.Post{Content: delay.String(), ScheduledAt: time.Now().Add(delay)}, cfg); err != nil {
+			t.Fatalf("Add: %v", err)
+		}
+	}
+
+	list, err := client.ListPosts(context.Background(), &schedulerpb.ListPostsRequest{})
+	if err != nil {
+		t.Fatalf("ListPosts: %v", err)
+	}
+
+	var ids []int64
+	for _, post := range list.GetPosts() {
+		ids = append(ids, post.GetId())
+	}
+
+	if len(ids) != 3 || ids[0] != 2 || ids[1] != 3 || ids[2] != 1 {
+		t.Errorf("ListPosts ids = %v, want [2 3 1]", ids)
+	}
+}
+
+func TestCreatePostInvalid(t *testing.T) {
+	cfg, sched := testStore(t)
+	client := newTestClient(t, cfg, sched, nil)
+
+	requests := map[string]*schedulerpb.CreatePostRequest{
+		"no content":   {ScheduledAt: time.Now().UTC().Add(time.Hour).Format("2006-01-02 15:04")},
+		"past":         {Content: "too late", ScheduledAt: "2000-01-01 09:00"},
+		"bad time":     {Content: "when?", ScheduledAt: "someday"},
+		"missing base": {Content: "follow-up", DependsOn: 42, OffsetMinutes: 30},
+	}
+
+	for name, req := range requests {
+		t.Run(name, func(t *testing.T) {
+			_, err := client.CreatePost(context.Background(), req)
+			assertCode(t, err, codes.InvalidArgument)
+		})
+	}
+
+	if len(sched.GetPosts()) != 0 {
+		t.Errorf("scheduler holds %d posts after invalid requests, want none", len(sched.GetPosts()))
+	}
+}
+
+func TestInvalidAndMissingIDs(t *testing.T) {
+	cfg, sched := testStore(t)
+	client := newTestClient(t, cfg, sched, nil)
+	ctx := context.Background()
+
+	_, err := client.GetPost(ctx, &schedulerpb.GetPostRequest{Id: 0})
+	assertCode(t, err, codes.InvalidArgument)
+
+	_, err = client.DeletePost(ctx, &schedulerpb.DeletePostRequest{Id: 7})
+	assertCode(t, err, codes.NotFound)
+
+	_, err = client.PublishPost(ctx, &schedulerpb.PublishPostRequest{Id: 7})
+	assertCode(t, err, codes.NotFound)
+}
+
+func TestPublishPost(t *testing.T) {
+	cfg, sched := testStore(t)
+	client := newTestClient(t, cfg, sched, nil)
+
+	post, err := sched.Add(models.Post{Content: "publish me", ScheduledAt: time.Now().Add(time.Hour)}, cfg)
+	if err != nil {
+		t.Fatalf("Add: %v", err)
+	}
+
+	published, err := client.PublishPost(context.Background(), &schedulerpb.PublishPostRequest{Id: int64(post.ID)})
+	if err != nil {
+		t.Fatalf("PublishPost: %v", err)
+	}
+
+	if published.GetStatus() != models.StatusPosted || published.GetPublishedAt() == nil {
+		t.Errorf("published post = %+v, want it posted", published)
+	}
+}
+
+func TestPublishPostWhilePaused(t *testing.T) {
+	cfg, sched := testStore(t)
+	cfg.Paused = true
+	sched.LoadSwitches(cfg)
+
+	client := newTestClient(t, cfg, sched, nil)
+
+	post, err := sched.Add(models.Post{Content: "held", ScheduledAt: time.Now().Add(time.Hour)}, cfg)
+	if err != nil {
+		t.Fatalf("Add: %v", err)
+	}
+
+	_, err = client.PublishPost(context.Background(), &schedulerpb.PublishPostRequest{Id: int64(post.ID)})
+	assertCode(t, err, codes.FailedPrecondition)
+
+	resp, err := client.GetStatus(context.Background(), &schedulerpb.GetStatusRequest{})
+	if err != nil || !resp.GetPaused() || resp.GetRunning() {
+		t.Fatalf("GetStatus = %+v, %v, want paused and no auto-scheduler", resp, err)
+	}
+}
+
+func TestCreateAndDeleteArmTimers(t *testing.T) {
+	cfg, sched := testStore(t)
+
+	cs := cron.NewScheduler(sched, cfg)
+	if err := cs.Start(); err != nil {
+		t.Fatalf("Start: %v", err)
+	}
+
+	t.Cleanup(cs.Stop)
+
+	client := newTestClient(t, cfg, sched, cs)
+	ctx := context.Background()
+
+	scheduledAt := time.Now().UTC().Add(2 * time.Hour).Truncate(time.Minute)
+
+	created, err := client.CreatePost(ctx, &schedulerpb.CreatePostRequest{Content: "armed", ScheduledAt: scheduledAt.Format("2006-01-02 15:04")})
+	if err != nil {
+		t.Fatalf("CreatePost: %v", err)
+	}
+
+	resp, err := client.GetStatus(ctx, &schedulerpb.GetStatusRequest{})
+	if err != nil {
+		t.Fatalf("GetStatus: %v", err)
+	}
+
+	if !resp.GetRunning() || resp.GetNextPostId() != created.GetId() || !resp.GetNextRun().AsTime().Equal(scheduledAt) {
+		t.Errorf("GetStatus = %+v, want post %d next at %v", resp, created.GetId(), scheduledAt)
+	}
+
+	if _, err := client.DeletePost(ctx, &schedulerpb.DeletePostRequest{Id: created.GetId()}); err != nil {
+		t.Fatalf("DeletePost: %v", err)
+	}
+
+	if id, next := cs.NextPost(); id != 0 || !next.IsZero() {
+		t.Errorf("next timer = post %d at %v after delete, want none", id, next)
+	}
+}
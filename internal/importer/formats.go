@@ -0,0 +1,97 @@
+package importer
+
+import (
+	"fmt"
+	"time"
+
+	"PostedIn/internal/models"
+)
+
+// Date layouts seen in Buffer exports and bulk-upload files.
+var bufferLayouts = []string{
+	"2006-01-02 15:04",
+	"2006-01-02 15:04:05",
+	"2006-01-02T15:04:05Z07:00",
+	"January 2, 2006 3:04 PM",
+	"Jan 2, 2006 3:04 PM",
+	"01/02/2006 15:04",
+	"01/02/2006 3:04 PM",
+}
+
+// Date layouts seen in Hootsuite bulk composer files, which put the day first.
+var hootsuiteLayouts = []string{
+	"02/01/2006 15:04",
+	"02/01/2006 15:04:05",
+	"02/01/2006 3:04 PM",
+	"2006-01-02 15:04",
+	"2006-01-02 15:04:05",
+}
+
+// Date layouts accepted by the generic format.
+var genericLayouts = []string{
+	"2006-01-02 15:04",
+	"2006-01-02 15:04:05",
+	"2006-01-02T15:04",
+	"2006-01-02T15:04:05Z07:00",
+}
+
+// bufferMapper maps Buffer exports: "Text" with "Posting Time", "Scheduled At" or "Due At".
+type bufferMapper struct{}
+
+func (bufferMapper) Map(record map[string]string, loc *time.Location) (models.Post, error) {
+	return mapPost(
+		firstOf(record, "text", "post text", "content"),
+		firstOf(record, "posting time", "scheduled at", "due at", "date"),
+		loc, bufferLayouts,
+	)
+}
+
+// hootsuiteMapper maps Hootsuite exports: "Message" with a day-first "Date", optionally
+// split into separate "Date" and "Time" columns.
+type hootsuiteMapper struct{}
+
+func (hootsuiteMapper) Map(record map[string]string, loc *time.Location) (models.Post, error) {
+	when := firstOf(record, "date", "scheduled date", "send date")
+	if clock := firstOf(record, "time", "scheduled time", "send time"); clock != "" && when != "" {
+		when += " " + clock
+	}
+
+	return mapPost(
+		firstOf(record, "message", "post text", "text", "content"),
+		when,
+		loc, hootsuiteLayouts,
+	)
+}
+
+// genericMapper maps files using this scheduler's own column names, "content" and "scheduled_at".
+type genericMapper struct{}
+
+func (genericMapper) Map(record map[string]string, loc *time.Location) (models.Post, error) {
+	return mapPost(
+		firstOf(record, "content", "text", "message"),
+		firstOf(record, "scheduled at", "date", "time"),
+		loc, genericLayouts,
+	)
+}
+
+// mapPost builds a text post from the content and time cells of a row.
+func mapPost(content, when string, loc *time.Location, layouts []string) (models.Post, error) {
+	if content == "" {
+		return models.Post{}, fmt.Errorf("content is empty")
+	}
+
+	if when == "" {
+		return models.Post{}, fmt.Errorf("scheduled time is empty")
+	}
+
+	scheduledAt, err := parseTime(when, loc, layouts)
+	if err != nil {
+		return models.Post{}, err
+	}
+
+	return models.Post{
+		Content:     content,
+		ScheduledAt: scheduledAt,
+		PostType:    models.PostTypeText,
+	}, nil
+}
@@ -0,0 +1,152 @@
+// Package importer converts CSV exports of other social media schedulers into scheduled posts.
+package importer
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+
+	"PostedIn/internal/models"
+)
+
+// Mapper converts one CSV record of a scheduler's export into a post.
+type Mapper interface {
+	// Map builds a post from a record keyed by normalized header name, parsing times without
+	// an explicit offset in loc.
+	Map(record map[string]string, loc *time.Location) (models.Post, error)
+}
+
+// mappers holds the supported export formats by name.
+var mappers = map[string]Mapper{
+	"buffer":    bufferMapper{},
+	"hootsuite": hootsuiteMapper{},
+	"generic":   genericMapper{},
+}
+
+// Formats returns the names of the supported export formats.
+func Formats() []string {
+	names := make([]string, 0, len(mappers))
+	for name := range mappers {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	return names
+}
+
+// MapperFor returns the mapper of the named export format.
+func MapperFor(format string) (Mapper, error) {
+	mapper, ok := mappers[strings.ToLower(format)]
+	if !ok {
+		return nil, fmt.Errorf("unknown import format %q, use one of: %s", format, strings.Join(Formats(), ", "))
+	}
+
+	return mapper, nil
+}
+
+// RowError records a CSV row that could not be mapped to a post.
+type RowError struct {
+	Row    int    `json:"row"` // 1-based line in the file, counting the header
+	Reason string `json:"reason"`
+}
+
+// MappedRow is a CSV row mapped to a post.
+type MappedRow struct {
+	Row  int // 1-based line in the file, counting the header
+	Post models.Post
+}
+
+// Result holds the posts mapped from a file and the rows that were skipped.
+type Result struct {
+	Posts    []MappedRow
+	Unmapped []RowError
+}
+
+// Parse reads a CSV export with a header row and maps every data row with mapper.
+// Rows that cannot be mapped are reported in Result.Unmapped instead of failing the import.
+func Parse(r io.Reader, mapper Mapper, loc *time.Location) (Result, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if errors.Is(err, io.EOF) {
+		return Result{}, fmt.Errorf("CSV file is empty")
+	}
+
+	if err != nil {
+		return Result{}, fmt.Errorf("failed to read CSV header: %w", err)
+	}
+
+	for i := range header {
+		header[i] = normalizeColumn(header[i])
+	}
+
+	var result Result
+
+	for row := 2; ; row++ {
+		fields, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		if err != nil {
+			result.Unmapped = append(result.Unmapped, RowError{Row: row, Reason: err.Error()})
+			continue
+		}
+
+		record := make(map[string]string, len(header))
+		for i, name := range header {
+			if i < len(fields) {
+				record[name] = strings.TrimSpace(fields[i])
+			}
+		}
+
+		post, err := mapper.Map(record, loc)
+		if err != nil {
+			result.Unmapped = append(result.Unmapped, RowError{Row: row, Reason: err.Error()})
+			continue
+		}
+
+		result.Posts = append(result.Posts, MappedRow{Row: row, Post: post})
+	}
+
+	return result, nil
+}
+
+// normalizeColumn lower-cases a header name and collapses spaces, dashes and underscores,
+// so "Posting Time", "posting_time" and "posting-time" match the same column.
+func normalizeColumn(name string) string {
+	name = strings.TrimPrefix(name, "\ufeff") // Byte order mark written by spreadsheet exports
+
+	return strings.Join(strings.FieldsFunc(strings.ToLower(name), func(r rune) bool {
+		return r == ' ' || r == '_' || r == '-'
+	}), " ")
+}
+
+// firstOf returns the first non-empty value among the given columns.
+func firstOf(record map[string]string, columns ...string) string {
+	for _, column := range columns {
+		if value := record[column]; value != "" {
+			return value
+		}
+	}
+
+	return ""
+}
+
+// parseTime tries each layout in turn; layouts without a zone are read in loc.
+func parseTime(value string, loc *time.Location, layouts []string) (time.Time, error) {
+	for _, layout := range layouts {
+		if t, err := time.ParseInLocation(layout, value, loc); err == nil {
+			return t, nil
+		}
+	}
+
+	return time.Time{}, fmt.Errorf("unrecognized date %q", value)
+}
@@ -0,0 +1,142 @@
+package importer
+
+import (
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"PostedIn/internal/models"
+)
+
+func TestParseFormats(t *testing.T) {
+	loc, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Skipf("timezone data unavailable: %v", err)
+	}
+
+	tests := []struct {
+		format string
+		csv    string
+		want   []time.Time
+	}{
+		{
+			format: "buffer",
+			csv: "Text,Posting Time,Profile\n" +
+				"Launch day,2026-11-03 09:30,LinkedIn\n" +
+				"\"Quoted, with comma\",\"November 4, 2026 2:15 PM\",LinkedIn\n" +
+				"Offset given,2026-11-05T08:00:00Z,LinkedIn\n",
+			want: []time.Time{
+				time.Date(2026, 11, 3, 9, 30, 0, 0, loc),
+				time.Date(2026, 11, 4, 14, 15, 0, 0, loc),
+				time.Date(2026, 11, 5, 8, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			format: "hootsuite",
+			csv: "Date,Time,Message\n" +
+				"03/11/2026,09:30,Day first\n" +
+				"04/11/2026 14:15,,Single column\n",
+			want: []time.Time{
+				time.Date(2026, 11, 3, 9, 30, 0, 0, loc),
+				time.Date(2026, 11, 4, 14, 15, 0, 0, loc),
+			},
+		},
+		{
+			format: "generic",
+			csv: "\ufeffcontent,scheduled_at\n" +
+				"Own columns,2026-11-03T09:30\n" +
+				"Seconds too,2026-11-04 14:15:00\n",
+			want: []time.Time{
+				time.Date(2026, 11, 3, 9, 30, 0, 0, loc),
+				time.Date(2026, 11, 4, 14, 15, 0, 0, loc),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		mapper, err := MapperFor(tt.format)
+		if err != nil {
+			t.Fatalf("MapperFor(%q): %v", tt.format, err)
+		}
+
+		result, err := Parse(strings.NewReader(tt.csv), mapper, loc)
+		if err != nil {
+			t.Fatalf("%s: Parse: %v", tt.format, err)
+		}
+
+		if len(result.Unmapped) != 0 {
+			t.Errorf("%s: unmapped rows %+v", tt.format, result.Unmapped)
+		}
+
+		if len(result.Posts) != len(tt.want) {
+			t.Fatalf("%s: mapped %d posts, want %d", tt.format, len(result.Posts), len(tt.want))
+		}
+
+		for i, row := range result.Posts {
+			if !row.Post.ScheduledAt.Equal(tt.want[i]) {
+				t.Errorf("%s row %d: scheduled at %v, want %v", tt.format, row.Row, row.Post.ScheduledAt, tt.want[i])
+			}
+
+			if row.Post.Content == "" || row.Post.PostType != models.PostTypeText {
+				t.Errorf("%s row %d: post %+v, want a text post with content", tt.format, row.Row, row.Post)
+			}
+		}
+	}
+}
+
+func TestParseReportsUnmappedRows(t *testing.T) {
+	csv := "Text,Posting Time\n" +
+		"Good,2026-11-03 09:30\n" +
+		",2026-11-03 10:00\n" +
+		"No time,\n" +
+		"Bad time,next week\n" +
+		"Also good,2026-11-03 11:00\n"
+
+	result, err := Parse(strings.NewReader(csv), bufferMapper{}, time.UTC)
+	if err != nil {
+		t.Fatalf("Parse: %v", err)
+	}
+
+	var rows []int
+	for _, row := range result.Posts {
+		rows = append(rows, row.Row)
+	}
+
+	if !slices.Equal(rows, []int{2, 6}) {
+		t.Errorf("mapped rows %v, want 2 and 6", rows)
+	}
+
+	var unmapped []int
+	for _, rowErr := range result.Unmapped {
+		unmapped = append(unmapped, rowErr.Row)
+	}
+
+	if !slices.Equal(unmapped, []int{3, 4, 5}) {
+		t.Errorf("unmapped rows %+v, want 3, 4 and 5", result.Unmapped)
+	}
+
+	if !strings.Contains(result.Unmapped[2].Reason, "unrecognized date") {
+		t.Errorf("reason = %q, want the unrecognized date", result.Unmapped[2].Reason)
+	}
+}
+
+func TestParseEmpty(t *testing.T) {
+	if _, err := Parse(strings.NewReader(""), genericMapper{}, time.UTC); err == nil {
+		t.Error("Parse accepted an empty file")
+	}
+}
+
+func TestMapperFor(t *testing.T) {
+	if _, err := MapperFor("Buffer"); err != nil {
+		t.Errorf("MapperFor is case sensitive: %v", err)
+	}
+
+	if _, err := MapperFor("later"); err == nil {
+		t.Error("MapperFor accepted an unknown format")
+	}
+
+	if got := Formats(); !slices.Equal(got, []string{"buffer", "generic", "hootsuite"}) {
+		t.Errorf("Formats = %v", got)
+	}
+}
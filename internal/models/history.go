@@ -0,0 +1,35 @@
+package models
+
+import "time"
+
+// MaxHistory bounds how many status changes are kept per post; the oldest are dropped first.
+const MaxHistory = 50
+
+// StatusPublishing marks the start of a publish attempt in a post's history; it is never stored as a post's status.
+const StatusPublishing = "publishing"
+
+// StatusChange is one entry of a post's lifecycle timeline.
+type StatusChange struct {
+	Status string    `json:"status"`
+	At     time.Time `json:"at"`
+	Note   string    `json:"note,omitempty"` // e.g. "created", "edited" or the failure reason
+}
+
+// Record appends an entry to the post's history without changing its status.
+func (p *Post) Record(status, note string) {
+	p.History = append(p.History, StatusChange{
+		Status: status,
+		At:     time.Now().In(p.ScheduledAt.Location()),
+		Note:   note,
+	})
+
+	if len(p.History) > MaxHistory {
+		p.History = append([]StatusChange(nil), p.History[len(p.History)-MaxHistory:]...)
+	}
+}
+
+// SetStatus changes the post's status and records the change in its history.
+func (p *Post) SetStatus(status, note string) {
+	p.Status = status
+	p.Record(status, note)
+}
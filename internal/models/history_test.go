@@ -0,0 +1,54 @@
+package models
+
+import (
+	"fmt"
+	"testing"
+	"time"
+)
+
+func TestSetStatusRecordsHistory(t *testing.T) {
+	post := Post{Status: StatusScheduled, ScheduledAt: time.Now().In(time.FixedZone("CEST", 2*60*60))}
+
+	post.Record(post.Status, "created")
+	post.SetStatus(StatusPosted, "")
+
+	if post.Status != StatusPosted {
+		t.Errorf("status = %q, want %q", post.Status, StatusPosted)
+	}
+
+	if len(post.History) != 2 {
+		t.Fatalf("history has %d entries, want 2", len(post.History))
+	}
+
+	if post.History[0].Note != "created" || post.History[1].Status != StatusPosted {
+		t.Errorf("history = %+v, want created then posted", post.History)
+	}
+
+	if post.History[1].At.Before(post.History[0].At) {
+		t.Error("history entries are out of order")
+	}
+
+	if _, offset := post.History[0].At.Zone(); offset != 2*60*60 {
+		t.Errorf("entry recorded with offset %d, want the post's timezone", offset)
+	}
+}
+
+func TestRecordKeepsHistoryBounded(t *testing.T) {
+	var post Post
+
+	for i := range MaxHistory + 10 {
+		post.Record(StatusScheduled, fmt.Sprintf("change %d", i))
+	}
+
+	if len(post.History) != MaxHistory {
+		t.Fatalf("history has %d entries, want %d", len(post.History), MaxHistory)
+	}
+
+	if first := post.History[0].Note; first != "change 10" {
+		t.Errorf("oldest kept entry = %q, want the oldest ones dropped first", first)
+	}
+
+	if last := post.History[MaxHistory-1].Note; last != fmt.Sprintf("change %d", MaxHistory+9) {
+		t.Errorf("newest entry = %q", last)
+	}
+}
@@ -3,12 +3,108 @@ package models
 
 import "time"
 
+// Post statuses.
+const (
+	StatusScheduled   = "scheduled"
+	StatusPosted      = "posted"
+	StatusFailed      = "failed"
+	StatusNeedsReview = "needs_review" // Overdue post held back for manual review
+	StatusWaiting     = "waiting"      // Waiting for the post it depends on to publish
+	StatusDraft       = "draft"        // Composed but not published until it is scheduled
+)
+
+// Post types supported by the scheduler.
+const (
+	PostTypeText  = "text"
+	PostTypePoll  = "poll"
+	PostTypeVideo = "video"
+)
+
+// Author types of a post.
+const (
+	AuthorPerson       = "person"
+	AuthorOrganization = "organization"
+)
+
 // Post represents a LinkedIn post with scheduling information.
 type Post struct {
 	ID          int       `json:"id"`
 	Content     string    `json:"content"`
 	ScheduledAt time.Time `json:"scheduled_at"`
-	Status      string    `json:"status"` // "scheduled", "posted", "failed"
+	Status      string    `json:"status"` // "scheduled", "posted", "failed", "needs_review", "waiting", "draft"
 	CreatedAt   time.Time `json:"created_at"`
 	CronEntryID int       `json:"cron_entry_id,omitempty"` // ID of the associated cron job
+	PostType    string    `json:"post_type,omitempty"`     // "text" (default), "poll" or "video"
+	Poll        *Poll     `json:"poll,omitempty"`
+	Language    string    `json:"language,omitempty"` // Language of Content
+	// Variants holds the content translated into other languages, keyed by language code.
+	Variants       map[string]string `json:"variants,omitempty"`
+	TargetLanguage string            `json:"target_language,omitempty"` // Variant to publish; "all" fans out, empty uses Content
+	// DependsOn is the ID of a post whose actual publish time, plus OffsetMinutes, sets this post's schedule.
+	DependsOn     int        `json:"depends_on,omitempty"`
+	OffsetMinutes int        `json:"offset_minutes,omitempty"`
+	PublishedAt   *time.Time `json:"published_at,omitempty"`
+	APIVersion    string     `json:"api_version,omitempty"` // LinkedIn-Version override for this post's publish request
+	ImagePath     string     `json:"image_path,omitempty"`  // Local image uploaded to LinkedIn at publish time
+	ImageAltText  string     `json:"image_alt_text,omitempty"`
+	DeferredCount int        `json:"deferred_count,omitempty"` // Times publishing was postponed because LinkedIn was unavailable
+	Attempts      int        `json:"attempts,omitempty"`       // Publish attempts made, including retries after transient failures
+	VideoPath     string     `json:"video_path,omitempty"`     // Local MP4 uploaded to LinkedIn at publish time
+	VideoTitle    string     `json:"video_title,omitempty"`
+	LastError     string     `json:"last_error,omitempty"` // Error of the most recent failed publish, with LinkedIn's request ID when known
+	// RequestID is the LinkedIn request ID of the most recent publish attempt, for support tickets.
+	RequestID string `json:"request_id,omitempty"`
+	Audience  string `json:"audience,omitempty"` // Named audience from config targeting the post's distribution
+	// Event names a calendar event from config; the post is scheduled EventOffsetMinutes from it
+	// (negative is before) and follows the event when it moves.
+	Event              string   `json:"event,omitempty"`
+	EventOffsetMinutes int      `json:"event_offset_minutes,omitempty"`
+	Tags               []string `json:"tags,omitempty"` // Normalized labels for organizing posts
+	// History is the bounded timeline of the post's status changes, oldest first.
+	History []StatusChange `json:"history,omitempty"`
+	PostURN string         `json:"post_urn,omitempty"` // LinkedIn URN of the published post (its first language variant)
+	// ResultsOf is the ID of the poll whose final tallies this post announces; its content is a results template.
+	ResultsOf int `json:"results_of,omitempty"`
+	// Recurrence is "daily", "weekly", "monthly" or a cron expression; each publish schedules a copy at the next occurrence.
+	Recurrence string `json:"recurrence,omitempty"`
+	Account    string `json:"account,omitempty"` // Configured LinkedIn account that publishes the post; empty is the default one
+	// AuthorType is "person" (the default when empty) or "organization" to publish as the company
+	// page OrganizationURN, which the authenticated member administers.
+	AuthorType      string `json:"author_type,omitempty"`
+	OrganizationURN string `json:"organization_urn,omitempty"`
+	// Visibility is PUBLIC, CONNECTIONS or LOGGED_IN; empty uses the configured visibility rules.
+	Visibility string `json:"visibility,omitempty"`
+	Stats      *Stats `json:"stats,omitempty"`     // Engagement last fetched from LinkedIn
+	RepostOf   int    `json:"repost_of,omitempty"` // ID of the post this best-of repost republishes
+}
+
+// Stats is the engagement of a published post at FetchedAt.
+type Stats struct {
+	Reactions int       `json:"reactions"`
+	Comments  int       `json:"comments"`
+	FetchedAt time.Time `json:"fetched_at"`
+}
+
+// Poll holds the question and options of a poll post.
+type Poll struct {
+	Question string   `json:"question"`
+	Options  []string `json:"options"`
+	Duration string   `json:"duration,omitempty"` // ONE_DAY, THREE_DAYS, SEVEN_DAYS or FOURTEEN_DAYS
+	// ResultsTemplate, when set, schedules a follow-up post announcing the results once the poll closes.
+	ResultsTemplate string `json:"results_template,omitempty"`
+}
+
+// IsVideo reports whether the post is a video post.
+func (p *Post) IsVideo() bool {
+	return p.PostType == PostTypeVideo && p.VideoPath != ""
+}
+
+// IsOrganization reports whether the post is published as a company page.
+func (p *Post) IsOrganization() bool {
+	return p.AuthorType == AuthorOrganization
+}
+
+// IsPoll reports whether the post is a poll.
+func (p *Post) IsPoll() bool {
+	return p.PostType == PostTypePoll && p.Poll != nil
 }
@@ -0,0 +1,102 @@
+// Package notify delivers post lifecycle events to a webhook, batching bursts of events.
+package notify
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"time"
+
+	"PostedIn/internal/models"
+)
+
+// Event types sent to the webhook.
+const (
+	EventPublished = "post.published"
+	EventFailed    = "post.failed"
+)
+
+const webhookTimeout = 15 * time.Second
+
+// Event describes a change in a post's publishing state.
+type Event struct {
+	Type   string    `json:"type"`
+	PostID int       `json:"post_id"`
+	Status string    `json:"status"`
+	Error  string    `json:"error,omitempty"`
+	Time   time.Time `json:"time"`
+}
+
+// NewPublishEvent builds the event for a publish attempt that succeeded or failed.
+func NewPublishEvent(post models.Post, err error) Event {
+	event := Event{
+		Type:   EventPublished,
+		PostID: post.ID,
+		Status: post.Status,
+		Time:   time.Now(),
+	}
+
+	if err != nil {
+		event.Type = EventFailed
+		event.Error = err.Error()
+	}
+
+	return event
+}
+
+// Sender delivers a batch of events.
+type Sender interface {
+	Send(ctx context.Context, events []Event) error
+}
+
+// WebhookSender posts event batches as JSON to a URL.
+type WebhookSender struct {
+	URL    string
+	client *http.Client
+}
+
+// NewWebhookSender creates a sender posting to the given webhook URL.
+func NewWebhookSender(url string) *WebhookSender {
+	return &WebhookSender{
+		URL:    url,
+		client: &http.Client{Timeout: webhookTimeout},
+	}
+}
+
+// Send posts {"events": [...]} to the webhook and fails on any non-2xx response.
+func (w *WebhookSender) Send(ctx context.Context, events []Event) error {
+	payload, err := json.Marshal(map[string]interface{}{"events": events})
+	if err != nil {
+		return fmt.Errorf("failed to marshal events: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.URL, bytes.NewReader(payload))
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "PostedIn/1.0")
+
+	resp, err := w.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to call webhook: %w", err)
+	}
+
+	defer func() {
+		if closeErr := resp.Body.Close(); closeErr != nil {
+			log.Printf("Warning: failed to close response body: %v", closeErr)
+		}
+	}()
+
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		body, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf("webhook error (%d): %s", resp.StatusCode, string(body))
+	}
+
+	return nil
+}
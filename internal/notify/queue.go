@@ -0,0 +1,159 @@
+package notify
+
+import (
+	"context"
+	"log"
+	"sync"
+	"time"
+
+	"PostedIn/internal/config"
+)
+
+const (
+	defaultBatchWindow = 10 * time.Second
+	defaultMaxRetries  = 3
+	initialBackoff     = time.Second
+	queueCapacity      = 1000
+)
+
+// Queue batches events arriving within a window into a single delivery.
+//
+// Events are delivered in the order they were enqueued, one batch at a time. Delivery is
+// at-least-once within the retry budget: a batch is retried with exponential backoff until the
+// receiver returns 2xx or MaxRetries is exhausted, so receivers may see a batch more than once
+// (e.g. when a response is lost) and should dedupe on post_id and type. A batch that still
+// fails after the last retry is logged and dropped, as are events enqueued while the queue is full.
+type Queue struct {
+	sender     Sender
+	window     time.Duration
+	maxRetries int
+	backoff    time.Duration
+	events     chan Event
+	stop       chan struct{}
+	wg         sync.WaitGroup
+	stopOnce   sync.Once
+}
+
+// NewQueue creates a queue delivering through sender. Non-positive values use the defaults.
+func NewQueue(sender Sender, window time.Duration, maxRetries int) *Queue {
+	if window <= 0 {
+		window = defaultBatchWindow
+	}
+
+	if maxRetries <= 0 {
+		maxRetries = defaultMaxRetries
+	}
+
+	return &Queue{
+		sender:     sender,
+		window:     window,
+		maxRetries: maxRetries,
+		backoff:    initialBackoff,
+		events:     make(chan Event, queueCapacity),
+		stop:       make(chan struct{}),
+	}
+}
+
+// NewQueueFromConfig creates a webhook queue from the notifications config, or nil when no webhook is set.
+func NewQueueFromConfig(cfg *config.Config) *Queue {
+	if cfg.Notifications.WebhookURL == "" {
+		return nil
+	}
+
+	window := time.Duration(cfg.Notifications.BatchWindowSeconds) * time.Second
+
+	return NewQueue(NewWebhookSender(cfg.Notifications.WebhookURL), window, cfg.Notifications.MaxRetries)
+}
+
+// Start begins delivering queued events in the background.
+func (q *Queue) Start() {
+	q.wg.Add(1)
+
+	go q.run()
+}
+
+// Stop delivers any pending events and waits for the queue to drain.
+func (q *Queue) Stop() {
+	q.stopOnce.Do(func() { close(q.stop) })
+	q.wg.Wait()
+}
+
+// Enqueue adds an event without blocking; the event is dropped if the queue is full.
+func (q *Queue) Enqueue(event Event) {
+	select {
+	case q.events <- event:
+	default:
+		log.Printf("⚠️ Notification queue full, dropping %s event for post %d", event.Type, event.PostID)
+	}
+}
+
+func (q *Queue) run() {
+	defer q.wg.Done()
+
+	for {
+		select {
+		case event := <-q.events:
+			q.deliver(q.collect(event))
+		case <-q.stop:
+			q.deliver(q.drain(nil))
+			return
+		}
+	}
+}
+
+// collect gathers events arriving within the batch window after the first one.
+func (q *Queue) collect(first Event) []Event {
+	batch := []Event{first}
+
+	timer := time.NewTimer(q.window)
+	defer timer.Stop()
+
+	for {
+		select {
+		case event := <-q.events:
+			batch = append(batch, event)
+		case <-timer.C:
+			return batch
+		case <-q.stop:
+			return q.drain(batch)
+		}
+	}
+}
+
+// drain appends every event already waiting in the channel.
+func (q *Queue) drain(batch []Event) []Event {
+	for {
+		select {
+		case event := <-q.events:
+			batch = append(batch, event)
+		default:
+			return batch
+		}
+	}
+}
+
+// deliver sends a batch, retrying with exponential backoff.
+func (q *Queue) deliver(batch []Event) {
+	if len(batch) == 0 {
+		return
+	}
+
+	backoff := q.backoff
+
+	for attempt := 0; ; attempt++ {
+		err := q.sender.Send(context.Background(), batch)
+		if err == nil {
+			return
+		}
+
+		if attempt >= q.maxRetries {
+			log.Printf("❌ Dropping %d notification(s) after %d attempts: %v", len(batch), attempt+1, err)
+			return
+		}
+
+		log.Printf("⚠️ Notification delivery failed, retrying in %v: %v", backoff, err)
+		time.Sleep(backoff)
+
+		backoff *= 2
+	}
+}
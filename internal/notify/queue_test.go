@@ -0,0 +1,187 @@
+package notify
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"PostedIn/internal/config"
+	"PostedIn/internal/models"
+)
+
+// fakeSender records delivered batches, failing the first failures calls.
+type fakeSender struct {
+	mu       sync.Mutex
+	batches  [][]Event
+	calls    int
+	failures int
+}
+
+func (f *fakeSender) Send(_ context.Context, events []Event) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.calls++
+	if f.calls <= f.failures {
+		return errors.New("receiver unavailable")
+	}
+
+	f.batches = append(f.batches, append([]Event(nil), events...))
+
+	return nil
+}
+
+func (f *fakeSender) snapshot() (int, [][]Event) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return f.calls, f.batches
+}
+
+func newTestQueue(sender Sender, window time.Duration, maxRetries int) *Queue {
+	q := NewQueue(sender, window, maxRetries)
+	q.backoff = time.Millisecond
+
+	return q
+}
+
+func TestQueueBatchesRapidEvents(t *testing.T) {
+	sender := &fakeSender{}
+	q := newTestQueue(sender, 200*time.Millisecond, 1)
+	q.Start()
+
+	for id := 1; id <= 5; id++ {
+		q.Enqueue(Event{Type: EventPublished, PostID: id})
+	}
+
+	deadline := time.Now().Add(2 * time.Second)
+	for {
+		if _, batches := sender.snapshot(); len(batches) > 0 || time.Now().After(deadline) {
+			break
+		}
+
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	q.Stop()
+
+	calls, batches := sender.snapshot()
+	if calls != 1 || len(batches) != 1 {
+		t.Fatalf("delivered %d batches in %d calls, want a single delivery", len(batches), calls)
+	}
+
+	if len(batches[0]) != 5 {
+		t.Fatalf("batch holds %d events, want 5", len(batches[0]))
+	}
+
+	for i, event := range batches[0] {
+		if event.PostID != i+1 {
+			t.Errorf("event %d is for post %d, want enqueue order", i, event.PostID)
+		}
+	}
+}
+
+func TestQueueRetriesFailedDelivery(t *testing.T) {
+	sender := &fakeSender{failures: 2}
+	q := newTestQueue(sender, time.Hour, 3)
+	q.Start()
+
+	q.Enqueue(Event{Type: EventFailed, PostID: 1})
+	q.Stop()
+
+	calls, batches := sender.snapshot()
+	if calls != 3 {
+		t.Errorf("Send called %d times, want 2 failures and a success", calls)
+	}
+
+	if len(batches) != 1 || len(batches[0]) != 1 {
+		t.Fatalf("delivered %v, want the batch once", batches)
+	}
+}
+
+func TestQueueGivesUpAfterMaxRetries(t *testing.T) {
+	sender := &fakeSender{failures: 100}
+	q := newTestQueue(sender, time.Hour, 2)
+	q.Start()
+
+	q.Enqueue(Event{Type: EventPublished, PostID: 1})
+	q.Stop()
+
+	if calls, batches := sender.snapshot(); calls != 3 || len(batches) != 0 {
+		t.Errorf("Send called %d times with %d deliveries, want 3 failed attempts", calls, len(batches))
+	}
+}
+
+func TestQueueStopDeliversPending(t *testing.T) {
+	sender := &fakeSender{}
+	q := newTestQueue(sender, time.Hour, 1)
+
+	q.Enqueue(Event{Type: EventPublished, PostID: 1})
+	q.Enqueue(Event{Type: EventPublished, PostID: 2})
+	q.Start()
+	q.Stop()
+	q.Stop()
+
+	if _, batches := sender.snapshot(); len(batches) != 1 || len(batches[0]) != 2 {
+		t.Fatalf("delivered %v on stop, want both pending events", batches)
+	}
+}
+
+func TestWebhookSender(t *testing.T) {
+	var received struct {
+		Events []Event `json:"events"`
+	}
+
+	status := http.StatusOK
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Content-Type") != "application/json" {
+			t.Errorf("Content-Type = %q", r.Header.Get("Content-Type"))
+		}
+
+		if err := json.NewDecoder(r.Body).Decode(&received); err != nil {
+			t.Errorf("body is not JSON: %v", err)
+		}
+
+		w.WriteHeader(status)
+	}))
+	defer server.Close()
+
+	sender := NewWebhookSender(server.URL)
+	events := []Event{NewPublishEvent(models.Post{ID: 4, Status: models.StatusFailed}, errors.New("token expired"))}
+
+	if err := sender.Send(context.Background(), events); err != nil {
+		t.Fatalf("Send: %v", err)
+	}
+
+	if len(received.Events) != 1 || received.Events[0].Type != EventFailed || received.Events[0].Error != "token expired" {
+		t.Errorf("received %+v", received.Events)
+	}
+
+	status = http.StatusBadGateway
+	if err := sender.Send(context.Background(), events); err == nil {
+		t.Error("Send ignored a non-2xx response")
+	}
+}
+
+func TestNewQueueFromConfig(t *testing.T) {
+	cfg := &config.Config{}
+	if NewQueueFromConfig(cfg) != nil {
+		t.Error("queue created without a webhook URL")
+	}
+
+	cfg.Notifications.WebhookURL = "https://example.com/hook"
+
+	q := NewQueueFromConfig(cfg)
+	if q == nil {
+		t.Fatal("no queue for a configured webhook")
+	}
+
+	if q.window != defaultBatchWindow || q.maxRetries != defaultMaxRetries {
+		t.Errorf("window %v, retries %d, want the defaults", q.window, q.maxRetries)
+	}
+}
@@ -0,0 +1,165 @@
+// Package ratelimit bounds how many publishes run at once and how many start per minute,
+// both globally and for each LinkedIn account.
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// window is the rolling period PerMinute is counted over.
+const window = time.Minute
+
+// Policy limits publishes; zero values mean unlimited.
+type Policy struct {
+	MaxConcurrent int `json:"max_concurrent,omitempty"` // Publishes in flight at once
+	PerMinute     int `json:"per_minute,omitempty"`     // Publishes started in any rolling minute
+}
+
+// Validate checks the policy has no negative limits.
+func (p Policy) Validate() error {
+	if p.MaxConcurrent < 0 || p.PerMinute < 0 {
+		return fmt.Errorf("max_concurrent and per_minute must be 0 (unlimited) or positive")
+	}
+
+	return nil
+}
+
+// limiter enforces one policy.
+type limiter struct {
+	mu      sync.Mutex
+	active  int
+	starts  []time.Time   // Start times within the last window, oldest first
+	changed chan struct{} // Closed and replaced whenever a slot is released
+}
+
+func newLimiter() *limiter {
+	return &limiter{changed: make(chan struct{})}
+}
+
+// acquire waits until the policy allows another publish or ctx is done.
+func (l *limiter) acquire(ctx context.Context, policy Policy) error {
+	var err error
+
+	for {
+		wait, changed, ok := l.tryAcquire(policy, time.Now())
+		if ok {
+			return nil
+		}
+
+		var (
+			timer   *time.Timer
+			expired <-chan time.Time
+		)
+
+		if wait > 0 {
+			timer = time.NewTimer(wait)
+			expired = timer.C
+		}
+
+		select {
+		case <-ctx.Done():
+			err = ctx.Err()
+		case <-changed:
+		case <-expired:
+		}
+
+		if timer != nil {
+			timer.Stop()
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// tryAcquire takes a slot when the policy allows it. Otherwise it returns how long until the
+// rate window frees a slot (0 when only a release can) and a channel closed on the next release.
+func (l *limiter) tryAcquire(policy Policy, now time.Time) (time.Duration, <-chan struct{}, bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	for len(l.starts) > 0 && now.Sub(l.starts[0]) >= window {
+		l.starts = l.starts[1:]
+	}
+
+	var wait time.Duration
+	if policy.PerMinute > 0 && len(l.starts) >= policy.PerMinute {
+		wait = l.starts[len(l.starts)-policy.PerMinute].Add(window).Sub(now)
+	}
+
+	busy := policy.MaxConcurrent > 0 && l.active >= policy.MaxConcurrent
+	if wait > 0 || busy {
+		return wait, l.changed, false
+	}
+
+	l.active++
+	l.starts = append(l.starts, now)
+
+	return 0, nil, true
+}
+
+// release frees a concurrency slot and wakes waiters.
+func (l *limiter) release() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.active--
+	close(l.changed)
+	l.changed = make(chan struct{})
+}
+
+// Layered enforces a global policy over all publishes and a policy per account, so a busy
+// account is held back by its own limit before it can take global slots from the others.
+type Layered struct {
+	global *limiter
+
+	mu       sync.Mutex
+	accounts map[string]*limiter
+}
+
+// NewLayered creates a limiter with no publishes in flight.
+func NewLayered() *Layered {
+	return &Layered{global: newLimiter(), accounts: make(map[string]*limiter)}
+}
+
+// Acquire waits for a slot under the account's policy and then under the global policy.
+// The returned function releases both slots and must be called once the publish ends.
+func (l *Layered) Acquire(ctx context.Context, account string, global, perAccount Policy) (func(), error) {
+	accountLimiter := l.account(account)
+
+	if err := accountLimiter.acquire(ctx, perAccount); err != nil {
+		return nil, fmt.Errorf("waiting for account %s publish slot: %w", account, err)
+	}
+
+	if err := l.global.acquire(ctx, global); err != nil {
+		accountLimiter.release()
+		return nil, fmt.Errorf("waiting for global publish slot: %w", err)
+	}
+
+	var once sync.Once
+
+	return func() {
+		once.Do(func() {
+			l.global.release()
+			accountLimiter.release()
+		})
+	}, nil
+}
+
+// account returns the limiter of an account, creating it on first use.
+func (l *Layered) account(account string) *limiter {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	limiter, ok := l.accounts[account]
+	if !ok {
+		limiter = newLimiter()
+		l.accounts[account] = limiter
+	}
+
+	return limiter
+}
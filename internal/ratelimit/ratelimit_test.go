@@ -0,0 +1,171 @@
+package ratelimit
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestPolicyValidate(t *testing.T) {
+	for _, policy := range []Policy{{}, {MaxConcurrent: 2, PerMinute: 10}} {
+		if err := policy.Validate(); err != nil {
+			t.Errorf("Validate(%+v): %v", policy, err)
+		}
+	}
+
+	for _, policy := range []Policy{{MaxConcurrent: -1}, {PerMinute: -1}} {
+		if err := policy.Validate(); err == nil {
+			t.Errorf("Validate(%+v) accepted a negative limit", policy)
+		}
+	}
+}
+
+func TestPerAccountLimitsAreIndependent(t *testing.T) {
+	limits := NewLayered()
+	perAccount := Policy{MaxConcurrent: 1}
+
+	releaseA, err := limits.Acquire(context.Background(), "a", Policy{}, perAccount)
+	if err != nil {
+		t.Fatalf("Acquire(a): %v", err)
+	}
+
+	// A busy account does not hold back another one
+	releaseB, err := limits.Acquire(context.Background(), "b", Policy{}, perAccount)
+	if err != nil {
+		t.Fatalf("Acquire(b) while a is busy: %v", err)
+	}
+
+	defer releaseB()
+
+	// But it is held to its own limit
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	if _, err := limits.Acquire(ctx, "a", Policy{}, perAccount); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("second Acquire(a) = %v, want it to wait past the deadline", err)
+	}
+
+	acquired := make(chan error, 1)
+
+	go func() {
+		release, err := limits.Acquire(context.Background(), "a", Policy{}, perAccount)
+		if err == nil {
+			release()
+		}
+		acquired <- err
+	}()
+
+	releaseA()
+	releaseA() // Releasing twice must not free a second slot
+
+	select {
+	case err := <-acquired:
+		if err != nil {
+			t.Fatalf("Acquire(a) after release: %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("Acquire(a) did not wake up on release")
+	}
+}
+
+func TestGlobalLimitBoundsConcurrency(t *testing.T) {
+	limits := NewLayered()
+	global := Policy{MaxConcurrent: 2}
+
+	var (
+		wg              sync.WaitGroup
+		active, maxSeen atomic.Int32
+	)
+
+	for i := range 12 {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			release, err := limits.Acquire(context.Background(), fmt.Sprintf("account-%d", i%4), global, Policy{MaxConcurrent: 3})
+			if err != nil {
+				t.Errorf("Acquire: %v", err)
+				return
+			}
+
+			defer release()
+
+			n := active.Add(1)
+			for {
+				seen := maxSeen.Load()
+				if n <= seen || maxSeen.CompareAndSwap(seen, n) {
+					break
+				}
+			}
+
+			time.Sleep(10 * time.Millisecond)
+			active.Add(-1)
+		}()
+	}
+
+	wg.Wait()
+
+	if got := maxSeen.Load(); got < 1 || got > 2 {
+		t.Errorf("%d publishes ran at once, want at most the global 2", got)
+	}
+}
+
+func TestGlobalWaitReleasesAccountSlot(t *testing.T) {
+	limits := NewLayered()
+	global := Policy{MaxConcurrent: 1}
+	perAccount := Policy{MaxConcurrent: 1}
+
+	release, err := limits.Acquire(context.Background(), "a", global, perAccount)
+	if err != nil {
+		t.Fatalf("Acquire(a): %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	if _, err := limits.Acquire(ctx, "b", global, perAccount); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("Acquire(b) = %v, want it to wait for the global slot", err)
+	}
+
+	release()
+
+	// b gave its account slot back when the global wait failed
+	releaseB, err := limits.Acquire(context.Background(), "b", global, perAccount)
+	if err != nil {
+		t.Fatalf("Acquire(b) after release: %v", err)
+	}
+
+	releaseB()
+}
+
+func TestPerMinuteWindow(t *testing.T) {
+	l := newLimiter()
+	policy := Policy{PerMinute: 2}
+	start := time.Date(2026, 1, 1, 9, 0, 0, 0, time.UTC)
+
+	for i := range 2 {
+		if _, _, ok := l.tryAcquire(policy, start.Add(time.Duration(i)*10*time.Second)); !ok {
+			t.Fatalf("start %d was refused", i+1)
+		}
+
+		l.release()
+	}
+
+	wait, _, ok := l.tryAcquire(policy, start.Add(30*time.Second))
+	if ok {
+		t.Fatal("a third start within the minute was allowed")
+	}
+
+	if wait != 30*time.Second {
+		t.Errorf("wait = %v, want 30s until the first start leaves the window", wait)
+	}
+
+	if _, _, ok := l.tryAcquire(policy, start.Add(time.Minute)); !ok {
+		t.Error("start was refused once the first left the window")
+	}
+}
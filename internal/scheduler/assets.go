@@ -0,0 +1,43 @@
+package scheduler
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"PostedIn/internal/assets"
+	"PostedIn/internal/config"
+)
+
+// uploadFunc uploads a local media file for owner and returns its URN.
+type uploadFunc func(ctx context.Context, owner, path string) (string, error)
+
+// uploadCached returns the URN of an earlier upload of the same file by the same owner when it is
+// still within the configured asset cache lifetime, and otherwise uploads the file and caches its URN.
+func uploadCached(ctx context.Context, cfg *config.Config, owner, path string, upload uploadFunc) (string, error) {
+	cacheFile, ttl := cfg.Storage.AssetCache()
+	if ttl == 0 {
+		return upload(ctx, owner, path)
+	}
+
+	key, err := assets.Key(owner, path)
+	if err != nil {
+		return upload(ctx, owner, path)
+	}
+
+	if urn, ok := assets.Lookup(cacheFile, key, ttl, time.Now()); ok {
+		log.Printf("♻️ Reusing %s uploaded earlier for %s", urn, path)
+		return urn, nil
+	}
+
+	urn, err := upload(ctx, owner, path)
+	if err != nil {
+		return "", err
+	}
+
+	if err := assets.Store(cacheFile, key, urn, ttl, time.Now()); err != nil {
+		log.Printf("⚠️ Failed to cache uploaded asset %s: %v", urn, err)
+	}
+
+	return urn, nil
+}
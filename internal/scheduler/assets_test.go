@@ -0,0 +1,83 @@
+package scheduler
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"PostedIn/internal/config"
+)
+
+// countingUpload returns an upload function handing out a new URN per call, and its call count.
+func countingUpload() (uploadFunc, *int) {
+	calls := 0
+
+	return func(_ context.Context, _, _ string) (string, error) {
+		calls++
+		return fmt.Sprintf("urn:li:image:%d", calls), nil
+	}, &calls
+}
+
+func TestUploadCachedReusesURN(t *testing.T) {
+	dir := t.TempDir()
+
+	image := filepath.Join(dir, "campaign.png")
+	if err := os.WriteFile(image, []byte("pixels"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	cfg := testConfig()
+	cfg.Storage.AssetCacheFile = filepath.Join(dir, "asset_cache.json")
+
+	upload, calls := countingUpload()
+	ctx := context.Background()
+
+	first, err := uploadCached(ctx, cfg, "urn:li:person:a", image, upload)
+	if err != nil {
+		t.Fatalf("first upload: %v", err)
+	}
+
+	second, err := uploadCached(ctx, cfg, "urn:li:person:a", image, upload)
+	if err != nil {
+		t.Fatalf("second upload: %v", err)
+	}
+
+	if *calls != 1 || second != first {
+		t.Errorf("second post got %q after %d uploads, want %q from a single upload", second, *calls, first)
+	}
+
+	// An image uploaded by another author cannot be referenced, so it is uploaded again
+	if other, _ := uploadCached(ctx, cfg, "urn:li:organization:1", image, upload); other == first || *calls != 2 {
+		t.Errorf("other owner got %q after %d uploads, want a new upload", other, *calls)
+	}
+}
+
+func TestUploadCachedDisabled(t *testing.T) {
+	dir := t.TempDir()
+
+	image := filepath.Join(dir, "campaign.png")
+	if err := os.WriteFile(image, []byte("pixels"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	cfg := testConfig()
+	cfg.Storage = config.StorageConfig{AssetCacheFile: filepath.Join(dir, "asset_cache.json"), AssetCacheHours: -1}
+
+	upload, calls := countingUpload()
+
+	for range 2 {
+		if _, err := uploadCached(context.Background(), cfg, "urn:li:person:a", image, upload); err != nil {
+			t.Fatalf("uploadCached: %v", err)
+		}
+	}
+
+	if *calls != 2 {
+		t.Errorf("%d uploads with the cache disabled, want 2", *calls)
+	}
+
+	if _, err := os.Stat(cfg.Storage.AssetCacheFile); !os.IsNotExist(err) {
+		t.Errorf("cache file written with the cache disabled: %v", err)
+	}
+}
@@ -0,0 +1,33 @@
+package scheduler
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"PostedIn/internal/models"
+	"PostedIn/pkg/linkedin"
+)
+
+func TestNormalizePostChecksAudience(t *testing.T) {
+	cfg := testConfig()
+	cfg.Audiences = map[string]linkedin.Audience{
+		"bay-area": {GeoLocations: []string{"urn:li:geo:90000084"}},
+	}
+
+	post := models.Post{Content: "targeted", ScheduledAt: time.Now().Add(time.Hour), Audience: "bay-area"}
+	if err := NormalizePost(&post, cfg); err != nil {
+		t.Fatalf("NormalizePost with a configured audience: %v", err)
+	}
+
+	post.Audience = "nowhere"
+
+	err := NormalizePost(&post, cfg)
+	if err == nil || !strings.Contains(err.Error(), `unknown audience "nowhere"`) {
+		t.Fatalf("NormalizePost with an unknown audience = %v, want it rejected", err)
+	}
+
+	if !strings.Contains(err.Error(), "bay-area") {
+		t.Errorf("error %q does not list the configured audiences", err)
+	}
+}
@@ -0,0 +1,198 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"PostedIn/internal/config"
+	"PostedIn/internal/models"
+	"PostedIn/pkg/linkedin"
+
+	"golang.org/x/oauth2"
+)
+
+// useTempConfigPath points SaveConfig at a temporary file for the test.
+func useTempConfigPath(t *testing.T) {
+	t.Helper()
+
+	config.SetConfigPath(filepath.Join(t.TempDir(), "config.json"))
+	t.Cleanup(func() { config.SetConfigPath("") })
+}
+
+func TestRememberAuthor(t *testing.T) {
+	const cached, accepted = "urn:li:person:cached", "urn:li:member:42"
+
+	tests := []struct {
+		name string
+		err  error
+		want string
+	}{
+		{name: "accepted", err: nil, want: accepted},
+		{name: "forbidden", err: fmt.Errorf("no working author URN format found: %w", &linkedin.APIError{StatusCode: http.StatusForbidden}), want: ""},
+		{name: "unprocessable", err: &linkedin.APIError{StatusCode: http.StatusUnprocessableEntity}, want: ""},
+		{name: "server error keeps the cache", err: &linkedin.APIError{StatusCode: http.StatusInternalServerError}, want: cached},
+		{name: "network error keeps the cache", err: errors.New("connection reset"), want: cached},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			useTempConfigPath(t)
+
+			cfg := testConfig()
+			cfg.LinkedIn.AuthorURN = cached
+
+			account, err := cfg.ForAccount("")
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			author := ""
+			if tt.err == nil {
+				author = accepted
+			}
+
+			rememberAuthor(cfg, "", account, author, tt.err)
+
+			if cfg.LinkedIn.AuthorURN != tt.want {
+				t.Errorf("cached author URN = %q, want %q", cfg.LinkedIn.AuthorURN, tt.want)
+			}
+		})
+	}
+}
+
+// probingLinkedIn is a fake LinkedIn API that answers the profile probe with member abc and accepts every post.
+func probingLinkedIn() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet {
+			_, _ = io.WriteString(w, `{"sub": "abc"}`)
+			return
+		}
+
+		_, _ = io.Copy(io.Discard, r.Body)
+		w.Header().Set("x-restli-id", "urn:li:share:42")
+		w.WriteHeader(http.StatusCreated)
+	})
+}
+
+// publishConcurrently publishes the posts at once while another goroutine reads the config the way
+// the API handlers do, so -race flags unguarded writes to the shared config.
+func publishConcurrently(t *testing.T, s *Scheduler, cfg *config.Config, posts []models.Post) {
+	t.Helper()
+
+	var wg sync.WaitGroup
+
+	for _, post := range posts {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			if err := s.PublishToLinkedIn(context.Background(), post.ID, cfg); err != nil {
+				t.Errorf("publish post %d: %v", post.ID, err)
+			}
+		}()
+	}
+
+	done := make(chan struct{})
+
+	wg.Add(1)
+
+	go func() {
+		defer wg.Done()
+
+		for {
+			select {
+			case <-done:
+				return
+			default:
+			}
+
+			for _, name := range cfg.AccountNames() {
+				_, _ = cfg.ForAccount(name)
+			}
+		}
+	}()
+
+	for _, post := range posts {
+		for findByID(t, s, post.ID).Status != models.StatusPosted && !t.Failed() {
+			time.Sleep(5 * time.Millisecond)
+		}
+	}
+
+	close(done)
+	wg.Wait()
+}
+
+func TestConcurrentPublishesCacheAuthor(t *testing.T) {
+	useTempConfigPath(t)
+
+	cfg := useFakeLinkedIn(t, probingLinkedIn())
+	cfg.LinkedIn.AuthorURN = ""
+
+	s := newTestScheduler(t)
+	posts := []models.Post{mustAdd(t, s, "first"), mustAdd(t, s, "second")}
+
+	publishConcurrently(t, s, cfg, posts)
+
+	if view, _ := cfg.ForAccount(""); view.LinkedIn.AuthorURN != "urn:li:person:abc" {
+		t.Errorf("cached author URN = %q, want the accepted one", view.LinkedIn.AuthorURN)
+	}
+
+	saved, err := config.ReadConfig()
+	if err != nil {
+		t.Fatalf("ReadConfig: %v", err)
+	}
+
+	if saved.LinkedIn.AuthorURN != "urn:li:person:abc" {
+		t.Errorf("saved author URN = %q, want the accepted one", saved.LinkedIn.AuthorURN)
+	}
+}
+
+func TestConcurrentPublishesCacheNamedAccountAuthor(t *testing.T) {
+	useTempConfigPath(t)
+
+	cfg := useFakeLinkedIn(t, probingLinkedIn())
+	cfg.LinkedIn.AuthorURN = ""
+
+	workToken := filepath.Join(t.TempDir(), "work-token.json")
+	if err := config.SaveToken(&oauth2.Token{AccessToken: "work", TokenType: "Bearer", Expiry: time.Now().Add(time.Hour)}, workToken); err != nil {
+		t.Fatalf("SaveToken: %v", err)
+	}
+
+	cfg.Accounts = map[string]config.AccountConfig{
+		"work":  {TokenFile: workToken},
+		"other": {TokenFile: filepath.Join(t.TempDir(), "other-token.json")},
+	}
+
+	s := newTestScheduler(t)
+
+	var posts []models.Post
+
+	for _, account := range []string{"work", "work", "", ""} {
+		post, err := s.Add(models.Post{Content: "as " + account, ScheduledAt: time.Now().Add(time.Hour), Account: account}, cfg)
+		if err != nil {
+			t.Fatalf("Add: %v", err)
+		}
+
+		posts = append(posts, post)
+	}
+
+	publishConcurrently(t, s, cfg, posts)
+
+	for _, name := range []string{"work", config.DefaultAccount} {
+		if view, _ := cfg.ForAccount(name); view.LinkedIn.AuthorURN != "urn:li:person:abc" {
+			t.Errorf("%s: cached author URN = %q, want the accepted one", name, view.LinkedIn.AuthorURN)
+		}
+	}
+
+	if other := cfg.Accounts["other"]; other.AuthorURN != "" {
+		t.Errorf("unused account cached author URN %q", other.AuthorURN)
+	}
+}
@@ -0,0 +1,122 @@
+package scheduler
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"PostedIn/internal/config"
+	"PostedIn/internal/models"
+)
+
+// BackupVersion is the format version written to backups; Restore rejects newer ones.
+const BackupVersion = 1
+
+// ErrInvalidBackup is returned when a backup cannot be restored; nothing is restored then.
+var ErrInvalidBackup = errors.New("invalid backup")
+
+// Backup is a snapshot of every post, with the settings needed to read it back.
+type Backup struct {
+	Version   int           `json:"version"`
+	CreatedAt time.Time     `json:"created_at"`
+	Timezone  string        `json:"timezone"` // Configured timezone the posts were scheduled in
+	NextID    int           `json:"next_id"`
+	Posts     []models.Post `json:"posts"`
+}
+
+// Backup writes a timestamped snapshot of every post as JSON.
+func (s *Scheduler) Backup(w io.Writer, cfg *config.Config) error {
+	s.mu.RLock()
+	backup := Backup{
+		Version:   BackupVersion,
+		CreatedAt: time.Now().UTC(),
+		Timezone:  cfg.Timezone.Location,
+		NextID:    s.nextID,
+		Posts:     clonePosts(s.Posts),
+	}
+	s.mu.RUnlock()
+
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+
+	if err := encoder.Encode(backup); err != nil {
+		return fmt.Errorf("failed to write backup: %w", err)
+	}
+
+	return nil
+}
+
+// Restore adds the posts of a backup to the scheduler and returns them. It is all or nothing: a
+// backup that does not parse, or holds a post whose ID is missing, repeated or already in use, whose
+// status is unknown, that depends on a post neither restored nor stored, or that fails validation
+// like a new post, restores no post, and neither does a failed save. Posts that posted or failed are
+// history and skip validation, as their event or media may be long gone.
+func (s *Scheduler) Restore(r io.Reader, cfg *config.Config) ([]models.Post, error) {
+	var backup Backup
+	if err := json.NewDecoder(r).Decode(&backup); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidBackup, err)
+	}
+
+	if backup.Version < 1 || backup.Version > BackupVersion {
+		return nil, fmt.Errorf("%w: unsupported version %d", ErrInvalidBackup, backup.Version)
+	}
+
+	for i := range backup.Posts {
+		post := &backup.Posts[i]
+
+		switch post.Status {
+		case models.StatusPosted, models.StatusFailed:
+			continue
+		case models.StatusScheduled, models.StatusWaiting, models.StatusNeedsReview, models.StatusDraft:
+		default:
+			return nil, fmt.Errorf("%w: post %d has unknown status %q", ErrInvalidBackup, post.ID, post.Status)
+		}
+
+		if err := NormalizePost(post, cfg); err != nil {
+			return nil, fmt.Errorf("%w: post %d: %v", ErrInvalidBackup, post.ID, err)
+		}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	taken := make(map[int]bool, len(s.Posts)+len(backup.Posts))
+	for _, post := range s.Posts {
+		taken[post.ID] = true
+	}
+
+	nextID := max(s.nextID, backup.NextID)
+
+	for _, post := range backup.Posts {
+		switch {
+		case post.ID <= 0:
+			return nil, fmt.Errorf("%w: post without an ID", ErrInvalidBackup)
+		case taken[post.ID]:
+			return nil, fmt.Errorf("%w: post ID %d is already in use", ErrInvalidBackup, post.ID)
+		}
+
+		taken[post.ID] = true
+		nextID = max(nextID, post.ID+1)
+	}
+
+	// Dependencies are checked once every ID is known, as a post may depend on a later one
+	for _, post := range backup.Posts {
+		if post.DependsOn != 0 && !taken[post.DependsOn] {
+			return nil, fmt.Errorf("%w: post %d depends on post %d, which is not found", ErrInvalidBackup, post.ID, post.DependsOn)
+		}
+	}
+
+	previous, previousID := s.Posts, s.nextID
+
+	s.Posts = append(clonePosts(s.Posts), backup.Posts...)
+	s.nextID = nextID
+
+	if err := s.savePosts(); err != nil {
+		s.Posts, s.nextID = previous, previousID
+		return nil, fmt.Errorf("failed to restore backup: %w", err)
+	}
+
+	return clonePosts(backup.Posts), nil
+}
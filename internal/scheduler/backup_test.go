@@ -0,0 +1,92 @@
+package scheduler
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"PostedIn/internal/models"
+)
+
+// backupOf encodes posts as a backup.
+func backupOf(t *testing.T, posts ...models.Post) []byte {
+	t.Helper()
+
+	data, err := json.Marshal(Backup{Version: BackupVersion, Posts: posts})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return data
+}
+
+func TestRestoreRejectsInvalidPosts(t *testing.T) {
+	at := time.Now().Add(time.Hour).UTC()
+	valid := models.Post{ID: 10, Content: "valid", ScheduledAt: at, Status: models.StatusScheduled}
+
+	tests := []struct {
+		name string
+		post models.Post
+		want string
+	}{
+		{"missing dependency", models.Post{ID: 11, Content: "orphan", DependsOn: 99, Status: models.StatusWaiting}, "depends on post 99"},
+		{"unknown status", models.Post{ID: 11, Content: "odd", ScheduledAt: at, Status: "queued"}, `unknown status "queued"`},
+		{"empty content", models.Post{ID: 11, Content: "   ", ScheduledAt: at, Status: models.StatusScheduled}, "content is empty"},
+		{"content too long", models.Post{ID: 11, Content: strings.Repeat("a", 3001), ScheduledAt: at, Status: models.StatusScheduled}, "3001"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "posts.json")
+			s := NewScheduler(path)
+			existing := mustAdd(t, s, "existing")
+
+			_, err := s.Restore(bytes.NewReader(backupOf(t, valid, tt.post)), testConfig())
+			if !errors.Is(err, ErrInvalidBackup) || !strings.Contains(err.Error(), tt.want) {
+				t.Fatalf("Restore error = %v, want ErrInvalidBackup mentioning %q", err, tt.want)
+			}
+
+			for _, store := range []*Scheduler{s, NewScheduler(path)} {
+				posts := store.GetPosts()
+				if len(posts) != 1 || posts[0].ID != existing.ID || posts[0].Content != "existing" {
+					t.Errorf("posts after a rejected restore = %+v, want only post %d", posts, existing.ID)
+				}
+			}
+		})
+	}
+}
+
+func TestRestoreValidatesPendingPosts(t *testing.T) {
+	s := newTestScheduler(t)
+	existing := mustAdd(t, s, "existing")
+	at := time.Now().Add(time.Hour).UTC()
+	postedAt := time.Now().AddDate(0, -1, 0).UTC()
+
+	restored, err := s.Restore(bytes.NewReader(backupOf(t,
+		// A dependent may come before the post it waits for, or depend on a stored post
+		models.Post{ID: 10, Content: "follow-up", DependsOn: 11, OffsetMinutes: 30, Status: models.StatusWaiting},
+		models.Post{ID: 11, Content: "launch", ScheduledAt: at, Status: models.StatusScheduled},
+		models.Post{ID: 12, Content: "reply", DependsOn: existing.ID, Status: models.StatusWaiting},
+		// History is kept as it was, even when it would no longer validate
+		models.Post{ID: 13, Content: "old", ScheduledAt: postedAt, Status: models.StatusPosted, Event: "gone"},
+	)), testConfig())
+	if err != nil {
+		t.Fatalf("Restore: %v", err)
+	}
+
+	if len(restored) != 4 {
+		t.Fatalf("restored %d posts, want 4", len(restored))
+	}
+
+	if launch := findByID(t, s, 11); launch.PostType != models.PostTypeText {
+		t.Errorf("restored post 11 = %+v, want it normalized like a new post", launch)
+	}
+
+	if old := findByID(t, s, 13); old.Event != "gone" || old.PostType != "" {
+		t.Errorf("restored post 13 = %+v, want it kept as it was", old)
+	}
+}
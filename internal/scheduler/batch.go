@@ -0,0 +1,70 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"PostedIn/internal/config"
+	"PostedIn/internal/models"
+	"PostedIn/pkg/linkedin"
+)
+
+// ErrBatchThrottled is returned by WaitBetweenPublishes when LinkedIn asked for a longer wait than
+// a batch publish holds out for; the rest of the batch waits for the next run.
+var ErrBatchThrottled = errors.New("LinkedIn throttled publishing")
+
+const (
+	defaultThrottleWait = time.Minute     // Wait after a 429 without a Retry-After header
+	maxThrottleWait     = 5 * time.Minute // Longest Retry-After a batch publish waits out
+)
+
+// LimitBatch orders due posts most overdue first and splits them at cron.max_publish_per_run,
+// returning the posts to publish in this run and those left for the next one.
+func LimitBatch(due []models.Post, cfg *config.Config) (batch, remaining []models.Post) {
+	sort.SliceStable(due, func(i, j int) bool {
+		return due[i].ScheduledAt.Before(due[j].ScheduledAt)
+	})
+
+	limit := cfg.Cron.MaxPublishPerRun
+	if limit <= 0 || len(due) <= limit {
+		return due, nil
+	}
+
+	return due[:limit], due[limit:]
+}
+
+// WaitBetweenPublishes pauses a batch publish before its next post for cron.publish_interval_seconds,
+// or for LinkedIn's Retry-After when lastErr shows the previous publish was throttled. It returns
+// ErrBatchThrottled when that wait is too long, or the context's error when it is canceled.
+func WaitBetweenPublishes(ctx context.Context, lastErr error, cfg *config.Config) error {
+	wait := cfg.Cron.PublishInterval()
+
+	if retryAfter, throttled := linkedin.RetryAfter(lastErr); throttled {
+		if retryAfter == 0 {
+			retryAfter = defaultThrottleWait
+		}
+
+		if retryAfter > maxThrottleWait {
+			return fmt.Errorf("%w, retry after %v", ErrBatchThrottled, retryAfter)
+		}
+
+		wait = max(wait, retryAfter)
+	}
+
+	if wait <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,138 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+
+	"PostedIn/internal/models"
+	"PostedIn/pkg/linkedin"
+
+	"golang.org/x/oauth2"
+)
+
+func TestLimitBatch(t *testing.T) {
+	now := time.Now()
+	due := []models.Post{
+		{ID: 1, ScheduledAt: now.Add(-time.Minute)},
+		{ID: 2, ScheduledAt: now.Add(-3 * time.Hour)},
+		{ID: 3, ScheduledAt: now.Add(-time.Hour)},
+		{ID: 4, ScheduledAt: now.Add(-2 * time.Hour)},
+	}
+
+	cfg := testConfig()
+	cfg.Cron.MaxPublishPerRun = 2
+
+	batch, remaining := LimitBatch(due, cfg)
+
+	if len(batch) != 2 || batch[0].ID != 2 || batch[1].ID != 4 {
+		t.Errorf("batch = %v, want the two most overdue posts 2 and 4", postIDs(batch))
+	}
+
+	if len(remaining) != 2 || remaining[0].ID != 3 || remaining[1].ID != 1 {
+		t.Errorf("remaining = %v, want 3 and 1 left for the next run", postIDs(remaining))
+	}
+}
+
+func TestLimitBatchWithoutCap(t *testing.T) {
+	due := []models.Post{{ID: 1}, {ID: 2}, {ID: 3}}
+
+	for _, limit := range []int{0, 3, 10} {
+		cfg := testConfig()
+		cfg.Cron.MaxPublishPerRun = limit
+
+		if batch, remaining := LimitBatch(due, cfg); len(batch) != 3 || remaining != nil {
+			t.Errorf("cap %d: batch %v, remaining %v, want every post in one run", limit, postIDs(batch), postIDs(remaining))
+		}
+	}
+}
+
+func postIDs(posts []models.Post) []int {
+	ids := make([]int, 0, len(posts))
+	for _, post := range posts {
+		ids = append(ids, post.ID)
+	}
+
+	return ids
+}
+
+// throttledError is what the client returns for a 429 asking to retry after the given wait.
+func throttledError(t *testing.T, retryAfter time.Duration) error {
+	t.Helper()
+
+	cfg := useFakeLinkedIn(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Retry-After", strconv.Itoa(int(retryAfter.Seconds())))
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+
+	client := linkedin.NewClient(linkedin.NewConfig("id", "secret", "http://localhost/callback"))
+	client.SetToken(&oauth2.Token{AccessToken: "token"})
+
+	err := client.CreatePost(t.Context(), "throttled", linkedin.AuthorPerson, cfg.LinkedIn.AuthorURN)
+	if _, ok := linkedin.RetryAfter(err); !ok {
+		t.Fatalf("CreatePost error = %v, want a 429", err)
+	}
+
+	return err
+}
+
+func TestWaitBetweenPublishes(t *testing.T) {
+	cfg := testConfig()
+
+	if err := WaitBetweenPublishes(t.Context(), nil, cfg); err != nil {
+		t.Errorf("without an interval: %v", err)
+	}
+
+	cfg.Cron.PublishIntervalSeconds = 1
+
+	start := time.Now()
+	if err := WaitBetweenPublishes(t.Context(), nil, cfg); err != nil {
+		t.Fatalf("with an interval: %v", err)
+	}
+
+	if waited := time.Since(start); waited < time.Second {
+		t.Errorf("waited %v, want the 1s interval", waited)
+	}
+
+	ctx, cancel := context.WithCancel(t.Context())
+	cancel()
+
+	if err := WaitBetweenPublishes(ctx, nil, cfg); !errors.Is(err, context.Canceled) {
+		t.Errorf("canceled wait = %v, want context.Canceled", err)
+	}
+
+	if err := WaitBetweenPublishes(t.Context(), throttledError(t, time.Hour), cfg); !errors.Is(err, ErrBatchThrottled) {
+		t.Errorf("after a long Retry-After = %v, want ErrBatchThrottled", err)
+	}
+}
+
+func TestThrottledPublishDefersForRetryAfter(t *testing.T) {
+	cfg := useFakeLinkedIn(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = io.Copy(io.Discard, r.Body)
+		w.Header().Set("Retry-After", "1800")
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+
+	s := newTestScheduler(t)
+	post := mustAdd(t, s, "throttled")
+
+	before := time.Now()
+
+	err := s.PublishToLinkedIn(t.Context(), post.ID, cfg)
+	if !errors.Is(err, ErrPublishDeferred) || !errors.Is(err, linkedin.ErrTooManyRequests) {
+		t.Fatalf("error = %v, want a deferral caused by the 429", err)
+	}
+
+	deferred := findByID(t, s, post.ID)
+	if deferred.Status != models.StatusScheduled || deferred.DeferredCount != 1 {
+		t.Errorf("post is %q after %d deferrals, want scheduled after 1", deferred.Status, deferred.DeferredCount)
+	}
+
+	if deferred.ScheduledAt.Before(before.Add(30 * time.Minute)) {
+		t.Errorf("rescheduled for %v, want at least the 30m Retry-After from %v", deferred.ScheduledAt, before)
+	}
+}
@@ -0,0 +1,285 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"time"
+
+	"PostedIn/internal/config"
+	"PostedIn/internal/models"
+	"PostedIn/internal/timezone"
+	"PostedIn/pkg/linkedin"
+)
+
+// slotWindow is how close to a slot a scheduled post must be to take it.
+const slotWindow = time.Hour
+
+// StatsFetcher returns the current engagement of a published post.
+type StatsFetcher func(ctx context.Context, post models.Post) (linkedin.PostStats, error)
+
+// BestOfSuggestion is a top-performing post proposed for a repost.
+type BestOfSuggestion struct {
+	Post   models.Post `json:"post"`
+	Score  int         `json:"score"`             // The post's best_of.metric
+	SlotAt *time.Time  `json:"slot_at,omitempty"` // Open slot the repost would publish in; nil when none is free
+}
+
+// LinkedInStats returns a StatsFetcher that reads engagement from LinkedIn as each post's account.
+func LinkedInStats(cfg *config.Config) StatsFetcher {
+	return func(ctx context.Context, post models.Post) (linkedin.PostStats, error) {
+		account, err := cfg.ForAccount(post.Account)
+		if err != nil {
+			return linkedin.PostStats{}, err
+		}
+
+		token, err := config.LoadToken(account.Storage.TokenFile)
+		if err != nil {
+			return linkedin.PostStats{}, fmt.Errorf("failed to load LinkedIn token: %w", err)
+		}
+
+		if token == nil {
+			return linkedin.PostStats{}, fmt.Errorf("no LinkedIn authentication token found%s - please authenticate first", accountSuffix(post.Account))
+		}
+
+		client := linkedin.NewClient(linkedin.NewConfig(account.LinkedIn.ClientID, account.LinkedIn.ClientSecret, account.LinkedIn.RedirectURL))
+		client.SetToken(token)
+		client.SetAPIVersion(apiVersion(&post, account))
+
+		return client.GetPostStats(ctx, post.PostURN)
+	}
+}
+
+// RefreshStats fetches the engagement of every post old enough to be reposted and stores it on
+// the post. A post whose fetch fails keeps its previous stats; the errors are joined and returned
+// with the number of posts updated.
+func (s *Scheduler) RefreshStats(ctx context.Context, cfg *config.Config, fetch StatsFetcher) (int, error) {
+	now := bestOfNow(cfg)
+	cutoff := now.Add(-cfg.BestOf.MinAge())
+
+	s.mu.RLock()
+	var targets []models.Post
+
+	for _, post := range s.Posts {
+		if repostable(&post, cutoff) {
+			targets = append(targets, post)
+		}
+	}
+	s.mu.RUnlock()
+
+	fetched := make(map[int]*models.Stats, len(targets))
+
+	var errs []error
+
+	for _, post := range targets {
+		if ctx.Err() != nil {
+			errs = append(errs, ctx.Err())
+			break
+		}
+
+		stats, err := fetch(ctx, post)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("post %d: %w", post.ID, err))
+			continue
+		}
+
+		fetched[post.ID] = &models.Stats{Reactions: stats.Reactions, Comments: stats.Comments, FetchedAt: now.UTC()}
+	}
+
+	if len(fetched) == 0 {
+		return 0, errors.Join(errs...)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for i := range s.Posts {
+		if stats, ok := fetched[s.Posts[i].ID]; ok {
+			s.Posts[i].Stats = stats
+		}
+	}
+
+	if err := s.savePosts(); err != nil {
+		return 0, err
+	}
+
+	return len(fetched), errors.Join(errs...)
+}
+
+// SuggestBestOf ranks the published posts by best_of.metric and returns the top best_of.count
+// that are old enough and not reposted within the cooldown, each with the next open slot.
+func (s *Scheduler) SuggestBestOf(cfg *config.Config) []BestOfSuggestion {
+	now := bestOfNow(cfg)
+	bestOf := cfg.BestOf
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	recent := s.recentReposts(now.Add(-bestOf.Cooldown()))
+	cutoff := now.Add(-bestOf.MinAge())
+
+	var suggestions []BestOfSuggestion
+
+	for _, post := range s.Posts {
+		if !repostable(&post, cutoff) || post.Stats == nil || recent[post.ID] {
+			continue
+		}
+
+		if score := metricScore(post.Stats, bestOf.RankMetric()); score > 0 {
+			suggestions = append(suggestions, BestOfSuggestion{Post: post, Score: score})
+		}
+	}
+
+	// Highest score first; ties go to the older post
+	slices.SortStableFunc(suggestions, func(a, b BestOfSuggestion) int {
+		if a.Score != b.Score {
+			return b.Score - a.Score
+		}
+
+		return a.Post.ID - b.Post.ID
+	})
+
+	suggestions = suggestions[:min(len(suggestions), bestOf.Reposts())]
+
+	slots := s.openSlots(bestOf, now)
+	for i := range suggestions {
+		if i < len(slots) {
+			suggestions[i].SlotAt = &slots[i]
+		}
+	}
+
+	return suggestions
+}
+
+// ScheduleBestOf schedules a repost of every suggestion that has an open slot and returns the
+// reposts added. It stops at the first repost that cannot be added.
+func (s *Scheduler) ScheduleBestOf(cfg *config.Config) ([]models.Post, error) {
+	s.bestOfMu.Lock()
+	defer s.bestOfMu.Unlock()
+
+	var added []models.Post
+
+	for _, suggestion := range s.SuggestBestOf(cfg) {
+		if suggestion.SlotAt == nil {
+			continue
+		}
+
+		repost, err := s.Add(newRepost(suggestion.Post, *suggestion.SlotAt), cfg)
+		if err != nil {
+			return added, fmt.Errorf("failed to repost post %d: %w", suggestion.Post.ID, err)
+		}
+
+		added = append(added, repost)
+	}
+
+	return added, nil
+}
+
+// repostable reports whether the post is an original published no later than cutoff that can be
+// published again; polls and poll results are tied to their vote and are never reposted.
+func repostable(post *models.Post, cutoff time.Time) bool {
+	return post.Status == models.StatusPosted && post.PostURN != "" && post.RepostOf == 0 &&
+		post.ResultsOf == 0 && !post.IsPoll() && post.PublishedAt != nil && !post.PublishedAt.After(cutoff)
+}
+
+// recentReposts returns the IDs of the posts with a repost pending or published since cutoff; the
+// caller holds the lock.
+func (s *Scheduler) recentReposts(cutoff time.Time) map[int]bool {
+	recent := make(map[int]bool)
+
+	for _, post := range s.Posts {
+		switch {
+		case post.RepostOf == 0, post.Status == models.StatusFailed:
+		case post.Status != models.StatusPosted:
+			recent[post.RepostOf] = true
+		case post.PublishedAt != nil && post.PublishedAt.After(cutoff):
+			recent[post.RepostOf] = true
+		}
+	}
+
+	return recent
+}
+
+// openSlots returns the occurrences of the configured weekly slots within the horizon that no
+// scheduled post is within slotWindow of, earliest first; the caller holds the lock.
+func (s *Scheduler) openSlots(bestOf config.BestOfConfig, now time.Time) []time.Time {
+	end := now.Add(bestOf.Horizon())
+
+	var slots []time.Time
+
+	for _, slot := range bestOf.Slots {
+		next, err := timezone.NextWeekly(slot, now)
+		if err != nil {
+			continue // Rejected when the config loads
+		}
+
+		for at := next; at.Before(end); at = at.AddDate(0, 0, 7) {
+			if !slices.ContainsFunc(slots, at.Equal) && !s.slotTaken(at) {
+				slots = append(slots, at)
+			}
+		}
+	}
+
+	slices.SortFunc(slots, func(a, b time.Time) int { return a.Compare(b) })
+
+	return slots
+}
+
+// slotTaken reports whether a scheduled post publishes within slotWindow of at; the caller holds the lock.
+func (s *Scheduler) slotTaken(at time.Time) bool {
+	for _, post := range s.Posts {
+		if post.Status == models.StatusScheduled && post.ScheduledAt.Sub(at).Abs() < slotWindow {
+			return true
+		}
+	}
+
+	return false
+}
+
+// metricScore returns the stats value a metric ranks posts by.
+func metricScore(stats *models.Stats, metric string) int {
+	switch metric {
+	case config.MetricReactions:
+		return stats.Reactions
+	case config.MetricComments:
+		return stats.Comments
+	}
+
+	return stats.Reactions + stats.Comments
+}
+
+// newRepost copies what a post publishes into a new post scheduled at the slot.
+func newRepost(original models.Post, at time.Time) models.Post {
+	return models.Post{
+		Content:         original.Content,
+		ScheduledAt:     at,
+		PostType:        original.PostType,
+		Language:        original.Language,
+		Variants:        maps.Clone(original.Variants),
+		TargetLanguage:  original.TargetLanguage,
+		APIVersion:      original.APIVersion,
+		ImagePath:       original.ImagePath,
+		ImageAltText:    original.ImageAltText,
+		VideoPath:       original.VideoPath,
+		VideoTitle:      original.VideoTitle,
+		Audience:        original.Audience,
+		Tags:            slices.Clone(original.Tags),
+		Account:         original.Account,
+		AuthorType:      original.AuthorType,
+		OrganizationURN: original.OrganizationURN,
+		Visibility:      original.Visibility,
+		RepostOf:        original.ID,
+	}
+}
+
+// bestOfNow returns the current time in the configured timezone, so weekly slots fall on local days.
+func bestOfNow(cfg *config.Config) time.Time {
+	now, err := cfg.Now()
+	if err != nil {
+		return time.Now()
+	}
+
+	return now
+}
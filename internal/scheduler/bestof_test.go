@@ -0,0 +1,263 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"PostedIn/internal/config"
+	"PostedIn/internal/models"
+	"PostedIn/pkg/linkedin"
+)
+
+// bestOfConfig returns a config reposting the top count posts into a weekly 10:00 slot two days out.
+func bestOfConfig(count int) *config.Config {
+	cfg := testConfig()
+	slot := time.Now().UTC().AddDate(0, 0, 2).Weekday().String() + " 10:00"
+	cfg.BestOf = config.BestOfConfig{Count: count, Slots: []string{slot}, HorizonDays: 21}
+
+	return cfg
+}
+
+// addPublished stores a post published daysAgo with the given stats; nil stats means none were fetched.
+func addPublished(t *testing.T, s *Scheduler, daysAgo int, stats *models.Stats) models.Post {
+	t.Helper()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	publishedAt := time.Now().AddDate(0, 0, -daysAgo)
+	post := models.Post{
+		ID:          s.nextID,
+		Content:     fmt.Sprintf("post %d", s.nextID),
+		ScheduledAt: publishedAt,
+		PublishedAt: &publishedAt,
+		Status:      models.StatusPosted,
+		PostType:    models.PostTypeText,
+		PostURN:     fmt.Sprintf("urn:li:share:%d", s.nextID),
+		Stats:       stats,
+	}
+
+	s.Posts = append(s.Posts, post)
+	s.nextID++
+
+	return post
+}
+
+// addRepost stores a repost of original with the status, published or scheduled daysAgo.
+func addRepost(s *Scheduler, original models.Post, status string, daysAgo int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	at := time.Now().AddDate(0, 0, -daysAgo)
+	repost := models.Post{ID: s.nextID, Content: original.Content, ScheduledAt: at, Status: status, RepostOf: original.ID}
+
+	if status == models.StatusPosted {
+		repost.PublishedAt = &at
+	}
+
+	s.Posts = append(s.Posts, repost)
+	s.nextID++
+}
+
+func suggestedIDs(suggestions []BestOfSuggestion) []int {
+	ids := make([]int, 0, len(suggestions))
+	for _, suggestion := range suggestions {
+		ids = append(ids, suggestion.Post.ID)
+	}
+
+	return ids
+}
+
+func TestRefreshStatsStoresMockedAnalytics(t *testing.T) {
+	s := newTestScheduler(t)
+	cfg := bestOfConfig(1)
+
+	old := addPublished(t, s, 40, nil)
+	failing := addPublished(t, s, 50, &models.Stats{Reactions: 7})
+	recent := addPublished(t, s, 3, nil)
+	scheduled := mustAdd(t, s, "upcoming")
+
+	var fetched []int
+
+	fetch := func(_ context.Context, post models.Post) (linkedin.PostStats, error) {
+		fetched = append(fetched, post.ID)
+
+		if post.ID == failing.ID {
+			return linkedin.PostStats{}, errors.New("rate limited")
+		}
+
+		return linkedin.PostStats{Reactions: 12, Comments: 3}, nil
+	}
+
+	updated, err := s.RefreshStats(context.Background(), cfg, fetch)
+	if err == nil || !strings.Contains(err.Error(), fmt.Sprintf("post %d", failing.ID)) {
+		t.Errorf("RefreshStats error = %v, want the failed fetch of post %d", err, failing.ID)
+	}
+
+	if updated != 1 {
+		t.Errorf("updated = %d, want 1", updated)
+	}
+
+	if len(fetched) != 2 {
+		t.Errorf("fetched %v, want only the posts old enough to repost", fetched)
+	}
+
+	stats := make(map[int]*models.Stats)
+	for _, post := range s.GetPosts() {
+		stats[post.ID] = post.Stats
+	}
+
+	if got := stats[old.ID]; got == nil || got.Reactions != 12 || got.Comments != 3 || got.FetchedAt.IsZero() {
+		t.Errorf("post %d stats = %+v, want the fetched analytics", old.ID, got)
+	}
+
+	if got := stats[failing.ID]; got == nil || got.Reactions != 7 {
+		t.Errorf("post %d stats = %+v, want its previous stats kept", failing.ID, got)
+	}
+
+	if stats[recent.ID] != nil || stats[scheduled.ID] != nil {
+		t.Errorf("recent or scheduled posts got stats: %+v, %+v", stats[recent.ID], stats[scheduled.ID])
+	}
+}
+
+func TestSuggestBestOfRanksByMetric(t *testing.T) {
+	s := newTestScheduler(t)
+
+	liked := addPublished(t, s, 40, &models.Stats{Reactions: 50, Comments: 1})
+	discussed := addPublished(t, s, 40, &models.Stats{Reactions: 10, Comments: 30})
+	balanced := addPublished(t, s, 40, &models.Stats{Reactions: 30, Comments: 25})
+	addPublished(t, s, 40, &models.Stats{})              // No engagement
+	addPublished(t, s, 5, &models.Stats{Reactions: 500}) // Too recent
+	addPublished(t, s, 40, nil)                          // Never fetched
+
+	tests := []struct {
+		metric string
+		want   []int
+	}{
+		{"", []int{balanced.ID, liked.ID}},
+		{config.MetricEngagement, []int{balanced.ID, liked.ID}},
+		{config.MetricReactions, []int{liked.ID, balanced.ID}},
+		{config.MetricComments, []int{discussed.ID, balanced.ID}},
+	}
+
+	for _, tt := range tests {
+		cfg := bestOfConfig(2)
+		cfg.BestOf.Metric = tt.metric
+
+		got := suggestedIDs(s.SuggestBestOf(cfg))
+		if fmt.Sprint(got) != fmt.Sprint(tt.want) {
+			t.Errorf("metric %q suggested %v, want %v", tt.metric, got, tt.want)
+		}
+	}
+}
+
+func TestSuggestBestOfSkipsRecentReposts(t *testing.T) {
+	s := newTestScheduler(t)
+	cfg := bestOfConfig(5)
+
+	repostedRecently := addPublished(t, s, 200, &models.Stats{Reactions: 100})
+	pending := addPublished(t, s, 200, &models.Stats{Reactions: 90})
+	repostedLongAgo := addPublished(t, s, 200, &models.Stats{Reactions: 80})
+	repostFailed := addPublished(t, s, 200, &models.Stats{Reactions: 70})
+
+	addRepost(s, repostedRecently, models.StatusPosted, 10)
+	addRepost(s, pending, models.StatusScheduled, -3)
+	addRepost(s, repostedLongAgo, models.StatusPosted, 120)
+	addRepost(s, repostFailed, models.StatusFailed, 5)
+
+	got := suggestedIDs(s.SuggestBestOf(cfg))
+	if want := []int{repostedLongAgo.ID, repostFailed.ID}; fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Errorf("suggested %v, want %v", got, want)
+	}
+}
+
+func TestSuggestBestOfAssignsOpenSlots(t *testing.T) {
+	s := newTestScheduler(t)
+	cfg := bestOfConfig(4)
+
+	for range 4 {
+		addPublished(t, s, 40, &models.Stats{Reactions: 10})
+	}
+
+	first, err := time.Parse("Monday 15:04", cfg.BestOf.Slots[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	slot := time.Now().UTC().AddDate(0, 0, 2)
+	slot = time.Date(slot.Year(), slot.Month(), slot.Day(), first.Hour(), first.Minute(), 0, 0, time.UTC)
+
+	// A post scheduled half an hour after the first occurrence takes that slot
+	if _, err := s.Add(models.Post{Content: "taken", ScheduledAt: slot.Add(30 * time.Minute)}, cfg); err != nil {
+		t.Fatalf("Add: %v", err)
+	}
+
+	suggestions := s.SuggestBestOf(cfg)
+	if len(suggestions) != 4 {
+		t.Fatalf("got %d suggestions, want 4", len(suggestions))
+	}
+
+	// Within 21 days the weekly slot recurs three times, and the first is taken
+	want := []time.Time{slot.AddDate(0, 0, 7), slot.AddDate(0, 0, 14)}
+	for i, suggestion := range suggestions {
+		switch {
+		case i < len(want) && (suggestion.SlotAt == nil || !suggestion.SlotAt.Equal(want[i])):
+			t.Errorf("suggestion %d slot = %v, want %v", i, suggestion.SlotAt, want[i])
+		case i >= len(want) && suggestion.SlotAt != nil:
+			t.Errorf("suggestion %d slot = %v, want none left", i, suggestion.SlotAt)
+		}
+	}
+}
+
+func TestScheduleBestOfDoesNotRepostTwice(t *testing.T) {
+	s := newTestScheduler(t)
+	cfg := bestOfConfig(1)
+
+	top := addPublished(t, s, 40, &models.Stats{Reactions: 20, Comments: 5})
+	second := addPublished(t, s, 60, &models.Stats{Reactions: 15})
+
+	first, err := s.ScheduleBestOf(cfg)
+	if err != nil || len(first) != 1 {
+		t.Fatalf("ScheduleBestOf = %v, %v, want one repost", first, err)
+	}
+
+	repost := first[0]
+	if repost.RepostOf != top.ID || repost.Content != top.Content || repost.Status != models.StatusScheduled {
+		t.Errorf("repost = %+v, want a scheduled copy of post %d", repost, top.ID)
+	}
+
+	// The pending repost takes the first slot and keeps the top post out of the next run
+	again, err := s.ScheduleBestOf(cfg)
+	if err != nil || len(again) != 1 {
+		t.Fatalf("second ScheduleBestOf = %v, %v, want one repost", again, err)
+	}
+
+	if again[0].RepostOf != second.ID {
+		t.Errorf("second run reposted post %d, want %d", again[0].RepostOf, second.ID)
+	}
+
+	if !again[0].ScheduledAt.After(repost.ScheduledAt) {
+		t.Errorf("second repost at %v, want a later slot than %v", again[0].ScheduledAt, repost.ScheduledAt)
+	}
+}
+
+func TestScheduleBestOfWithoutSlots(t *testing.T) {
+	s := newTestScheduler(t)
+	cfg := bestOfConfig(1)
+	cfg.BestOf.Slots = nil
+
+	addPublished(t, s, 40, &models.Stats{Reactions: 20})
+
+	if suggestions := s.SuggestBestOf(cfg); len(suggestions) != 1 || suggestions[0].SlotAt != nil {
+		t.Errorf("suggestions = %+v, want one without a slot", suggestions)
+	}
+
+	reposts, err := s.ScheduleBestOf(cfg)
+	if err != nil || len(reposts) != 0 {
+		t.Errorf("ScheduleBestOf = %v, %v, want nothing scheduled", reposts, err)
+	}
+}
@@ -0,0 +1,52 @@
+package scheduler
+
+import (
+	"sort"
+	"time"
+
+	"PostedIn/internal/models"
+)
+
+const (
+	// dueNowWindow is how long a scheduled post counts as due now before it is reported as overdue.
+	dueNowWindow = 15 * time.Minute
+	// upcomingWindow is how far ahead the dashboard lists upcoming posts.
+	upcomingWindow = 24 * time.Hour
+)
+
+// Dashboard summarizes the posts that need attention today.
+type Dashboard struct {
+	Overdue   []models.Post // Scheduled posts whose time passed more than dueNowWindow ago
+	DueNow    []models.Post // Scheduled posts that just came due
+	Upcoming  []models.Post // Scheduled posts due in the next 24 hours
+	Attention []models.Post // Failed posts and posts held for review
+}
+
+// BuildDashboard groups the posts relative to now; each group is sorted by scheduled time.
+func BuildDashboard(posts []models.Post, now time.Time) Dashboard {
+	var d Dashboard
+
+	for _, post := range posts {
+		switch post.Status {
+		case models.StatusScheduled:
+			switch {
+			case post.ScheduledAt.Before(now.Add(-dueNowWindow)):
+				d.Overdue = append(d.Overdue, post)
+			case !post.ScheduledAt.After(now):
+				d.DueNow = append(d.DueNow, post)
+			case post.ScheduledAt.Before(now.Add(upcomingWindow)):
+				d.Upcoming = append(d.Upcoming, post)
+			}
+		case models.StatusFailed, models.StatusNeedsReview:
+			d.Attention = append(d.Attention, post)
+		}
+	}
+
+	for _, group := range [][]models.Post{d.Overdue, d.DueNow, d.Upcoming, d.Attention} {
+		sort.SliceStable(group, func(i, j int) bool {
+			return group[i].ScheduledAt.Before(group[j].ScheduledAt)
+		})
+	}
+
+	return d
+}
@@ -0,0 +1,52 @@
+package scheduler
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	"PostedIn/internal/models"
+)
+
+func TestBuildDashboard(t *testing.T) {
+	now := time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC)
+	posts := []models.Post{
+		{ID: 1, Status: models.StatusScheduled, ScheduledAt: now.Add(-3 * time.Hour)},
+		{ID: 2, Status: models.StatusScheduled, ScheduledAt: now.Add(-5 * time.Minute)},
+		{ID: 3, Status: models.StatusScheduled, ScheduledAt: now.Add(6 * time.Hour)},
+		{ID: 4, Status: models.StatusScheduled, ScheduledAt: now.Add(2 * time.Hour)},
+		{ID: 5, Status: models.StatusScheduled, ScheduledAt: now.Add(48 * time.Hour)},
+		{ID: 6, Status: models.StatusFailed, ScheduledAt: now.Add(-time.Hour)},
+		{ID: 7, Status: models.StatusNeedsReview, ScheduledAt: now.Add(-2 * time.Hour)},
+		{ID: 8, Status: models.StatusPosted, ScheduledAt: now.Add(-4 * time.Hour)},
+		{ID: 9, Status: models.StatusScheduled, ScheduledAt: now.Add(-20 * time.Minute)},
+		{ID: 10, Status: models.StatusScheduled, ScheduledAt: now},
+	}
+
+	d := BuildDashboard(posts, now)
+
+	groups := []struct {
+		name  string
+		posts []models.Post
+		want  []int
+	}{
+		{"overdue", d.Overdue, []int{1, 9}},
+		{"due now", d.DueNow, []int{2, 10}},
+		{"upcoming", d.Upcoming, []int{4, 3}},
+		{"attention", d.Attention, []int{7, 6}},
+	}
+
+	for _, group := range groups {
+		if got := postIDs(group.posts); !slices.Equal(got, group.want) {
+			t.Errorf("%s = %v, want %v", group.name, got, group.want)
+		}
+	}
+}
+
+func TestBuildDashboardEmpty(t *testing.T) {
+	d := BuildDashboard(nil, time.Now())
+
+	if len(d.Overdue)+len(d.DueNow)+len(d.Upcoming)+len(d.Attention) != 0 {
+		t.Errorf("dashboard of no posts = %+v, want empty groups", d)
+	}
+}
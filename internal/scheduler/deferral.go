@@ -0,0 +1,70 @@
+package scheduler
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"PostedIn/internal/models"
+	"PostedIn/pkg/linkedin"
+)
+
+// ErrPublishDeferred is returned when LinkedIn was unavailable or throttled, the rate limits held the publish
+// back too long or the publish failed with a transient error, and the post was rescheduled for a retry.
+var ErrPublishDeferred = errors.New("publish deferred")
+
+const (
+	deferBaseDelay = 5 * time.Minute
+	deferMaxDelay  = 2 * time.Hour
+	maxDeferrals   = 10
+)
+
+// deferDelay doubles the retry delay with each deferral, capped at deferMaxDelay.
+func deferDelay(deferrals int) time.Duration {
+	delay := deferBaseDelay
+
+	for i := 0; i < deferrals && delay < deferMaxDelay; i++ {
+		delay *= 2
+	}
+
+	return min(delay, deferMaxDelay)
+}
+
+// deferPost pushes the post's schedule back after LinkedIn reported it was unavailable or throttled,
+// or the rate limits did not free a slot in time; reason says which. The delay is at least minDelay,
+// e.g. LinkedIn's Retry-After. It returns false once the post has been deferred too many times and
+// should fail instead.
+func deferPost(post *models.Post, reason string, minDelay time.Duration) bool {
+	if post.DeferredCount >= maxDeferrals {
+		return false
+	}
+
+	delay := max(deferDelay(post.DeferredCount), minDelay)
+	post.DeferredCount++
+	post.ScheduledAt = time.Now().In(post.ScheduledAt.Location()).Add(delay)
+	post.Record(post.Status, "deferred: "+reason)
+
+	log.Printf("⏳ %s, deferring post %d by %v (attempt %d of %d)", reason, post.ID, delay, post.DeferredCount, maxDeferrals)
+
+	return true
+}
+
+// deferReason returns why a failed publish should be retried later, or "" when it should fail.
+func deferReason(publishErr error) string {
+	switch {
+	case errors.Is(publishErr, linkedin.ErrServiceUnavailable):
+		return "LinkedIn unavailable"
+	case errors.Is(publishErr, linkedin.ErrTooManyRequests):
+		return "LinkedIn throttled publishing"
+	case errors.Is(publishErr, ErrRateLimited):
+		return "rate limit reached"
+	}
+
+	return ""
+}
+
+// deferredError describes a deferral while keeping both the deferral and the cause matchable.
+func deferredError(post *models.Post, cause error) error {
+	return fmt.Errorf("%w: post %d retries at %s: %w", ErrPublishDeferred, post.ID, post.ScheduledAt.Format("2006-01-02 15:04 MST"), cause)
+}
@@ -0,0 +1,114 @@
+package scheduler
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"PostedIn/internal/models"
+	"PostedIn/pkg/linkedin"
+)
+
+// maintenanceError is what the client returns for a 503 during a LinkedIn maintenance window.
+func maintenanceError() error {
+	apiErr := &linkedin.APIError{Kind: "API", StatusCode: http.StatusServiceUnavailable, Body: "maintenance"}
+
+	return fmt.Errorf("%w: %w", linkedin.ErrServiceUnavailable, apiErr)
+}
+
+func findByID(t *testing.T, s *Scheduler, id int) models.Post {
+	t.Helper()
+
+	for _, post := range s.GetPosts() {
+		if post.ID == id {
+			return post
+		}
+	}
+
+	t.Fatalf("post %d not found", id)
+
+	return models.Post{}
+}
+
+func TestMaintenanceDefersPublish(t *testing.T) {
+	s := newTestScheduler(t)
+	post := mustAdd(t, s, "during maintenance")
+	cfg := testConfig()
+
+	if _, err := s.beginPublish(post.ID, cfg); err != nil {
+		t.Fatalf("beginPublish: %v", err)
+	}
+
+	before := time.Now()
+	_, _, err := s.finishPublish(post.ID, publishOutcome{}, maintenanceError(), cfg)
+	s.endPublish(post.ID)
+
+	if !errors.Is(err, ErrPublishDeferred) || !errors.Is(err, linkedin.ErrServiceUnavailable) {
+		t.Fatalf("error = %v, want a deferral caused by the 503", err)
+	}
+
+	deferred := findByID(t, s, post.ID)
+
+	if deferred.Status != models.StatusScheduled {
+		t.Errorf("status = %q, want the post kept scheduled", deferred.Status)
+	}
+
+	if deferred.DeferredCount != 1 || deferred.Attempts != 0 {
+		t.Errorf("deferred %d times with %d attempts, want 1 deferral and no failed attempt", deferred.DeferredCount, deferred.Attempts)
+	}
+
+	if retryAt := deferred.ScheduledAt; retryAt.Before(before.Add(deferBaseDelay)) || retryAt.After(time.Now().Add(deferBaseDelay)) {
+		t.Errorf("retry at %v, want %v from now", retryAt, deferBaseDelay)
+	}
+
+	// The retry publishes normally once LinkedIn is back
+	if _, err := s.beginPublish(post.ID, cfg); err != nil {
+		t.Fatalf("beginPublish after deferral: %v", err)
+	}
+}
+
+func TestMaintenanceFailsAfterMaxDeferrals(t *testing.T) {
+	s := newTestScheduler(t)
+	post := mustAdd(t, s, "long outage")
+	cfg := testConfig()
+	cfg.Cron.RetryMaxAttempts = 1
+
+	s.Posts[0].DeferredCount = maxDeferrals
+
+	if _, err := s.beginPublish(post.ID, cfg); err != nil {
+		t.Fatalf("beginPublish: %v", err)
+	}
+
+	_, _, err := s.finishPublish(post.ID, publishOutcome{}, maintenanceError(), cfg)
+	s.endPublish(post.ID)
+
+	if err == nil || errors.Is(err, ErrPublishDeferred) {
+		t.Fatalf("error = %v, want the publish to fail", err)
+	}
+
+	if failed := findByID(t, s, post.ID); failed.Status != models.StatusFailed {
+		t.Errorf("status = %q, want %q", failed.Status, models.StatusFailed)
+	}
+}
+
+func TestDeferDelayBacksOff(t *testing.T) {
+	want := []time.Duration{5 * time.Minute, 10 * time.Minute, 20 * time.Minute, 40 * time.Minute, 80 * time.Minute, deferMaxDelay, deferMaxDelay}
+
+	for deferrals, delay := range want {
+		if got := deferDelay(deferrals); got != delay {
+			t.Errorf("deferDelay(%d) = %v, want %v", deferrals, got, delay)
+		}
+	}
+}
+
+func TestDeferReason(t *testing.T) {
+	if deferReason(maintenanceError()) == "" {
+		t.Error("a 503 is not deferred")
+	}
+
+	if reason := deferReason(&linkedin.APIError{Kind: "API", StatusCode: http.StatusBadRequest}); reason != "" {
+		t.Errorf("a 400 is deferred (%q), want it to fail", reason)
+	}
+}
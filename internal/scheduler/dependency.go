@@ -0,0 +1,142 @@
+package scheduler
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"PostedIn/internal/config"
+	"PostedIn/internal/models"
+)
+
+var (
+	// ErrInvalidDependency is returned when a post references a dependency that cannot be waited on.
+	ErrInvalidDependency = errors.New("invalid dependency")
+	// ErrWaitingDependents is returned when deleting a post that other posts still wait on; nothing is deleted then.
+	ErrWaitingDependents = errors.New("posts are waiting on it")
+)
+
+// OnDependentsReleased registers a callback invoked with the posts that became scheduled
+// because the post they depend on published (or failed under the publish policy), and with
+// the results follow-up scheduled when a poll publishes.
+func (s *Scheduler) OnDependentsReleased(fn func([]models.Post)) {
+	s.releaseHooks = append(s.releaseHooks, fn)
+}
+
+// CheckDependency reports whether a new post's dependency could be waited on, without storing anything.
+func (s *Scheduler) CheckDependency(post models.Post) error {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.resolveDependency(&post)
+}
+
+// resolveDependency validates a new post's dependency and either computes its schedule from an
+// already published dependency or leaves it waiting; the caller holds the lock.
+func (s *Scheduler) resolveDependency(post *models.Post) error {
+	if post.DependsOn == 0 {
+		return nil
+	}
+
+	if post.OffsetMinutes < 0 {
+		return fmt.Errorf("%w: offset_minutes cannot be negative", ErrInvalidDependency)
+	}
+
+	var dependency *models.Post
+
+	for i := range s.Posts {
+		if s.Posts[i].ID == post.DependsOn {
+			dependency = &s.Posts[i]
+			break
+		}
+	}
+
+	if dependency == nil {
+		return fmt.Errorf("%w: post %d not found", ErrInvalidDependency, post.DependsOn)
+	}
+
+	switch dependency.Status {
+	case models.StatusPosted:
+		publishedAt := dependency.ScheduledAt
+		if dependency.PublishedAt != nil {
+			publishedAt = *dependency.PublishedAt
+		}
+
+		post.Status = models.StatusScheduled
+		post.ScheduledAt = publishedAt.Add(time.Duration(post.OffsetMinutes) * time.Minute)
+
+		// A dependency that posted long ago makes the post due now rather than in the past, where
+		// neither a timer nor the sweep would pick it up
+		if now := time.Now().In(post.ScheduledAt.Location()); post.ScheduledAt.Before(now) {
+			post.ScheduledAt = now
+		}
+	case models.StatusFailed:
+		return fmt.Errorf("%w: post %d has failed", ErrInvalidDependency, post.DependsOn)
+	case models.StatusDraft, models.StatusNeedsReview:
+		// Neither publishes on its own, so a dependent would wait until someone acts on it
+		return fmt.Errorf("%w: post %d is %s and would never publish on its own; schedule it first", ErrInvalidDependency, post.DependsOn, dependency.Status)
+	default:
+		post.Status = models.StatusWaiting
+		post.ScheduledAt = time.Time{}
+	}
+
+	return nil
+}
+
+// releaseDependents schedules posts waiting on the given post once it has completed.
+// On success dependents are scheduled relative to the publish time; on failure the
+// configured dependency failure policy decides whether they are skipped or still published.
+// A skipped post fails in turn, so the posts waiting on it are skipped as well.
+func (s *Scheduler) releaseDependents(id int, at time.Time, succeeded bool, failurePolicy string) []models.Post {
+	var released []models.Post
+
+	for i := range s.Posts {
+		post := &s.Posts[i]
+		if post.DependsOn != id || post.Status != models.StatusWaiting {
+			continue
+		}
+
+		if !succeeded && failurePolicy != config.DependencyPublish {
+			post.SetStatus(models.StatusFailed, fmt.Sprintf("skipped because post %d failed", id))
+
+			log.Printf("⏭️ Skipping post %d because post %d failed", post.ID, id)
+
+			released = append(released, s.releaseDependents(post.ID, at, false, failurePolicy)...)
+
+			continue
+		}
+
+		post.ScheduledAt = at.Add(time.Duration(post.OffsetMinutes) * time.Minute)
+		post.SetStatus(models.StatusScheduled, fmt.Sprintf("released by post %d", id))
+		released = append(released, *post)
+
+		log.Printf("🔗 Post %d released by post %d, scheduled for %s", post.ID, id, post.ScheduledAt.Format("2006-01-02 15:04:05 MST"))
+	}
+
+	return released
+}
+
+// waitingDependents returns the IDs of posts still waiting on the given post.
+func (s *Scheduler) waitingDependents(id int) []int {
+	var ids []int
+
+	for _, post := range s.Posts {
+		if post.DependsOn == id && post.Status == models.StatusWaiting {
+			ids = append(ids, post.ID)
+		}
+	}
+
+	return ids
+}
+
+// notifyReleased passes released dependents to registered callbacks.
+func (s *Scheduler) notifyReleased(released []models.Post) {
+	if len(released) == 0 {
+		return
+	}
+
+	for _, fn := range s.releaseHooks {
+		fn(released)
+	}
+}
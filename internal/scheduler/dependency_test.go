@@ -0,0 +1,196 @@
+package scheduler
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"PostedIn/internal/config"
+	"PostedIn/internal/models"
+)
+
+// addChain schedules A and adds B waiting on A and C waiting on B.
+func addChain(t *testing.T, s *Scheduler) (a, b, c models.Post) {
+	t.Helper()
+
+	a = mustAdd(t, s, "A")
+
+	var err error
+
+	if b, err = s.Add(models.Post{Content: "B", DependsOn: a.ID, OffsetMinutes: 10}, testConfig()); err != nil {
+		t.Fatalf("Add B: %v", err)
+	}
+
+	if c, err = s.Add(models.Post{Content: "C", DependsOn: b.ID, OffsetMinutes: 10}, testConfig()); err != nil {
+		t.Fatalf("Add C: %v", err)
+	}
+
+	if b.Status != models.StatusWaiting || c.Status != models.StatusWaiting {
+		t.Fatalf("B and C are %q and %q, want both waiting", b.Status, c.Status)
+	}
+
+	return a, b, c
+}
+
+// statuses returns the status of every post by ID.
+func statuses(s *Scheduler) map[int]string {
+	byID := map[int]string{}
+	for _, post := range s.GetPosts() {
+		byID[post.ID] = post.Status
+	}
+
+	return byID
+}
+
+func TestReleaseDependentsCascadesSkips(t *testing.T) {
+	s := newTestScheduler(t)
+	a, b, c := addChain(t, s)
+
+	s.mu.Lock()
+	released := s.releaseDependents(a.ID, time.Now(), false, config.DependencySkip)
+	s.mu.Unlock()
+
+	if len(released) != 0 {
+		t.Errorf("released %d post(s) under the skip policy, want none", len(released))
+	}
+
+	got := statuses(s)
+	if got[b.ID] != models.StatusFailed || got[c.ID] != models.StatusFailed {
+		t.Errorf("B is %q and C is %q, want both failed", got[b.ID], got[c.ID])
+	}
+}
+
+func TestReleaseDependentsPublishPolicyReleasesOnlyDirectDependents(t *testing.T) {
+	s := newTestScheduler(t)
+	a, b, c := addChain(t, s)
+
+	s.mu.Lock()
+	released := s.releaseDependents(a.ID, time.Now(), false, config.DependencyPublish)
+	s.mu.Unlock()
+
+	if len(released) != 1 || released[0].ID != b.ID {
+		t.Fatalf("released %+v, want only post %d", released, b.ID)
+	}
+
+	// C still waits for B to publish
+	if got := statuses(s); got[c.ID] != models.StatusWaiting {
+		t.Errorf("C is %q, want waiting", got[c.ID])
+	}
+}
+
+func TestDeleteMultiplePostsKeepsWaitedOnPosts(t *testing.T) {
+	s := newTestScheduler(t)
+	a, b, c := addChain(t, s)
+
+	err := s.DeleteMultiplePosts([]int{a.ID})
+	if !errors.Is(err, ErrWaitingDependents) {
+		t.Fatalf("DeleteMultiplePosts(A) error = %v, want ErrWaitingDependents", err)
+	}
+
+	if len(s.GetPosts()) != 3 {
+		t.Fatalf("a rejected bulk delete removed posts: %+v", s.GetPosts())
+	}
+
+	// Deleting a post together with everything waiting on it is fine
+	if err := s.DeleteMultiplePosts([]int{a.ID, b.ID, c.ID}); err != nil {
+		t.Fatalf("DeleteMultiplePosts(A, B, C): %v", err)
+	}
+
+	if len(s.GetPosts()) != 0 {
+		t.Errorf("posts left after deleting the chain: %+v", s.GetPosts())
+	}
+}
+
+func TestDependencyOnDraftOrReviewIsRejected(t *testing.T) {
+	s := newTestScheduler(t)
+
+	draft, err := s.AddDraft(models.Post{Content: "draft"}, testConfig())
+	if err != nil {
+		t.Fatalf("AddDraft: %v", err)
+	}
+
+	review := mustAdd(t, s, "review")
+
+	s.mu.Lock()
+	s.findPost(review.ID).SetStatus(models.StatusNeedsReview, "overdue at startup")
+	s.mu.Unlock()
+
+	for _, id := range []int{draft.ID, review.ID} {
+		_, err := s.Add(models.Post{Content: "dependent", DependsOn: id}, testConfig())
+		if !errors.Is(err, ErrInvalidDependency) {
+			t.Errorf("depending on post %d: error = %v, want ErrInvalidDependency", id, err)
+		}
+	}
+}
+
+func TestDependencyChangesAreRaceFree(t *testing.T) {
+	s := newTestScheduler(t)
+	a, _, _ := addChain(t, s)
+
+	var wg sync.WaitGroup
+
+	for range 4 {
+		wg.Add(3)
+
+		go func() {
+			defer wg.Done()
+
+			_ = s.DeleteMultiplePosts([]int{a.ID})
+		}()
+
+		go func() {
+			defer wg.Done()
+
+			_, _ = s.Add(models.Post{Content: "late", DependsOn: a.ID}, testConfig())
+		}()
+
+		go func() {
+			defer wg.Done()
+
+			s.mu.Lock()
+			s.releaseDependents(a.ID, time.Now(), false, config.DependencySkip)
+			s.mu.Unlock()
+		}()
+	}
+
+	wg.Wait()
+
+	got := statuses(s)
+	for _, post := range s.GetPosts() {
+		if _, ok := got[post.DependsOn]; post.Status == models.StatusWaiting && !ok {
+			t.Errorf("post %d waits on deleted post %d", post.ID, post.DependsOn)
+		}
+	}
+}
+
+func TestDependencyPostedLongAgoIsDueNow(t *testing.T) {
+	s := newTestScheduler(t)
+	publishedAt := time.Now().Add(-48 * time.Hour)
+
+	s.Posts = []models.Post{{ID: 1, Content: "A", Status: models.StatusPosted, ScheduledAt: publishedAt, PublishedAt: &publishedAt}}
+	s.nextID = 2
+
+	before := time.Now()
+
+	late, err := s.Add(models.Post{Content: "late follow-up", DependsOn: 1, OffsetMinutes: 60}, testConfig())
+	if err != nil {
+		t.Fatalf("Add: %v", err)
+	}
+
+	if late.Status != models.StatusScheduled || late.ScheduledAt.Before(before) || late.ScheduledAt.After(time.Now()) {
+		t.Errorf("post is %q for %v, want scheduled now rather than at its past offset", late.Status, late.ScheduledAt)
+	}
+
+	recent := time.Now().Add(-10 * time.Minute)
+	s.Posts[0].PublishedAt = &recent
+
+	onTime, err := s.Add(models.Post{Content: "on time", DependsOn: 1, OffsetMinutes: 60}, testConfig())
+	if err != nil {
+		t.Fatalf("Add: %v", err)
+	}
+
+	if want := recent.Add(time.Hour); !onTime.ScheduledAt.Equal(want) {
+		t.Errorf("scheduled for %v, want %v, an hour after the dependency published", onTime.ScheduledAt, want)
+	}
+}
@@ -0,0 +1,91 @@
+package scheduler
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"PostedIn/internal/config"
+	"PostedIn/internal/models"
+)
+
+// ErrNotDraft is returned when scheduling a post that is not a draft.
+var ErrNotDraft = errors.New("post is not a draft")
+
+// ErrNoScheduledTime is returned when scheduling a draft without a time.
+var ErrNoScheduledTime = errors.New("a scheduled time is required")
+
+// AddDraft stores a post as a draft. Drafts are never published; ScheduleDraft gives them a time.
+// A draft may carry a tentative scheduled time, or none.
+func (s *Scheduler) AddDraft(post models.Post, cfg *config.Config) (models.Post, error) {
+	if post.DependsOn > 0 || post.Event != "" {
+		return models.Post{}, fmt.Errorf("a draft cannot depend on another post or follow an event, schedule it instead")
+	}
+
+	if err := NormalizePost(&post, cfg); err != nil {
+		return models.Post{}, err
+	}
+
+	now, err := cfg.Now()
+	if err != nil {
+		now = time.Now() // Fallback to system time
+	}
+
+	post.Status = models.StatusDraft
+	post.CreatedAt = now
+
+	if err := s.insert(&post); err != nil {
+		return models.Post{}, err
+	}
+
+	fmt.Printf("Draft saved with ID %d\n", post.ID)
+
+	return post, nil
+}
+
+// ScheduleDraft promotes a draft to scheduled at scheduledAt, which the caller has validated like a
+// new post's time. A zero scheduledAt uses the draft's tentative time, which must still be ahead.
+func (s *Scheduler) ScheduleDraft(id int, scheduledAt time.Time, cfg *config.Config) (models.Post, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	post := s.findPost(id)
+
+	switch {
+	case post == nil:
+		return models.Post{}, fmt.Errorf("post %d: %w", id, ErrPostNotFound)
+	case post.Status != models.StatusDraft:
+		return models.Post{}, fmt.Errorf("post %d is %s: %w", id, post.Status, ErrNotDraft)
+	}
+
+	if scheduledAt.IsZero() {
+		if post.ScheduledAt.IsZero() {
+			return models.Post{}, fmt.Errorf("draft %d has no time: %w", id, ErrNoScheduledTime)
+		}
+
+		now, err := cfg.Now()
+		if err != nil {
+			now = time.Now()
+		}
+
+		if !post.ScheduledAt.After(now) {
+			return models.Post{}, fmt.Errorf("%w: the draft's time %s has passed, give it a new one",
+				ErrTooSoon, post.ScheduledAt.Format("2006-01-02 15:04 MST"))
+		}
+
+		if err := CheckLeadTime(post.ScheduledAt, cfg); err != nil {
+			return models.Post{}, err
+		}
+
+		scheduledAt = post.ScheduledAt
+	}
+
+	post.ScheduledAt = scheduledAt
+	post.SetStatus(models.StatusScheduled, "scheduled from draft")
+
+	if err := s.savePosts(); err != nil {
+		return models.Post{}, err
+	}
+
+	return *post, nil
+}
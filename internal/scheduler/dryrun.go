@@ -0,0 +1,85 @@
+package scheduler
+
+import (
+	"fmt"
+	"log"
+
+	"PostedIn/internal/config"
+	"PostedIn/internal/models"
+	"PostedIn/internal/transform"
+	"PostedIn/pkg/linkedin"
+)
+
+// IsDryRun reports whether publishes only log what they would send.
+func (s *Scheduler) IsDryRun() bool {
+	return s.dryRun.Load()
+}
+
+// SetDryRun switches dry-run publishing on or off and persists it so it survives restarts.
+// The switch is left unchanged when saving fails.
+func (s *Scheduler) SetDryRun(cfg *config.Config, dryRun bool) error {
+	s.switchMu.Lock()
+	defer s.switchMu.Unlock()
+
+	previous := cfg.DryRun
+	cfg.DryRun = dryRun
+
+	if err := config.SaveConfig(cfg); err != nil {
+		cfg.DryRun = previous
+		return err
+	}
+
+	s.dryRun.Store(dryRun)
+
+	return nil
+}
+
+// publishDryRun logs what publishing the claimed post would send to LinkedIn and marks it posted
+// without calling the API, so the scheduling pipeline can be tested without touching the feed.
+func (s *Scheduler) publishDryRun(attempt models.Post, account *config.Config, cfg *config.Config) error {
+	variants, err := transform.SelectVariants(attempt.Content, attempt.Language, attempt.Variants, attempt.TargetLanguage)
+	if err == nil {
+		author := dryRunAuthor(&attempt, account)
+		visibility := PostVisibility(&attempt, cfg)
+
+		for _, variant := range variants {
+			log.Printf("🧪 Dry run, post %d would publish as %s with visibility %s:\n%s",
+				attempt.ID, author, visibility, publishText(&attempt, variant.Content, account))
+		}
+
+		switch {
+		case attempt.IsPoll():
+			log.Printf("🧪 Dry run, post %d poll: %s %v", attempt.ID, attempt.Poll.Question, attempt.Poll.Options)
+		case attempt.ImagePath != "":
+			log.Printf("🧪 Dry run, post %d image: %s", attempt.ID, attempt.ImagePath)
+		case attempt.IsVideo():
+			log.Printf("🧪 Dry run, post %d video: %s", attempt.ID, attempt.VideoPath)
+		}
+	}
+
+	published, released, err := s.finishPublish(attempt.ID, publishOutcome{Content: attempt.Content, DryRun: true}, err, cfg)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("🧪 Post %d marked as posted (dry run, nothing was sent to LinkedIn)\n", published.ID)
+
+	s.notifyReleased(released)
+	s.notifyPublished(published, nil)
+
+	return nil
+}
+
+// dryRunAuthor returns the author URN a publish would use, as far as it is known without the API.
+func dryRunAuthor(post *models.Post, account *config.Config) string {
+	switch {
+	case post.IsOrganization():
+		return post.OrganizationURN
+	case account.LinkedIn.AuthorURN != "":
+		return account.LinkedIn.AuthorURN
+	case account.LinkedIn.UserID != "":
+		return linkedin.AuthorURN(account.LinkedIn.UserID)
+	}
+
+	return "the member (detected at publish time)"
+}
@@ -0,0 +1,75 @@
+package scheduler
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"PostedIn/internal/models"
+)
+
+func TestDryRunMarksPostedWithoutLinkedIn(t *testing.T) {
+	useTempConfigPath(t)
+
+	s := newTestScheduler(t)
+	cfg := testConfig()
+	post := mustAdd(t, s, "rehearsal")
+
+	if err := s.SetDryRun(cfg, true); err != nil {
+		t.Fatalf("SetDryRun: %v", err)
+	}
+
+	// No token is configured, so only a dry run can succeed
+	if err := s.PublishToLinkedIn(context.Background(), post.ID, cfg); err != nil {
+		t.Fatalf("PublishToLinkedIn in dry run: %v", err)
+	}
+
+	published := s.GetPosts()[0]
+	if published.Status != models.StatusPosted || published.PublishedAt == nil {
+		t.Fatalf("post is %q (published at %v), want posted", published.Status, published.PublishedAt)
+	}
+
+	last := published.History[len(published.History)-1]
+	if last.Note != "dry run, not sent to LinkedIn" {
+		t.Errorf("last history note = %q, want the dry run noted", last.Note)
+	}
+}
+
+func TestLoadSwitchesHonorsPersistedDryRun(t *testing.T) {
+	s := newTestScheduler(t)
+	cfg := testConfig()
+	cfg.DryRun = true
+
+	s.LoadSwitches(cfg)
+
+	if !s.IsDryRun() {
+		t.Error("a dry run persisted in the config was not applied at startup")
+	}
+}
+
+func TestDryRunSwitchIsRaceFree(t *testing.T) {
+	useTempConfigPath(t)
+
+	s := newTestScheduler(t)
+	cfg := testConfig()
+
+	var wg sync.WaitGroup
+
+	for i := range 8 {
+		wg.Add(2)
+
+		go func() {
+			defer wg.Done()
+
+			_ = s.SetDryRun(cfg, i%2 == 0)
+		}()
+
+		go func() {
+			defer wg.Done()
+
+			_ = s.IsDryRun()
+		}()
+	}
+
+	wg.Wait()
+}
@@ -0,0 +1,85 @@
+package scheduler
+
+import (
+	"fmt"
+	"time"
+
+	"PostedIn/internal/config"
+	"PostedIn/internal/models"
+	"PostedIn/internal/transform"
+)
+
+// Near-duplicate detection defaults, used when content.duplicate_similarity_percent and
+// content.duplicate_lookback_days are unset.
+const (
+	defaultDuplicateSimilarityPercent = 80
+	defaultDuplicateLookbackDays      = 30
+)
+
+// DuplicateWarning returns a warning when content is at least the configured percentage similar
+// to a post published within the lookback window, naming the closest match. It returns an empty
+// string when nothing similar was published recently.
+func (s *Scheduler) DuplicateWarning(content string, cfg *config.Config) string {
+	now, err := cfg.Now()
+	if err != nil {
+		now = time.Now()
+	}
+
+	s.mu.RLock()
+	match, similarity, ok := findSimilarPosted(content, s.Posts, cfg.Content, now)
+	s.mu.RUnlock()
+
+	if !ok {
+		return ""
+	}
+
+	return fmt.Sprintf("content is %.0f%% similar to post %d published %s",
+		similarity*100, match.ID, postedAt(match).In(now.Location()).Format("2006-01-02 15:04 MST"))
+}
+
+// findSimilarPosted returns the posted item within the lookback window most similar to content,
+// if its similarity reaches the threshold.
+func findSimilarPosted(content string, posts []models.Post, cfg config.ContentConfig, now time.Time) (models.Post, float64, bool) {
+	threshold := cfg.DuplicateSimilarityPercent
+	if threshold <= 0 {
+		threshold = defaultDuplicateSimilarityPercent
+	}
+
+	lookback := cfg.DuplicateLookbackDays
+	if lookback <= 0 {
+		lookback = defaultDuplicateLookbackDays
+	}
+
+	since := now.AddDate(0, 0, -lookback)
+
+	var (
+		best      models.Post
+		bestScore float64
+	)
+
+	for _, post := range posts {
+		if post.Status != models.StatusPosted || postedAt(post).Before(since) {
+			continue
+		}
+
+		if score := transform.Similarity(content, post.Content); score > bestScore {
+			best, bestScore = post, score
+		}
+	}
+
+	if bestScore*100 < float64(threshold) {
+		return models.Post{}, 0, false
+	}
+
+	return best, bestScore, true
+}
+
+// postedAt returns when a posted item went out, falling back to its scheduled time for
+// posts published before the publish time was recorded.
+func postedAt(post models.Post) time.Time {
+	if post.PublishedAt != nil {
+		return *post.PublishedAt
+	}
+
+	return post.ScheduledAt
+}
@@ -0,0 +1,98 @@
+package scheduler
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"PostedIn/internal/models"
+)
+
+// restorePosted loads posts published the given number of days ago, with IDs 1, 2, ... in order.
+func restorePosted(t *testing.T, s *Scheduler, contents []string, daysAgo []int) {
+	t.Helper()
+
+	backup := Backup{Version: BackupVersion}
+
+	for i, content := range contents {
+		publishedAt := time.Now().AddDate(0, 0, -daysAgo[i])
+		backup.Posts = append(backup.Posts, models.Post{
+			ID:          i + 1,
+			Content:     content,
+			ScheduledAt: publishedAt,
+			PublishedAt: &publishedAt,
+			Status:      models.StatusPosted,
+			PostType:    models.PostTypeText,
+		})
+	}
+
+	data, err := json.Marshal(backup)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := s.Restore(bytes.NewReader(data), testConfig()); err != nil {
+		t.Fatalf("Restore: %v", err)
+	}
+}
+
+func TestDuplicateWarning(t *testing.T) {
+	s := newTestScheduler(t)
+	cfg := testConfig()
+
+	restorePosted(t, s, []string{
+		"We just shipped dark mode for the dashboard, try it today",
+		"Hiring: senior Go engineer, remote friendly",
+	}, []int{3, 10})
+
+	warning := s.DuplicateWarning("We just shipped dark mode for the dashboard - try it today!", cfg)
+	if !strings.Contains(warning, "100% similar to post 1") {
+		t.Errorf("warning = %q, want post 1 named as a near duplicate", warning)
+	}
+
+	if warning := s.DuplicateWarning("Our quarterly results are in, revenue grew", cfg); warning != "" {
+		t.Errorf("dissimilar content: warning = %q, want none", warning)
+	}
+}
+
+func TestDuplicateWarningSettings(t *testing.T) {
+	s := newTestScheduler(t)
+	restorePosted(t, s, []string{
+		"alpha beta gamma delta",
+		"one two three four five six seven eight nine ten",
+	}, []int{40, 1})
+
+	cfg := testConfig()
+
+	// Post 1 is identical but outside the default 30 day lookback
+	if warning := s.DuplicateWarning("alpha beta gamma delta", cfg); warning != "" {
+		t.Errorf("default lookback: warning = %q, want none", warning)
+	}
+
+	cfg.Content.DuplicateLookbackDays = 60
+	if warning := s.DuplicateWarning("alpha beta gamma delta", cfg); !strings.Contains(warning, "post 1") {
+		t.Errorf("60 day lookback: warning = %q, want post 1", warning)
+	}
+
+	// 7 of 11 distinct words in common is 64%, under the default 80% threshold
+	content := "one two three four five six seven extra"
+	if warning := s.DuplicateWarning(content, cfg); warning != "" {
+		t.Errorf("default threshold: warning = %q, want none", warning)
+	}
+
+	cfg.Content.DuplicateSimilarityPercent = 60
+	if warning := s.DuplicateWarning(content, cfg); !strings.Contains(warning, "64% similar to post 2") {
+		t.Errorf("60%% threshold: warning = %q, want post 2", warning)
+	}
+}
+
+func TestDuplicateWarningIgnoresUnpublished(t *testing.T) {
+	s := newTestScheduler(t)
+	mustAdd(t, s, "scheduled but not published yet")
+
+	if warning := s.DuplicateWarning("scheduled but not published yet", testConfig()); warning != "" {
+		t.Errorf("warning = %q, want scheduled posts ignored", warning)
+	}
+}
@@ -0,0 +1,119 @@
+package scheduler
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"PostedIn/internal/config"
+	"PostedIn/internal/models"
+)
+
+// ErrInvalidEventMove is returned when moving an event would shift a post tied to it into the past
+// or inside the minimum lead time.
+var ErrInvalidEventMove = errors.New("event cannot be moved")
+
+// resolveEvent sets the scheduled time of a post tied to a named event from the event's time
+// and the post's offset, rejecting unknown events and times that are not far enough ahead.
+func resolveEvent(post *models.Post, cfg *config.Config) error {
+	if post.Event == "" {
+		return nil
+	}
+
+	if post.DependsOn > 0 {
+		return fmt.Errorf("a post can follow either another post or an event, not both")
+	}
+
+	at, err := cfg.EventTime(post.Event)
+	if err != nil {
+		return err
+	}
+
+	post.ScheduledAt = at.Add(time.Duration(post.EventOffsetMinutes) * time.Minute)
+
+	now, err := cfg.Now()
+	if err != nil {
+		now = time.Now()
+	}
+
+	if post.ScheduledAt.Before(now) {
+		return fmt.Errorf("%d minutes from event %q is %s, which is in the past",
+			post.EventOffsetMinutes, post.Event, post.ScheduledAt.Format("2006-01-02 15:04 MST"))
+	}
+
+	return CheckLeadTime(post.ScheduledAt, cfg)
+}
+
+// CheckEventMove reports whether the named event can move to at: every scheduled post tied to it
+// must still publish in the future and outside the minimum lead time. Check before saving the move,
+// so a rejected one leaves the event and its posts untouched.
+func (s *Scheduler) CheckEventMove(name string, at time.Time, cfg *config.Config) error {
+	now, err := cfg.Now()
+	if err != nil {
+		now = time.Now()
+	}
+
+	// Events are stored to the minute
+	at = at.Truncate(time.Minute)
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	for _, post := range s.Posts {
+		if post.Event != name || post.Status != models.StatusScheduled {
+			continue
+		}
+
+		scheduledAt := at.Add(time.Duration(post.EventOffsetMinutes) * time.Minute)
+		if scheduledAt.Before(now) {
+			return fmt.Errorf("%w: post %d would move to %s, which is in the past",
+				ErrInvalidEventMove, post.ID, scheduledAt.Format("2006-01-02 15:04 MST"))
+		}
+
+		if err := CheckLeadTime(scheduledAt, cfg); err != nil {
+			return fmt.Errorf("%w: post %d: %w", ErrInvalidEventMove, post.ID, err)
+		}
+	}
+
+	return nil
+}
+
+// RescheduleEvent recomputes the scheduled time of every scheduled post tied to the named event
+// after the event moved, saves them and returns the posts that changed so their timers can be re-armed.
+func (s *Scheduler) RescheduleEvent(name string, cfg *config.Config) ([]models.Post, error) {
+	at, err := cfg.EventTime(name)
+	if err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var changed []models.Post
+
+	for i := range s.Posts {
+		post := &s.Posts[i]
+		if post.Event != name || post.Status != models.StatusScheduled {
+			continue
+		}
+
+		scheduledAt := at.Add(time.Duration(post.EventOffsetMinutes) * time.Minute)
+		if scheduledAt.Equal(post.ScheduledAt) {
+			continue
+		}
+
+		post.ScheduledAt = scheduledAt
+		post.Record(post.Status, fmt.Sprintf("event %q moved", name))
+		changed = append(changed, *post)
+	}
+
+	if len(changed) == 0 {
+		return nil, nil
+	}
+
+	if err := s.savePosts(); err != nil {
+		return nil, fmt.Errorf("failed to save rescheduled posts: %w", err)
+	}
+
+	return changed, nil
+}
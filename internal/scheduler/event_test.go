@@ -0,0 +1,60 @@
+package scheduler
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"PostedIn/internal/models"
+)
+
+func TestCheckEventMove(t *testing.T) {
+	s := newTestScheduler(t)
+	cfg := testConfig()
+	now := time.Now().UTC()
+
+	if err := cfg.SetEvent("webinar", now.Add(48*time.Hour)); err != nil {
+		t.Fatal(err)
+	}
+
+	post, err := s.Add(models.Post{Content: "reminder", Event: "webinar", EventOffsetMinutes: -24 * 60}, cfg)
+	if err != nil {
+		t.Fatalf("Add: %v", err)
+	}
+
+	tests := []struct {
+		name    string
+		at      time.Time
+		wantErr bool
+	}{
+		{name: "later", at: now.Add(72 * time.Hour)},
+		{name: "post still ahead", at: now.Add(25 * time.Hour)},
+		{name: "post would be in the past", at: now.Add(12 * time.Hour), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := s.CheckEventMove("webinar", tt.at, cfg)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("CheckEventMove error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if err != nil && !errors.Is(err, ErrInvalidEventMove) {
+				t.Errorf("error %v is not ErrInvalidEventMove", err)
+			}
+		})
+	}
+
+	// Posts tied to other events, or no longer scheduled, do not block a move
+	if err := s.CheckEventMove("launch", now.Add(time.Hour), cfg); err != nil {
+		t.Errorf("moving an unrelated event: %v", err)
+	}
+
+	if _, err := s.MarkAsPosted(post.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := s.CheckEventMove("webinar", now.Add(time.Hour), cfg); err != nil {
+		t.Errorf("moving an event whose post already published: %v", err)
+	}
+}
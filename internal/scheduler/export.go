@@ -0,0 +1,175 @@
+package scheduler
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	"PostedIn/internal/models"
+)
+
+// Export formats.
+const (
+	ExportCSVFormat  = "csv"
+	ExportJSONFormat = "json"
+)
+
+// Times an export range can filter on.
+const (
+	ExportByScheduled = "scheduled"
+	ExportByPublished = "published"
+)
+
+// exportDateLayout is the format of the days bounding an export range.
+const exportDateLayout = "2006-01-02"
+
+// ErrInvalidExportRange is returned when an export range cannot be parsed or ends before it starts.
+var ErrInvalidExportRange = errors.New("invalid export range")
+
+// exportColumns are the CSV export's columns; content and scheduled_at match the generic import format.
+var exportColumns = []string{"id", "content", "status", "scheduled_at", "created_at"}
+
+// ExportRange limits an export to the posts whose scheduled or published time falls on a day from
+// From to To, both inclusive. A zero From or To leaves that end open; the zero range exports every post.
+type ExportRange struct {
+	From time.Time // Start of the first day, in the configured timezone
+	To   time.Time // Start of the last day, in the configured timezone
+	By   string    // ExportByScheduled or ExportByPublished; empty means scheduled
+}
+
+// ParseExportRange reads the YYYY-MM-DD days of a range in loc; empty days leave that end open.
+func ParseExportRange(from, to, by string, loc *time.Location) (ExportRange, error) {
+	rng := ExportRange{By: by}
+
+	switch by {
+	case "", ExportByScheduled, ExportByPublished:
+	default:
+		return ExportRange{}, fmt.Errorf("%w: by must be %s or %s", ErrInvalidExportRange, ExportByScheduled, ExportByPublished)
+	}
+
+	var err error
+
+	if from != "" {
+		if rng.From, err = time.ParseInLocation(exportDateLayout, from, loc); err != nil {
+			return ExportRange{}, fmt.Errorf("%w: from must be YYYY-MM-DD", ErrInvalidExportRange)
+		}
+	}
+
+	if to != "" {
+		if rng.To, err = time.ParseInLocation(exportDateLayout, to, loc); err != nil {
+			return ExportRange{}, fmt.Errorf("%w: to must be YYYY-MM-DD", ErrInvalidExportRange)
+		}
+	}
+
+	if !rng.From.IsZero() && !rng.To.IsZero() && rng.From.After(rng.To) {
+		return ExportRange{}, fmt.Errorf("%w: from %s is after to %s", ErrInvalidExportRange, from, to)
+	}
+
+	return rng, nil
+}
+
+// Includes reports whether a post falls in the range. Unpublished posts are outside any bounded
+// range filtering on the published time.
+func (r ExportRange) Includes(post models.Post) bool {
+	if r.From.IsZero() && r.To.IsZero() {
+		return true
+	}
+
+	at := post.ScheduledAt
+	if r.By == ExportByPublished {
+		if post.PublishedAt == nil {
+			return false
+		}
+
+		at = *post.PublishedAt
+	}
+
+	if !r.From.IsZero() && at.Before(r.From) {
+		return false
+	}
+
+	// AddDate keeps the end of the last day right across DST changes
+	return r.To.IsZero() || at.Before(r.To.AddDate(0, 0, 1))
+}
+
+// Export writes the posts in the range in the named format, csv or json, and returns how many it wrote.
+func (s *Scheduler) Export(w io.Writer, format string, rng ExportRange) (int, error) {
+	switch format {
+	case ExportCSVFormat:
+		return s.ExportCSV(w, rng)
+	case ExportJSONFormat:
+		return s.ExportJSON(w, rng)
+	}
+
+	return 0, fmt.Errorf("unknown export format %q, use %s or %s", format, ExportCSVFormat, ExportJSONFormat)
+}
+
+// ExportCSV writes one row per post in the range with RFC 3339 times, so the file can be imported
+// again with the generic format.
+func (s *Scheduler) ExportCSV(w io.Writer, rng ExportRange) (int, error) {
+	writer := csv.NewWriter(w)
+
+	if err := writer.Write(exportColumns); err != nil {
+		return 0, fmt.Errorf("failed to write CSV header: %w", err)
+	}
+
+	posts := s.exportedPosts(rng)
+
+	for _, post := range posts {
+		record := []string{
+			strconv.Itoa(post.ID),
+			post.Content,
+			post.Status,
+			exportTime(post.ScheduledAt),
+			exportTime(post.CreatedAt),
+		}
+
+		if err := writer.Write(record); err != nil {
+			return 0, fmt.Errorf("failed to write post %d: %w", post.ID, err)
+		}
+	}
+
+	writer.Flush()
+
+	return len(posts), writer.Error()
+}
+
+// ExportJSON writes the posts in the range as a JSON array in the same form the API returns them.
+func (s *Scheduler) ExportJSON(w io.Writer, rng ExportRange) (int, error) {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+
+	posts := s.exportedPosts(rng)
+
+	if err := encoder.Encode(posts); err != nil {
+		return 0, fmt.Errorf("failed to write posts: %w", err)
+	}
+
+	return len(posts), nil
+}
+
+// exportedPosts returns copies of the posts in the range.
+func (s *Scheduler) exportedPosts(rng ExportRange) []models.Post {
+	posts := []models.Post{}
+
+	for _, post := range s.GetPosts() {
+		if rng.Includes(post) {
+			posts = append(posts, post)
+		}
+	}
+
+	return posts
+}
+
+// exportTime formats a time as RFC 3339, leaving zero times empty.
+func exportTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+
+	return t.Format(time.RFC3339)
+}
@@ -0,0 +1,147 @@
+package scheduler
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"PostedIn/internal/models"
+)
+
+func TestParseExportRange(t *testing.T) {
+	tests := []struct {
+		name     string
+		from, to string
+		by       string
+		wantErr  bool
+	}{
+		{name: "open", wantErr: false},
+		{name: "same day", from: "2025-06-01", to: "2025-06-01"},
+		{name: "published", from: "2025-06-01", to: "2025-06-30", by: ExportByPublished},
+		{name: "only from", from: "2025-06-01"},
+		{name: "from after to", from: "2025-06-02", to: "2025-06-01", wantErr: true},
+		{name: "bad from", from: "06/01/2025", wantErr: true},
+		{name: "bad to", to: "2025-6-1x", wantErr: true},
+		{name: "bad by", from: "2025-06-01", by: "created", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseExportRange(tt.from, tt.to, tt.by, time.UTC)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseExportRange(%q, %q, %q) error = %v, wantErr %v", tt.from, tt.to, tt.by, err, tt.wantErr)
+			}
+
+			if err != nil && !errors.Is(err, ErrInvalidExportRange) {
+				t.Errorf("error %v is not ErrInvalidExportRange", err)
+			}
+		})
+	}
+}
+
+func TestExportRangeIncludesInConfiguredTimezone(t *testing.T) {
+	loc, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skipf("timezone data unavailable: %v", err)
+	}
+
+	rng, err := ParseExportRange("2025-06-01", "2025-06-30", "", loc)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name string
+		at   time.Time
+		want bool
+	}{
+		{name: "first instant of from", at: time.Date(2025, 6, 1, 0, 0, 0, 0, loc), want: true},
+		{name: "just before from", at: time.Date(2025, 5, 31, 23, 59, 59, 0, loc), want: false},
+		{name: "last minute of to", at: time.Date(2025, 6, 30, 23, 59, 0, 0, loc), want: true},
+		{name: "first instant after to", at: time.Date(2025, 7, 1, 0, 0, 0, 0, loc), want: false},
+		// 03:30 UTC on July 1st is still June 30th in New York
+		{name: "late on to in UTC terms", at: time.Date(2025, 7, 1, 3, 30, 0, 0, time.UTC), want: true},
+		// 02:00 UTC on June 1st is still May 31st in New York
+		{name: "early on from in UTC terms", at: time.Date(2025, 6, 1, 2, 0, 0, 0, time.UTC), want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := rng.Includes(models.Post{ScheduledAt: tt.at}); got != tt.want {
+				t.Errorf("Includes(%s) = %v, want %v", tt.at, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestExportFiltersOnPublishedTime(t *testing.T) {
+	s := newTestScheduler(t)
+	june := time.Date(2025, 6, 15, 12, 0, 0, 0, time.UTC)
+	july := time.Date(2025, 7, 2, 12, 0, 0, 0, time.UTC)
+
+	s.Posts = []models.Post{
+		{ID: 1, Content: "scheduled in June, published in July", ScheduledAt: june, PublishedAt: &july},
+		{ID: 2, Content: "published in June", ScheduledAt: june, PublishedAt: &june},
+		{ID: 3, Content: "never published", ScheduledAt: june},
+	}
+
+	tests := []struct {
+		by   string
+		want []int
+	}{
+		{by: ExportByScheduled, want: []int{1, 2, 3}},
+		{by: ExportByPublished, want: []int{2}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.by, func(t *testing.T) {
+			rng, err := ParseExportRange("2025-06-01", "2025-06-30", tt.by, time.UTC)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var buf bytes.Buffer
+
+			count, err := s.Export(&buf, ExportJSONFormat, rng)
+			if err != nil {
+				t.Fatalf("Export: %v", err)
+			}
+
+			var posts []models.Post
+			if err := json.Unmarshal(buf.Bytes(), &posts); err != nil {
+				t.Fatalf("export is not JSON: %v", err)
+			}
+
+			if count != len(tt.want) || len(posts) != len(tt.want) {
+				t.Fatalf("exported %d post(s) (count %d), want %v", len(posts), count, tt.want)
+			}
+
+			for i, id := range tt.want {
+				if posts[i].ID != id {
+					t.Errorf("post %d = ID %d, want %d", i, posts[i].ID, id)
+				}
+			}
+		})
+	}
+}
+
+func TestExportCSVWithoutRangeWritesEveryPost(t *testing.T) {
+	s := newTestScheduler(t)
+	s.Posts = []models.Post{
+		{ID: 1, Content: "a", ScheduledAt: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{ID: 2, Content: "b", ScheduledAt: time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)},
+	}
+
+	var buf bytes.Buffer
+
+	count, err := s.Export(&buf, ExportCSVFormat, ExportRange{})
+	if err != nil {
+		t.Fatalf("Export: %v", err)
+	}
+
+	if count != 2 || bytes.Count(buf.Bytes(), []byte("\n")) != 3 {
+		t.Errorf("CSV export wrote %d post(s):\n%s", count, buf.String())
+	}
+}
@@ -0,0 +1,51 @@
+package scheduler
+
+import (
+	"strings"
+	"time"
+
+	"PostedIn/internal/models"
+)
+
+// PostFilter selects posts by status, scheduled time range and content search; zero fields match every post.
+type PostFilter struct {
+	Status string
+	From   time.Time // Inclusive
+	To     time.Time // Inclusive
+	Search string    // Case-insensitive substring of the content
+}
+
+// Matches reports whether the post satisfies every set criterion of the filter.
+func (f PostFilter) Matches(post models.Post) bool {
+	if f.Status != "" && post.Status != f.Status {
+		return false
+	}
+
+	if !f.From.IsZero() && post.ScheduledAt.Before(f.From) {
+		return false
+	}
+
+	if !f.To.IsZero() && post.ScheduledAt.After(f.To) {
+		return false
+	}
+
+	return f.Search == "" || strings.Contains(strings.ToLower(post.Content), strings.ToLower(f.Search))
+}
+
+// Apply returns the posts matching the filter, in their original order.
+func (f PostFilter) Apply(posts []models.Post) []models.Post {
+	matched := []models.Post{}
+
+	for _, post := range posts {
+		if f.Matches(post) {
+			matched = append(matched, post)
+		}
+	}
+
+	return matched
+}
+
+// FilterPosts returns copies of the posts matching the filter.
+func (s *Scheduler) FilterPosts(filter PostFilter) []models.Post {
+	return filter.Apply(s.GetPosts())
+}
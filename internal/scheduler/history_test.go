@@ -0,0 +1,71 @@
+package scheduler
+
+import (
+	"net/http"
+	"testing"
+
+	"PostedIn/internal/models"
+	"PostedIn/pkg/linkedin"
+)
+
+// timeline lists a post's history as "status/note" entries.
+func timeline(post models.Post) []string {
+	entries := make([]string, 0, len(post.History))
+	for _, change := range post.History {
+		entries = append(entries, change.Status+"/"+change.Note)
+	}
+
+	return entries
+}
+
+func assertTimeline(t *testing.T, post models.Post, want ...string) {
+	t.Helper()
+
+	got := timeline(post)
+	if len(got) != len(want) {
+		t.Fatalf("post %d history = %v, want %v", post.ID, got, want)
+	}
+
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("post %d history[%d] = %q, want %q", post.ID, i, got[i], want[i])
+		}
+	}
+}
+
+func TestHistoryRecordsPublishAndRelease(t *testing.T) {
+	s := newTestScheduler(t)
+	first := mustAdd(t, s, "first")
+
+	follow, err := s.Add(models.Post{Content: "follow-up", DependsOn: first.ID, OffsetMinutes: 30}, testConfig())
+	if err != nil {
+		t.Fatalf("Add: %v", err)
+	}
+
+	if _, err := s.MarkAsPosted(first.ID); err != nil {
+		t.Fatalf("MarkAsPosted: %v", err)
+	}
+
+	assertTimeline(t, findByID(t, s, first.ID), "scheduled/created", "posted/marked as posted")
+	assertTimeline(t, findByID(t, s, follow.ID), "waiting/created", "scheduled/released by post 1")
+}
+
+func TestHistoryRecordsFailure(t *testing.T) {
+	s := newTestScheduler(t)
+	post := mustAdd(t, s, "rejected")
+	cfg := testConfig()
+
+	if _, err := s.beginPublish(post.ID, cfg); err != nil {
+		t.Fatalf("beginPublish: %v", err)
+	}
+
+	rejected := &linkedin.APIError{Kind: "API", StatusCode: http.StatusUnprocessableEntity, Body: "duplicate"}
+
+	if _, _, err := s.finishPublish(post.ID, publishOutcome{}, rejected, cfg); err == nil {
+		t.Fatal("finishPublish succeeded with a rejected publish")
+	}
+
+	s.endPublish(post.ID)
+
+	assertTimeline(t, findByID(t, s, post.ID), "scheduled/created", "failed/"+rejected.Error())
+}
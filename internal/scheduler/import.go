@@ -0,0 +1,77 @@
+package scheduler
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"time"
+
+	"PostedIn/internal/config"
+	"PostedIn/internal/importer"
+	"PostedIn/internal/models"
+)
+
+// ErrInvalidImport is returned when an import file cannot be read as CSV.
+var ErrInvalidImport = errors.New("invalid import file")
+
+// ImportResult holds the posts scheduled by an import and the rows that were not.
+type ImportResult struct {
+	Imported []models.Post       `json:"imported"`
+	Skipped  []importer.RowError `json:"skipped"` // Unmapped, past-due and invalid rows with the reason
+}
+
+// ImportCSV schedules every row of a CSV export mapped by mapper, reading times without an offset in
+// loc. Rows in the past, inside the minimum lead time or failing validation are skipped and reported
+// without aborting the import; the posts that pass are saved together in one write.
+func (s *Scheduler) ImportCSV(r io.Reader, mapper importer.Mapper, loc *time.Location, cfg *config.Config) (ImportResult, error) {
+	parsed, err := importer.Parse(r, mapper, loc)
+	if err != nil {
+		return ImportResult{}, fmt.Errorf("%w: %w", ErrInvalidImport, err)
+	}
+
+	now, err := cfg.Now()
+	if err != nil {
+		now = time.Now() // Fallback to system time
+	}
+
+	result := ImportResult{Imported: []models.Post{}, Skipped: append([]importer.RowError{}, parsed.Unmapped...)}
+	tx := s.Begin()
+	defer tx.Rollback()
+
+	for _, mapped := range parsed.Posts {
+		if mapped.Post.ScheduledAt.Before(now) {
+			result.Skipped = append(result.Skipped, importer.RowError{
+				Row:    mapped.Row,
+				Reason: fmt.Sprintf("scheduled time %s is in the past", mapped.Post.ScheduledAt.Format("2006-01-02 15:04 MST")),
+			})
+
+			continue
+		}
+
+		if err := CheckLeadTime(mapped.Post.ScheduledAt, cfg); err != nil {
+			result.Skipped = append(result.Skipped, importer.RowError{Row: mapped.Row, Reason: err.Error()})
+			continue
+		}
+
+		added, err := tx.Add(mapped.Post, cfg)
+		if err != nil {
+			result.Skipped = append(result.Skipped, importer.RowError{Row: mapped.Row, Reason: err.Error()})
+			continue
+		}
+
+		result.Imported = append(result.Imported, added)
+	}
+
+	sort.Slice(result.Skipped, func(i, j int) bool { return result.Skipped[i].Row < result.Skipped[j].Row })
+
+	if len(result.Imported) == 0 {
+		return result, nil
+	}
+
+	if err := tx.Commit(); err != nil {
+		return ImportResult{}, err
+	}
+
+	return result, nil
+}
@@ -0,0 +1,58 @@
+package scheduler
+
+import (
+	"fmt"
+	"time"
+
+	"PostedIn/internal/config"
+	"PostedIn/internal/models"
+)
+
+const wallClockFormat = "2006-01-02 15:04"
+
+// VerifyScheduleIntegrity checks that a post's stored instant still means the wall-clock time the
+// user entered in the configured timezone. Scheduled times are stored with the offset they were
+// entered in, so a stored offset that differs from the configured zone's offset at that instant
+// means the time was converted through another zone; a shift of exactly the zone's offset is the
+// signature of a time converted twice.
+func VerifyScheduleIntegrity(post models.Post, cfg *config.Config) error {
+	if post.Status == models.StatusWaiting {
+		return nil
+	}
+
+	if post.ScheduledAt.IsZero() {
+		return fmt.Errorf("post %d has no scheduled time", post.ID)
+	}
+
+	loc, err := cfg.GetTimezone()
+	if err != nil {
+		return fmt.Errorf("cannot verify post %d: %w", post.ID, err)
+	}
+
+	stored := post.ScheduledAt
+	configured := stored.In(loc)
+
+	_, storedOffset := stored.Zone()
+	_, configuredOffset := configured.Zone()
+
+	if storedOffset == configuredOffset {
+		return nil
+	}
+
+	// Compare wall clocks as if both were UTC to measure the shift the user would see
+	shift := wallClock(configured).Sub(wallClock(stored))
+	zoneOffset := time.Duration(configuredOffset) * time.Second
+
+	if storedOffset == 0 && (shift == zoneOffset || shift == -zoneOffset) {
+		return fmt.Errorf("post %d looks converted twice: entered as %s but publishes at %s %s, off by exactly the timezone offset",
+			post.ID, stored.Format(wallClockFormat), configured.Format(wallClockFormat), loc)
+	}
+
+	return fmt.Errorf("post %d was stored at %s (UTC%s) but publishes at %s %s; check the time if the timezone was not changed on purpose",
+		post.ID, stored.Format(wallClockFormat), stored.Format("-07:00"), configured.Format(wallClockFormat), loc)
+}
+
+// wallClock returns the same calendar date and clock reading in UTC.
+func wallClock(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), time.UTC)
+}
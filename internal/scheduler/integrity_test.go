@@ -0,0 +1,111 @@
+package scheduler
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"PostedIn/internal/config"
+	"PostedIn/internal/models"
+)
+
+func newYorkConfig(t *testing.T) *config.Config {
+	t.Helper()
+
+	cfg := testConfig()
+	cfg.Timezone.Location = "America/New_York"
+
+	if _, err := cfg.GetTimezone(); err != nil {
+		t.Skipf("timezone data unavailable: %v", err)
+	}
+
+	return cfg
+}
+
+func TestVerifyScheduleIntegrityAcceptsParsedTimes(t *testing.T) {
+	cfg := newYorkConfig(t)
+
+	for _, date := range []string{"2025-01-15", "2025-07-15"} {
+		scheduledAt, err := cfg.ParseTimeInTimezone(date, "09:00")
+		if err != nil {
+			t.Fatalf("ParseTimeInTimezone: %v", err)
+		}
+
+		post := models.Post{ID: 1, ScheduledAt: scheduledAt, Status: models.StatusScheduled}
+		if err := VerifyScheduleIntegrity(post, cfg); err != nil {
+			t.Errorf("%s 09:00: %v", date, err)
+		}
+	}
+}
+
+func TestVerifyScheduleIntegrityAfterReload(t *testing.T) {
+	cfg := newYorkConfig(t)
+	path := filepath.Join(t.TempDir(), "posts.json")
+
+	scheduledAt, err := cfg.ParseTimeInTimezone(time.Now().AddDate(0, 0, 7).Format("2006-01-02"), "09:00")
+	if err != nil {
+		t.Fatalf("ParseTimeInTimezone: %v", err)
+	}
+
+	if _, err := NewScheduler(path).Add(models.Post{Content: "round trip", ScheduledAt: scheduledAt}, cfg); err != nil {
+		t.Fatalf("Add: %v", err)
+	}
+
+	for _, post := range NewScheduler(path).GetPosts() {
+		if err := VerifyScheduleIntegrity(post, cfg); err != nil {
+			t.Errorf("reloaded post: %v", err)
+		}
+	}
+}
+
+func TestVerifyScheduleIntegrityDetectsDoubleConversion(t *testing.T) {
+	cfg := newYorkConfig(t)
+
+	// The bug: "09:00" meant New York time, but the wall clock was re-read as UTC
+	intended, err := cfg.ParseTimeInTimezone("2025-07-15", "09:00")
+	if err != nil {
+		t.Fatalf("ParseTimeInTimezone: %v", err)
+	}
+
+	corrupted := wallClock(intended)
+
+	post := models.Post{ID: 7, ScheduledAt: corrupted, Status: models.StatusScheduled}
+
+	err = VerifyScheduleIntegrity(post, cfg)
+	if err == nil {
+		t.Fatal("double-converted time was not flagged")
+	}
+
+	if !strings.Contains(err.Error(), "converted twice") {
+		t.Errorf("error = %v, want it to name the double conversion", err)
+	}
+}
+
+func TestVerifyScheduleIntegrityFlagsOtherOffsets(t *testing.T) {
+	cfg := newYorkConfig(t)
+	berlin := time.FixedZone("CEST", 2*60*60)
+
+	post := models.Post{ID: 3, ScheduledAt: time.Date(2025, 7, 15, 9, 0, 0, 0, berlin), Status: models.StatusScheduled}
+
+	err := VerifyScheduleIntegrity(post, cfg)
+	if err == nil {
+		t.Fatal("time stored in another offset was not flagged")
+	}
+
+	if strings.Contains(err.Error(), "converted twice") {
+		t.Errorf("error = %v, want a plain offset mismatch", err)
+	}
+}
+
+func TestVerifyScheduleIntegritySkipsWaitingPosts(t *testing.T) {
+	cfg := newYorkConfig(t)
+
+	if err := VerifyScheduleIntegrity(models.Post{ID: 2, Status: models.StatusWaiting}, cfg); err != nil {
+		t.Errorf("waiting post: %v", err)
+	}
+
+	if err := VerifyScheduleIntegrity(models.Post{ID: 2, Status: models.StatusScheduled}, cfg); err == nil {
+		t.Error("scheduled post without a time was not flagged")
+	}
+}
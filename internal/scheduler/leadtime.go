@@ -0,0 +1,38 @@
+package scheduler
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"PostedIn/internal/config"
+)
+
+// ErrTooSoon is returned when a post is scheduled inside the configured minimum lead time.
+var ErrTooSoon = errors.New("scheduled time is too soon")
+
+// EarliestAllowed returns the earliest time a new post may be scheduled, in the configured timezone.
+func EarliestAllowed(cfg *config.Config) time.Time {
+	now, err := cfg.Now()
+	if err != nil {
+		now = time.Now()
+	}
+
+	return now.Add(time.Duration(cfg.Cron.MinLeadMinutes) * time.Minute)
+}
+
+// CheckLeadTime rejects times sooner than cron.min_lead_minutes from now,
+// suggesting the earliest time that would be accepted.
+func CheckLeadTime(scheduledAt time.Time, cfg *config.Config) error {
+	if cfg.Cron.MinLeadMinutes <= 0 {
+		return nil
+	}
+
+	earliest := EarliestAllowed(cfg)
+	if scheduledAt.Before(earliest) {
+		return fmt.Errorf("%w: posts must be scheduled at least %d minutes ahead, earliest allowed is %s",
+			ErrTooSoon, cfg.Cron.MinLeadMinutes, earliest.Format("2006-01-02 15:04 MST"))
+	}
+
+	return nil
+}
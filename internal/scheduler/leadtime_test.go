@@ -0,0 +1,55 @@
+package scheduler
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestCheckLeadTimeBoundary(t *testing.T) {
+	cfg := testConfig()
+	cfg.Cron.MinLeadMinutes = 5
+
+	lead := 5 * time.Minute
+
+	if err := CheckLeadTime(time.Now().Add(lead+2*time.Second), cfg); err != nil {
+		t.Errorf("just after the lead time: %v", err)
+	}
+
+	err := CheckLeadTime(time.Now().Add(lead-2*time.Second), cfg)
+	if !errors.Is(err, ErrTooSoon) {
+		t.Fatalf("just inside the lead time: error = %v, want ErrTooSoon", err)
+	}
+
+	if !strings.Contains(err.Error(), "earliest allowed is") {
+		t.Errorf("error = %v, want it to suggest the earliest allowed time", err)
+	}
+}
+
+func TestCheckLeadTimeDisabled(t *testing.T) {
+	if err := CheckLeadTime(time.Now().Add(time.Second), testConfig()); err != nil {
+		t.Errorf("without a lead time: %v", err)
+	}
+}
+
+func TestEarliestAllowed(t *testing.T) {
+	cfg := testConfig()
+	cfg.Timezone.Location = "Asia/Tokyo"
+	cfg.Cron.MinLeadMinutes = 30
+
+	if _, err := cfg.GetTimezone(); err != nil {
+		t.Skipf("timezone data unavailable: %v", err)
+	}
+
+	before := time.Now()
+	earliest := EarliestAllowed(cfg)
+
+	if earliest.Location().String() != "Asia/Tokyo" {
+		t.Errorf("earliest is in %v, want the configured timezone", earliest.Location())
+	}
+
+	if lead := earliest.Sub(before); lead < 30*time.Minute || lead > 31*time.Minute {
+		t.Errorf("earliest is %v from now, want 30m", lead)
+	}
+}
@@ -0,0 +1,19 @@
+//go:build !sqlite
+
+package scheduler
+
+import (
+	"errors"
+	"testing"
+
+	"PostedIn/internal/config"
+)
+
+func TestSQLiteBackendNeedsBuildTag(t *testing.T) {
+	cfg := testConfig()
+	cfg.Storage.Backend = config.StorageSQLite
+
+	if _, err := NewSchedulerFromConfig(cfg); !errors.Is(err, errSQLiteNotBuilt) {
+		t.Errorf("NewSchedulerFromConfig = %v, want errSQLiteNotBuilt", err)
+	}
+}
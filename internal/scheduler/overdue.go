@@ -0,0 +1,150 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"PostedIn/internal/config"
+	"PostedIn/internal/models"
+)
+
+const defaultSnooze = time.Hour
+
+// OverdueResult summarizes the scheduled posts found past their time.
+type OverdueResult struct {
+	Overdue   []int // All overdue post IDs
+	ToPublish []int // Posts to publish immediately (publish policy)
+	Reviewed  []int // Posts marked needs_review (review policy)
+	Snoozed   []int // Posts rescheduled (snooze policy)
+	Missed    []int // Posts marked failed (fail policy)
+}
+
+// ReconcileOverdue applies the overdue policy to scheduled posts whose time is before the cutoff.
+// Posts overdue by at most grace are published whatever the policy. Posts are updated in place
+// for the review, snooze and fail policies; publishing is left to the caller.
+func ReconcileOverdue(posts []models.Post, cutoff time.Time, policy string, snooze, grace time.Duration) OverdueResult {
+	var result OverdueResult
+
+	if snooze <= 0 {
+		snooze = defaultSnooze
+	}
+
+	for i := range posts {
+		post := &posts[i]
+		if post.Status != models.StatusScheduled || !post.ScheduledAt.Before(cutoff) {
+			continue
+		}
+
+		result.Overdue = append(result.Overdue, post.ID)
+
+		if grace > 0 && cutoff.Sub(post.ScheduledAt) <= grace {
+			result.ToPublish = append(result.ToPublish, post.ID)
+			continue
+		}
+
+		switch policy {
+		case config.OverduePublish:
+			result.ToPublish = append(result.ToPublish, post.ID)
+		case config.OverdueReview:
+			post.SetStatus(models.StatusNeedsReview, "overdue at startup")
+			post.CronEntryID = 0
+			result.Reviewed = append(result.Reviewed, post.ID)
+		case config.OverdueSnooze:
+			post.ScheduledAt = cutoff.Add(snooze).In(post.ScheduledAt.Location())
+			post.Record(post.Status, "snoozed after being overdue at startup")
+			result.Snoozed = append(result.Snoozed, post.ID)
+		case config.OverdueFail:
+			post.LastError = fmt.Sprintf("missed: scheduled for %s while the scheduler was not running",
+				post.ScheduledAt.Format("2006-01-02 15:04 MST"))
+			post.SetStatus(models.StatusFailed, "missed while offline")
+			post.CronEntryID = 0
+			result.Missed = append(result.Missed, post.ID)
+		}
+	}
+
+	return result
+}
+
+// HandleOverdue reconciles scheduled posts that became overdue while the app was offline,
+// according to the configured overdue policy, and logs a summary.
+func (s *Scheduler) HandleOverdue(ctx context.Context, cfg *config.Config) OverdueResult {
+	now, err := cfg.Now()
+	if err != nil {
+		now = time.Now() // Fallback to system time
+	}
+
+	policy := cfg.Cron.OverduePolicy
+	switch policy {
+	case "":
+		policy = config.OverdueReport
+	case config.OverdueReport, config.OverduePublish, config.OverdueReview, config.OverdueSnooze, config.OverdueFail:
+	default:
+		log.Printf("⚠️ Unknown overdue_policy %q, only reporting overdue posts", policy)
+
+		policy = config.OverdueReport
+	}
+
+	snooze := time.Duration(cfg.Cron.OverdueSnoozeMinutes) * time.Minute
+	if snooze <= 0 {
+		snooze = defaultSnooze
+	}
+
+	grace := time.Duration(cfg.Cron.OverdueGraceMinutes) * time.Minute
+
+	s.mu.Lock()
+
+	result := ReconcileOverdue(s.Posts, now, policy, snooze, grace)
+
+	// Missed posts fail like a failed publish, so their dependents are released or failed too
+	var released, missed []models.Post
+	for _, id := range result.Missed {
+		released = append(released, s.releaseDependents(id, now, false, cfg.Cron.DependencyFailurePolicy)...)
+		missed = append(missed, *s.findPost(id))
+	}
+
+	var saveErr error
+	if len(result.Reviewed) > 0 || len(result.Snoozed) > 0 || len(result.Missed) > 0 {
+		saveErr = s.savePosts()
+	}
+
+	s.mu.Unlock()
+
+	s.notifyReleased(released)
+
+	for _, post := range missed {
+		s.notifyPublished(post, errors.New(post.LastError))
+	}
+
+	if len(result.Overdue) == 0 {
+		return result
+	}
+
+	log.Printf("⏰ %d scheduled post(s) were overdue at startup: %v (policy: %s)", len(result.Overdue), result.Overdue, policy)
+
+	if saveErr != nil {
+		log.Printf("⚠️ Failed to save overdue post updates: %v", saveErr)
+	}
+
+	if len(result.Snoozed) > 0 {
+		log.Printf("😴 Snoozed overdue posts %v by %v", result.Snoozed, snooze)
+	}
+
+	if len(result.Reviewed) > 0 {
+		log.Printf("📝 Marked overdue posts %v as %s", result.Reviewed, models.StatusNeedsReview)
+	}
+
+	if len(result.Missed) > 0 {
+		log.Printf("❌ Marked overdue posts %v as %s (missed)", result.Missed, models.StatusFailed)
+	}
+
+	for _, id := range result.ToPublish {
+		if err := s.PublishToLinkedIn(ctx, id, cfg); err != nil {
+			log.Printf("❌ Catch-up publish of overdue post %d failed: %v", id, err)
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,150 @@
+package scheduler
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"PostedIn/internal/config"
+	"PostedIn/internal/models"
+)
+
+// overduePosts returns a post overdue by two hours, one due in an hour, and a posted one from yesterday.
+func overduePosts(now time.Time) []models.Post {
+	return []models.Post{
+		{ID: 1, Status: models.StatusScheduled, ScheduledAt: now.Add(-2 * time.Hour)},
+		{ID: 2, Status: models.StatusScheduled, ScheduledAt: now.Add(time.Hour)},
+		{ID: 3, Status: models.StatusPosted, ScheduledAt: now.Add(-24 * time.Hour)},
+	}
+}
+
+func TestReconcileOverduePolicies(t *testing.T) {
+	now := time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		policy     string
+		wantResult string
+		wantStatus string
+	}{
+		{config.OverdueReport, "{[1] [] [] [] []}", models.StatusScheduled},
+		{config.OverduePublish, "{[1] [1] [] [] []}", models.StatusScheduled},
+		{config.OverdueReview, "{[1] [] [1] [] []}", models.StatusNeedsReview},
+		{config.OverdueSnooze, "{[1] [] [] [1] []}", models.StatusScheduled},
+	}
+
+	for _, tt := range tests {
+		posts := overduePosts(now)
+		posts[0].CronEntryID = 1
+
+		result := ReconcileOverdue(posts, now, tt.policy, 30*time.Minute, 0)
+		if got := fmt.Sprint(result); got != tt.wantResult {
+			t.Errorf("%s: result = %s, want %s", tt.policy, got, tt.wantResult)
+		}
+
+		if posts[0].Status != tt.wantStatus {
+			t.Errorf("%s: status = %q, want %q", tt.policy, posts[0].Status, tt.wantStatus)
+		}
+
+		if posts[1].Status != models.StatusScheduled || posts[2].Status != models.StatusPosted {
+			t.Errorf("%s: posts that are not overdue changed: %+v", tt.policy, posts[1:])
+		}
+	}
+}
+
+func TestReconcileOverdueSnoozeAndReview(t *testing.T) {
+	now := time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC)
+
+	posts := overduePosts(now)
+	ReconcileOverdue(posts, now, config.OverdueSnooze, 0, 0)
+
+	if want := now.Add(defaultSnooze); !posts[0].ScheduledAt.Equal(want) {
+		t.Errorf("snoozed to %v, want %v with the default snooze", posts[0].ScheduledAt, want)
+	}
+
+	posts = overduePosts(now)
+	posts[0].CronEntryID = 1
+	ReconcileOverdue(posts, now, config.OverdueReview, 0, 0)
+
+	if posts[0].CronEntryID != 0 {
+		t.Errorf("reviewed post kept timer %d", posts[0].CronEntryID)
+	}
+}
+
+func TestHandleOverdueSavesReviewedPosts(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "posts.json")
+	s := NewScheduler(path)
+	cfg := testConfig()
+	cfg.Cron.OverduePolicy = config.OverdueReview
+
+	s.Posts = overduePosts(time.Now())
+	s.nextID = 4
+
+	result := s.HandleOverdue(t.Context(), cfg)
+	if fmt.Sprint(result.Reviewed) != "[1]" {
+		t.Fatalf("reviewed = %v, want [1]", result.Reviewed)
+	}
+
+	if got := statuses(NewScheduler(path))[1]; got != models.StatusNeedsReview {
+		t.Errorf("saved status = %q, want %q", got, models.StatusNeedsReview)
+	}
+}
+
+func TestReconcileOverdueGraceAndFail(t *testing.T) {
+	now := time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC)
+
+	posts := overduePosts(now)
+	posts = append(posts, models.Post{ID: 4, Status: models.StatusScheduled, ScheduledAt: now.Add(-5 * time.Minute)})
+	posts[0].CronEntryID = 1
+
+	result := ReconcileOverdue(posts, now, config.OverdueFail, 0, 10*time.Minute)
+	if got := fmt.Sprint(result); got != "{[1 4] [4] [] [] [1]}" {
+		t.Fatalf("result = %s, want post 4 published within the grace window and post 1 missed", got)
+	}
+
+	if posts[0].Status != models.StatusFailed || posts[0].CronEntryID != 0 {
+		t.Errorf("missed post is %q with timer %d, want failed without a timer", posts[0].Status, posts[0].CronEntryID)
+	}
+
+	if !strings.HasPrefix(posts[0].LastError, "missed:") {
+		t.Errorf("last error = %q, want it marked missed", posts[0].LastError)
+	}
+
+	if posts[3].Status != models.StatusScheduled {
+		t.Errorf("post within the grace window is %q, want it left for the caller to publish", posts[3].Status)
+	}
+}
+
+func TestHandleOverduePublishesWithinGrace(t *testing.T) {
+	useTempConfigPath(t)
+
+	path := filepath.Join(t.TempDir(), "posts.json")
+	s := NewScheduler(path)
+	cfg := testConfig()
+	cfg.DryRun = true
+	cfg.Cron.OverduePolicy = config.OverdueFail
+	cfg.Cron.OverdueGraceMinutes = 10
+	s.LoadSwitches(cfg)
+
+	now := time.Now()
+	s.Posts = []models.Post{
+		{ID: 1, Content: "long gone", Status: models.StatusScheduled, ScheduledAt: now.Add(-2 * time.Hour)},
+		{ID: 2, Content: "just missed", Status: models.StatusScheduled, ScheduledAt: now.Add(-5 * time.Minute)},
+	}
+	s.nextID = 3
+
+	result := s.HandleOverdue(t.Context(), cfg)
+	if fmt.Sprint(result.ToPublish, result.Missed) != "[2] [1]" {
+		t.Fatalf("to publish %v, missed %v, want [2] and [1]", result.ToPublish, result.Missed)
+	}
+
+	saved := statuses(NewScheduler(path))
+	if saved[1] != models.StatusFailed {
+		t.Errorf("saved status of the old post = %q, want %q", saved[1], models.StatusFailed)
+	}
+
+	if saved[2] != models.StatusPosted {
+		t.Errorf("saved status of the post within the grace window = %q, want %q", saved[2], models.StatusPosted)
+	}
+}
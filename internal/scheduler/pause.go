@@ -0,0 +1,41 @@
+package scheduler
+
+import (
+	"errors"
+
+	"PostedIn/internal/config"
+)
+
+// ErrPublishingPaused is returned by every publish path while the global kill switch is set.
+var ErrPublishingPaused = errors.New("publishing is paused - resume publishing to continue")
+
+// LoadSwitches applies the kill switch and dry-run setting persisted in cfg; call it once at
+// startup, before anything publishes.
+func (s *Scheduler) LoadSwitches(cfg *config.Config) {
+	s.paused.Store(cfg.Paused)
+	s.dryRun.Store(cfg.DryRun)
+}
+
+// IsPaused reports whether publishing is paused.
+func (s *Scheduler) IsPaused() bool {
+	return s.paused.Load()
+}
+
+// SetPaused sets or clears the publishing kill switch and persists it so it survives restarts.
+// The switch is left unchanged when saving fails.
+func (s *Scheduler) SetPaused(cfg *config.Config, paused bool) error {
+	s.switchMu.Lock()
+	defer s.switchMu.Unlock()
+
+	previous := cfg.Paused
+	cfg.Paused = paused
+
+	if err := config.SaveConfig(cfg); err != nil {
+		cfg.Paused = previous
+		return err
+	}
+
+	s.paused.Store(paused)
+
+	return nil
+}
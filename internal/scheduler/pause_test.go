@@ -0,0 +1,83 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+)
+
+func TestPausedBlocksPublishingUntilResumed(t *testing.T) {
+	useTempConfigPath(t)
+
+	s := newTestScheduler(t)
+	cfg := testConfig()
+	post := mustAdd(t, s, "held")
+
+	if err := s.SetPaused(cfg, true); err != nil {
+		t.Fatalf("SetPaused(true): %v", err)
+	}
+
+	if !cfg.Paused {
+		t.Error("SetPaused did not record the switch in the config")
+	}
+
+	if err := s.PublishToLinkedIn(context.Background(), post.ID, cfg); !errors.Is(err, ErrPublishingPaused) {
+		t.Fatalf("PublishToLinkedIn while paused = %v, want ErrPublishingPaused", err)
+	}
+
+	if err := s.SetPaused(cfg, false); err != nil {
+		t.Fatalf("SetPaused(false): %v", err)
+	}
+
+	// The claim is the step the pause blocks; publishing itself would need LinkedIn
+	if _, err := s.beginPublish(post.ID, cfg); err != nil {
+		t.Fatalf("beginPublish after resume: %v", err)
+	}
+
+	s.endPublish(post.ID)
+}
+
+func TestLoadSwitchesHonorsPersistedPause(t *testing.T) {
+	s := newTestScheduler(t)
+	cfg := testConfig()
+	cfg.Paused = true
+
+	s.LoadSwitches(cfg)
+
+	if !s.IsPaused() {
+		t.Error("a pause persisted in the config was not applied at startup")
+	}
+}
+
+func TestPauseIsRaceFree(t *testing.T) {
+	useTempConfigPath(t)
+
+	s := newTestScheduler(t)
+	cfg := testConfig()
+	post := mustAdd(t, s, "contended")
+
+	var wg sync.WaitGroup
+
+	for i := range 8 {
+		wg.Add(2)
+
+		go func() {
+			defer wg.Done()
+
+			_ = s.SetPaused(cfg, i%2 == 0)
+		}()
+
+		go func() {
+			defer wg.Done()
+
+			if _, err := s.beginPublish(post.ID, cfg); err == nil {
+				s.endPublish(post.ID)
+			}
+
+			_ = s.IsPaused()
+		}()
+	}
+
+	wg.Wait()
+}
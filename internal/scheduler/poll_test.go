@@ -0,0 +1,40 @@
+package scheduler
+
+import (
+	"testing"
+	"time"
+
+	"PostedIn/internal/models"
+	"PostedIn/pkg/linkedin"
+)
+
+func TestAddPollPost(t *testing.T) {
+	s := newTestScheduler(t)
+	at := time.Now().Add(time.Hour)
+
+	post, err := s.Add(models.Post{
+		Content:     "Vote below",
+		ScheduledAt: at,
+		PostType:    models.PostTypePoll,
+		Poll:        &models.Poll{Question: "Tabs or spaces?", Options: []string{"Tabs", "Spaces"}},
+	}, testConfig())
+	if err != nil {
+		t.Fatalf("Add: %v", err)
+	}
+
+	if !post.IsPoll() || post.Poll.Duration != linkedin.DefaultPollDuration {
+		t.Errorf("poll = %+v, want the default duration filled in", post.Poll)
+	}
+
+	invalid := []models.Post{
+		{Content: "No poll", ScheduledAt: at, PostType: models.PostTypePoll},
+		{Content: "One option", ScheduledAt: at, PostType: models.PostTypePoll, Poll: &models.Poll{Question: "Q?", Options: []string{"A"}}},
+		{Content: "Bad duration", ScheduledAt: at, PostType: models.PostTypePoll, Poll: &models.Poll{Question: "Q?", Options: []string{"A", "B"}, Duration: "FOREVER"}},
+	}
+
+	for _, p := range invalid {
+		if _, err := s.Add(p, testConfig()); err == nil {
+			t.Errorf("Add(%q) succeeded, want a poll validation error", p.Content)
+		}
+	}
+}
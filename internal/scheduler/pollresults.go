@@ -0,0 +1,173 @@
+package scheduler
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"PostedIn/internal/models"
+	"PostedIn/pkg/linkedin"
+)
+
+// resultsPlaceholder matches a {name} placeholder in a poll results template.
+var resultsPlaceholder = regexp.MustCompile(`\{(\w+)\}`)
+
+// resultsFields lists the placeholders a poll results template can use.
+var resultsFields = []string{"question", "results", "winner", "voters"}
+
+// validateResultsTemplate checks that a poll's results template fits in a post and only uses known placeholders.
+func validateResultsTemplate(template string) error {
+	if utf8.RuneCountInString(template) > linkedin.MaxPostLength {
+		return fmt.Errorf("poll results template exceeds %d characters", linkedin.MaxPostLength)
+	}
+
+	for _, match := range resultsPlaceholder.FindAllStringSubmatch(template, -1) {
+		if !slices.Contains(resultsFields, match[1]) {
+			return fmt.Errorf("unknown placeholder %s in poll results template (allowed: {%s})", match[0], strings.Join(resultsFields, "}, {"))
+		}
+	}
+
+	return nil
+}
+
+// scheduleResultsFollowUp adds the results post of a poll that just published, scheduled for when the
+// poll closes, and reports whether one was added; the caller holds the lock.
+func (s *Scheduler) scheduleResultsFollowUp(poll *models.Post, publishedAt time.Time) (models.Post, bool) {
+	if !poll.IsPoll() || poll.Poll.ResultsTemplate == "" {
+		return models.Post{}, false
+	}
+
+	followUp := models.Post{
+		ID:              s.nextID,
+		Content:         poll.Poll.ResultsTemplate,
+		ScheduledAt:     publishedAt.Add(linkedin.PollLength(poll.Poll.Duration)),
+		Status:          models.StatusScheduled,
+		CreatedAt:       publishedAt,
+		PostType:        models.PostTypeText,
+		APIVersion:      poll.APIVersion,
+		Audience:        poll.Audience,
+		Tags:            slices.Clone(poll.Tags),
+		ResultsOf:       poll.ID,
+		Account:         poll.Account, // Only the poll's author can read its results
+		AuthorType:      poll.AuthorType,
+		OrganizationURN: poll.OrganizationURN,
+		Visibility:      poll.Visibility,
+	}
+	followUp.Record(followUp.Status, fmt.Sprintf("results of poll %d", poll.ID))
+
+	s.Posts = append(s.Posts, followUp)
+	s.nextID++
+
+	return followUp, true
+}
+
+// fillPollResults replaces the template of a results follow-up with the final tallies of its poll,
+// failing when they make the post too long to publish. Other posts are left untouched.
+func (s *Scheduler) fillPollResults(ctx context.Context, client *linkedin.Client, post *models.Post) error {
+	if post.ResultsOf == 0 {
+		return nil
+	}
+
+	s.mu.RLock()
+	poll := s.findPost(post.ResultsOf)
+
+	var urn string
+	if poll != nil {
+		urn = poll.PostURN
+	}
+	s.mu.RUnlock()
+
+	switch {
+	case poll == nil:
+		return fmt.Errorf("poll post %d no longer exists, edit post %d to publish it without results", post.ResultsOf, post.ID)
+	case urn == "":
+		return fmt.Errorf("LinkedIn did not return an ID for poll post %d, so its results cannot be fetched", post.ResultsOf)
+	}
+
+	results, err := client.GetPollResults(ctx, urn)
+	if err != nil {
+		return fmt.Errorf("failed to fetch results of poll %d: %w", post.ResultsOf, err)
+	}
+
+	content := RenderPollResults(post.Content, results)
+	if length := utf8.RuneCountInString(content); length > linkedin.MaxPostLength {
+		return fmt.Errorf("results of poll %d fill post %d to %d characters, over the %d LinkedIn allows, shorten its results template",
+			post.ResultsOf, post.ID, length, linkedin.MaxPostLength)
+	}
+
+	post.Content = content
+
+	return nil
+}
+
+// RenderPollResults fills the {question}, {results}, {winner} and {voters} placeholders of a
+// results template with the poll's tallies.
+func RenderPollResults(template string, results linkedin.PollResults) string {
+	total := 0
+	for _, option := range results.Options {
+		total += option.Votes
+	}
+
+	voters := results.Voters
+	if voters == 0 {
+		voters = total
+	}
+
+	return resultsPlaceholder.ReplaceAllStringFunc(template, func(placeholder string) string {
+		switch strings.Trim(placeholder, "{}") {
+		case "question":
+			return results.Question
+		case "results":
+			return formatPollTallies(results.Options, total)
+		case "winner":
+			return pollWinner(results.Options)
+		case "voters":
+			return strconv.Itoa(voters)
+		}
+
+		return placeholder
+	})
+}
+
+// formatPollTallies lists each option with its votes and share of all votes, one per line.
+func formatPollTallies(options []linkedin.PollOptionResult, total int) string {
+	lines := make([]string, 0, len(options))
+
+	for _, option := range options {
+		share := 0
+		if total > 0 {
+			share = option.Votes * 100 / total
+		}
+
+		lines = append(lines, fmt.Sprintf("%s: %d votes (%d%%)", option.Text, option.Votes, share))
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// pollWinner returns the option with the most votes, joining tied options with " / ".
+func pollWinner(options []linkedin.PollOptionResult) string {
+	best := 0
+	for _, option := range options {
+		best = max(best, option.Votes)
+	}
+
+	if best == 0 {
+		return "no votes"
+	}
+
+	var winners []string
+
+	for _, option := range options {
+		if option.Votes == best {
+			winners = append(winners, option.Text)
+		}
+	}
+
+	return strings.Join(winners, " / ")
+}
@@ -0,0 +1,265 @@
+package scheduler
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"PostedIn/internal/models"
+	"PostedIn/pkg/linkedin"
+)
+
+const resultsTemplate = "Results of {question}\n{results}\nWinner: {winner} ({voters} voters)"
+
+// addResultsPoll schedules a three-day poll whose results are posted when it closes.
+func addResultsPoll(t *testing.T, s *Scheduler) models.Post {
+	t.Helper()
+
+	post, err := s.Add(models.Post{
+		Content:     "Vote below",
+		ScheduledAt: time.Now().Add(time.Hour),
+		PostType:    models.PostTypePoll,
+		Tags:        []string{"survey"},
+		Poll: &models.Poll{
+			Question:        "Tabs or spaces?",
+			Options:         []string{"Tabs", "Spaces"},
+			Duration:        "THREE_DAYS",
+			ResultsTemplate: resultsTemplate,
+		},
+	}, testConfig())
+	if err != nil {
+		t.Fatalf("Add: %v", err)
+	}
+
+	return post
+}
+
+// resultsFollowUp returns the results post of a poll.
+func resultsFollowUp(t *testing.T, s *Scheduler, pollID int) models.Post {
+	t.Helper()
+
+	for _, post := range s.GetPosts() {
+		if post.ResultsOf == pollID {
+			return post
+		}
+	}
+
+	t.Fatalf("no results post was scheduled for poll %d", pollID)
+
+	return models.Post{}
+}
+
+func TestPollSchedulesResultsFollowUp(t *testing.T) {
+	cfg := testConfig()
+	cfg.DryRun = true
+
+	s := newTestScheduler(t)
+	s.LoadSwitches(cfg)
+
+	var released []models.Post
+
+	s.OnDependentsReleased(func(posts []models.Post) { released = append(released, posts...) })
+
+	poll := addResultsPoll(t, s)
+
+	if err := s.PublishToLinkedIn(context.Background(), poll.ID, cfg); err != nil {
+		t.Fatalf("PublishToLinkedIn: %v", err)
+	}
+
+	published := findByID(t, s, poll.ID)
+	followUp := resultsFollowUp(t, s, poll.ID)
+
+	if want := published.PublishedAt.Add(72 * time.Hour); !followUp.ScheduledAt.Equal(want) {
+		t.Errorf("follow-up scheduled at %v, want %v when the poll closes", followUp.ScheduledAt, want)
+	}
+
+	if followUp.Status != models.StatusScheduled || followUp.Content != resultsTemplate || len(followUp.Tags) != 1 {
+		t.Errorf("follow-up = %+v, want a scheduled post with the template and the poll's tags", followUp)
+	}
+
+	// The auto-scheduler arms released posts, so the follow-up must be among them
+	if len(released) != 1 || released[0].ID != followUp.ID {
+		t.Errorf("released %+v, want the follow-up", released)
+	}
+}
+
+func TestResultsFollowUpPublishesTallies(t *testing.T) {
+	var (
+		mu    sync.Mutex
+		texts []string
+	)
+
+	cfg := useFakeLinkedIn(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet {
+			if !strings.HasSuffix(r.URL.Path, "/urn:li:share:42") {
+				http.NotFound(w, r)
+				return
+			}
+
+			_, _ = io.WriteString(w, `{"content": {"poll": {"question": "Tabs or spaces?", "uniqueVotersCount": 4,
+				"options": [{"text": "Tabs", "voteCount": 1}, {"text": "Spaces", "voteCount": 3}]}}}`)
+
+			return
+		}
+
+		var body struct {
+			Commentary string `json:"commentary"`
+		}
+		_ = json.NewDecoder(r.Body).Decode(&body)
+
+		mu.Lock()
+		texts = append(texts, body.Commentary)
+		mu.Unlock()
+
+		w.Header().Set("x-restli-id", "urn:li:share:42")
+		w.WriteHeader(http.StatusCreated)
+	}))
+
+	s := newTestScheduler(t)
+	poll := addResultsPoll(t, s)
+
+	if err := s.PublishToLinkedIn(context.Background(), poll.ID, cfg); err != nil {
+		t.Fatalf("publish poll: %v", err)
+	}
+
+	if urn := findByID(t, s, poll.ID).PostURN; urn != "urn:li:share:42" {
+		t.Fatalf("poll URN = %q, want the one LinkedIn returned", urn)
+	}
+
+	followUp := resultsFollowUp(t, s, poll.ID)
+	if err := s.PublishToLinkedIn(context.Background(), followUp.ID, cfg); err != nil {
+		t.Fatalf("publish follow-up: %v", err)
+	}
+
+	want := "Results of Tabs or spaces?\nTabs: 1 votes (25%)\nSpaces: 3 votes (75%)\nWinner: Spaces (4 voters)"
+
+	mu.Lock()
+	defer mu.Unlock()
+
+	if len(texts) != 2 || texts[1] != want {
+		t.Fatalf("published %q, want the follow-up as %q", texts, want)
+	}
+
+	if published := findByID(t, s, followUp.ID); published.Status != models.StatusPosted || published.Content != want {
+		t.Errorf("follow-up = %+v, want it posted with the results filled in", published)
+	}
+}
+
+func TestResultsFollowUpFetchFailure(t *testing.T) {
+	cfg := useFakeLinkedIn(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet {
+			http.Error(w, `{"message": "internal error"}`, http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("x-restli-id", "urn:li:share:42")
+		w.WriteHeader(http.StatusCreated)
+	}))
+
+	s := newTestScheduler(t)
+	poll := addResultsPoll(t, s)
+
+	if err := s.PublishToLinkedIn(context.Background(), poll.ID, cfg); err != nil {
+		t.Fatalf("publish poll: %v", err)
+	}
+
+	followUp := resultsFollowUp(t, s, poll.ID)
+
+	err := s.PublishToLinkedIn(context.Background(), followUp.ID, cfg)
+	if err == nil || !strings.Contains(err.Error(), "failed to fetch results of poll") {
+		t.Fatalf("publish follow-up = %v, want the results fetch error", err)
+	}
+
+	// The template is not published with raw placeholders; the server error is retried later
+	retried := findByID(t, s, followUp.ID)
+	if retried.Status != models.StatusScheduled || retried.Content != resultsTemplate || retried.Attempts != 1 {
+		t.Errorf("follow-up = %+v, want it rescheduled with the template kept", retried)
+	}
+
+	if !strings.Contains(retried.LastError, "failed to fetch results of poll") {
+		t.Errorf("last error = %q, want the results fetch error", retried.LastError)
+	}
+
+	if posted := findByID(t, s, poll.ID); posted.Status != models.StatusPosted {
+		t.Errorf("poll status = %q, want posted", posted.Status)
+	}
+}
+
+func TestResultsFollowUpTooLong(t *testing.T) {
+	var posts atomic.Int32
+
+	cfg := useFakeLinkedIn(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet {
+			question := strings.Repeat("q", linkedin.MaxPostLength)
+			_, _ = io.WriteString(w, `{"content": {"poll": {"question": "`+question+`",
+				"options": [{"text": "Tabs", "voteCount": 1}, {"text": "Spaces", "voteCount": 3}]}}}`)
+
+			return
+		}
+
+		posts.Add(1)
+
+		w.Header().Set("x-restli-id", "urn:li:share:42")
+		w.WriteHeader(http.StatusCreated)
+	}))
+
+	s := newTestScheduler(t)
+	poll := addResultsPoll(t, s)
+
+	if err := s.PublishToLinkedIn(context.Background(), poll.ID, cfg); err != nil {
+		t.Fatalf("publish poll: %v", err)
+	}
+
+	followUp := resultsFollowUp(t, s, poll.ID)
+
+	err := s.PublishToLinkedIn(context.Background(), followUp.ID, cfg)
+	if err == nil || !strings.Contains(err.Error(), "shorten its results template") {
+		t.Fatalf("publish follow-up = %v, want the length error", err)
+	}
+
+	if n := posts.Load(); n != 1 {
+		t.Errorf("LinkedIn received %d posts, want only the poll", n)
+	}
+
+	// Retrying cannot shorten the results, so the follow-up fails with its template kept
+	failed := findByID(t, s, followUp.ID)
+	if failed.Status != models.StatusFailed || failed.Content != resultsTemplate {
+		t.Errorf("follow-up = %+v, want it failed with the template kept", failed)
+	}
+}
+
+func TestRenderPollResults(t *testing.T) {
+	results := linkedin.PollResults{
+		Question: "Best day?",
+		Options:  []linkedin.PollOptionResult{{Text: "Mon", Votes: 2}, {Text: "Fri", Votes: 2}, {Text: "Sun"}},
+	}
+
+	got := RenderPollResults("{question} {winner} {voters} {other}", results)
+	if want := "Best day? Mon / Fri 4 {other}"; got != want {
+		t.Errorf("RenderPollResults = %q, want %q", got, want)
+	}
+
+	if got := RenderPollResults("{winner}", linkedin.PollResults{Options: []linkedin.PollOptionResult{{Text: "A"}}}); got != "no votes" {
+		t.Errorf("winner without votes = %q, want %q", got, "no votes")
+	}
+}
+
+func TestValidateResultsTemplate(t *testing.T) {
+	if err := validateResultsTemplate(resultsTemplate); err != nil {
+		t.Errorf("validateResultsTemplate: %v", err)
+	}
+
+	if err := validateResultsTemplate("{loser} lost"); err == nil {
+		t.Error("validateResultsTemplate accepted an unknown placeholder")
+	}
+
+	if err := validateResultsTemplate(strings.Repeat("a", linkedin.MaxPostLength+1)); err == nil {
+		t.Error("validateResultsTemplate accepted a template longer than a post")
+	}
+}
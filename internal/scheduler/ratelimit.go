@@ -0,0 +1,29 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"PostedIn/internal/config"
+	"PostedIn/internal/ratelimit"
+)
+
+// ErrRateLimited is returned when the rate limits did not allow a publish before its deadline.
+var ErrRateLimited = errors.New("publish rate limit reached")
+
+// publishLimits is shared by every scheduler in the process, so the global limit spans all accounts.
+var publishLimits = ratelimit.NewLayered()
+
+// acquirePublishSlot waits until the account's and the global rate limits allow another publish.
+// The returned function frees the slot.
+func acquirePublishSlot(ctx context.Context, cfg *config.Config) (func(), error) {
+	account := cfg.AccountID()
+
+	release, err := publishLimits.Acquire(ctx, account, cfg.RateLimits.Global, cfg.RateLimits.AccountPolicy(account))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrRateLimited, err)
+	}
+
+	return release, nil
+}
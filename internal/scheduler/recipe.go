@@ -0,0 +1,170 @@
+package scheduler
+
+import (
+	"fmt"
+	"maps"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"PostedIn/internal/config"
+	"PostedIn/internal/models"
+	"PostedIn/internal/timezone"
+	"PostedIn/pkg/linkedin"
+)
+
+// BuildRecipePost assembles a post from the named recipe, filling its template with vars and
+// scheduling it at the given time or phrase, or at the recipe's schedule when at is empty.
+// The post is not stored; add it with Add, which validates the image, audience and tags.
+func BuildRecipePost(cfg *config.Config, name string, vars map[string]string, at string) (models.Post, error) {
+	recipe, err := cfg.Recipe(name)
+	if err != nil {
+		return models.Post{}, err
+	}
+
+	if at == "" {
+		at = recipe.Schedule
+	}
+
+	if at == "" {
+		return models.Post{}, fmt.Errorf("recipe %q has no schedule; pass a time", name)
+	}
+
+	now, err := cfg.Now()
+	if err != nil {
+		now = time.Now()
+	}
+
+	scheduledAt, err := timezone.ParseSchedule(at, now)
+	if err != nil {
+		return models.Post{}, fmt.Errorf("recipe %q: %w", name, err)
+	}
+
+	if scheduledAt.Before(now) {
+		return models.Post{}, fmt.Errorf("recipe %q schedules the post at %s, which is in the past", name, scheduledAt.Format("2006-01-02 15:04 MST"))
+	}
+
+	if err := CheckLeadTime(scheduledAt, cfg); err != nil {
+		return models.Post{}, err
+	}
+
+	content, err := RenderRecipe(cfg, name, vars, scheduledAt)
+	if err != nil {
+		return models.Post{}, err
+	}
+
+	return models.Post{
+		Content:      content,
+		ScheduledAt:  scheduledAt,
+		PostType:     models.PostTypeText,
+		Tags:         recipe.Tags,
+		ImagePath:    recipe.ImagePath,
+		ImageAltText: recipe.ImageAltText,
+		Audience:     recipe.Audience,
+	}, nil
+}
+
+// RenderRecipe fills the named recipe's template with vars. Date functions such as {{date}} render
+// at, the post's publish time, in the configured timezone.
+func RenderRecipe(cfg *config.Config, name string, vars map[string]string, at time.Time) (string, error) {
+	recipe, err := cfg.Recipe(name)
+	if err != nil {
+		return "", err
+	}
+
+	tmpl, err := recipe.ParseTemplate(name)
+	if err != nil {
+		return "", fmt.Errorf("recipe %q: %w", name, err)
+	}
+
+	if loc, err := cfg.GetTimezone(); err == nil {
+		at = at.In(loc)
+	}
+
+	var content strings.Builder
+	if err := tmpl.Funcs(config.TemplateFuncs(at)).Execute(&content, vars); err != nil {
+		return "", fmt.Errorf("recipe %q: %w", name, err)
+	}
+
+	return content.String(), nil
+}
+
+// missingKeyPattern matches the error text/template reports for a variable vars does not set.
+var missingKeyPattern = regexp.MustCompile(`map has no entry for key "([^"]*)"`)
+
+// RecipeTest is the outcome of test-rendering one recipe's template.
+type RecipeTest struct {
+	Name       string   `json:"name"`
+	Content    string   `json:"content,omitempty"`
+	Unresolved []string `json:"unresolved,omitempty"` // Variables the template uses that no sample value sets
+	Error      string   `json:"error,omitempty"`
+}
+
+// Passed reports whether the template rendered with every variable set.
+func (t RecipeTest) Passed() bool {
+	return t.Error == "" && len(t.Unresolved) == 0
+}
+
+// TestRecipes renders every recipe's template with its sample_vars, overridden by vars, without
+// creating posts, and reports the variables left unresolved and any other error. Date functions
+// render today.
+func TestRecipes(cfg *config.Config, vars map[string]string) []RecipeTest {
+	now, err := cfg.Now()
+	if err != nil {
+		now = time.Now()
+	}
+
+	names := cfg.RecipeNames()
+	results := make([]RecipeTest, 0, len(names))
+
+	for _, name := range names {
+		results = append(results, testRecipe(cfg, name, vars, now))
+	}
+
+	return results
+}
+
+// testRecipe renders one recipe, setting each missing variable to empty and rendering again so
+// every unresolved variable is reported, not only the first.
+func testRecipe(cfg *config.Config, name string, vars map[string]string, at time.Time) RecipeTest {
+	result := RecipeTest{Name: name}
+
+	sample := maps.Clone(cfg.Recipes[name].SampleVars)
+	if sample == nil {
+		sample = make(map[string]string)
+	}
+
+	maps.Copy(sample, vars)
+
+	for {
+		content, err := RenderRecipe(cfg, name, sample, at)
+		if err == nil {
+			result.Content = content
+			break
+		}
+
+		match := missingKeyPattern.FindStringSubmatch(err.Error())
+		if match == nil || slices.Contains(result.Unresolved, match[1]) {
+			result.Error = err.Error()
+			return result
+		}
+
+		result.Unresolved = append(result.Unresolved, match[1])
+		sample[match[1]] = ""
+	}
+
+	if len(result.Unresolved) > 0 {
+		return result
+	}
+
+	switch {
+	case strings.TrimSpace(result.Content) == "":
+		result.Error = "template renders empty content"
+	case utf8.RuneCountInString(result.Content) > linkedin.MaxPostLength:
+		result.Error = fmt.Sprintf("rendered content exceeds %d characters", linkedin.MaxPostLength)
+	}
+
+	return result
+}
@@ -0,0 +1,160 @@
+package scheduler
+
+import (
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"PostedIn/internal/config"
+	"PostedIn/internal/models"
+	"PostedIn/pkg/linkedin"
+)
+
+func TestTestRecipes(t *testing.T) {
+	cfg := testConfig()
+	cfg.Recipes = map[string]config.Recipe{
+		"release": {
+			Template:   "Version {{.version}} of {{.product}} is out",
+			SampleVars: map[string]string{"version": "1.0", "product": "PostedIn"},
+		},
+		"dated":     {Template: "Notes for {{weekday}} {{date}}"},
+		"missing":   {Template: "{{.greeting}}, {{.who}} and {{.greeting}} again"},
+		"broken":    {Template: "Hello {{.name"},
+		"empty":     {Template: "{{if .show}}hidden{{end}}", SampleVars: map[string]string{"show": ""}},
+		"too-long":  {Template: strings.Repeat("x", 3001)},
+		"overrides": {Template: "Hi {{.name}}", SampleVars: map[string]string{"name": "sample"}},
+	}
+
+	results := TestRecipes(cfg, map[string]string{"name": "override"})
+
+	byName := make(map[string]RecipeTest, len(results))
+	for _, result := range results {
+		byName[result.Name] = result
+	}
+
+	if len(results) != len(cfg.Recipes) {
+		t.Fatalf("got %d results, want one per recipe (%d)", len(results), len(cfg.Recipes))
+	}
+
+	for _, name := range []string{"release", "dated", "overrides"} {
+		if !byName[name].Passed() {
+			t.Errorf("%s failed: %+v", name, byName[name])
+		}
+	}
+
+	if got := byName["release"].Content; got != "Version 1.0 of PostedIn is out" {
+		t.Errorf("release rendered %q", got)
+	}
+
+	if want := "Notes for " + time.Now().UTC().Weekday().String(); !strings.HasPrefix(byName["dated"].Content, want) {
+		t.Errorf("dated rendered %q, want prefix %q", byName["dated"].Content, want)
+	}
+
+	if got := byName["overrides"].Content; got != "Hi override" {
+		t.Errorf("overrides rendered %q, want --var to override sample_vars", got)
+	}
+
+	missing := byName["missing"]
+	if missing.Passed() || missing.Error != "" || !slices.Equal(missing.Unresolved, []string{"greeting", "who"}) {
+		t.Errorf("missing = %+v, want unresolved greeting and who", missing)
+	}
+
+	for _, name := range []string{"broken", "empty", "too-long"} {
+		if byName[name].Passed() || byName[name].Error == "" {
+			t.Errorf("%s = %+v, want an error", name, byName[name])
+		}
+	}
+}
+
+func TestTestRecipesNoRecipes(t *testing.T) {
+	if results := TestRecipes(testConfig(), nil); len(results) != 0 {
+		t.Errorf("got %d results, want none", len(results))
+	}
+}
+
+func TestBuildRecipePost(t *testing.T) {
+	cfg := testConfig()
+	cfg.Audiences = map[string]linkedin.Audience{"devs": {JobFunctions: []string{"urn:li:function:8"}}}
+	cfg.Recipes = map[string]config.Recipe{
+		"release": {
+			Template:     "{{.product}} {{.version}} ships {{weekday}} {{date}}",
+			Schedule:     "in 3 days",
+			Tags:         []string{"release"},
+			ImagePath:    "images/release.png",
+			ImageAltText: "Release banner",
+			Audience:     "devs",
+		},
+		"unscheduled": {Template: "Ad hoc"},
+	}
+
+	post, err := BuildRecipePost(cfg, "release", map[string]string{"product": "PostedIn", "version": "2.0"}, "")
+	if err != nil {
+		t.Fatalf("BuildRecipePost: %v", err)
+	}
+
+	want := time.Now().UTC().AddDate(0, 0, 3)
+	if diff := post.ScheduledAt.Sub(want); diff < -time.Minute || diff > time.Minute {
+		t.Errorf("scheduled at %v, want the recipe's schedule %v", post.ScheduledAt, want)
+	}
+
+	if wantContent := "PostedIn 2.0 ships " + want.Weekday().String() + " " + want.Format("2006-01-02"); post.Content != wantContent {
+		t.Errorf("content = %q, want %q with dates of the publish day", post.Content, wantContent)
+	}
+
+	if !slices.Equal(post.Tags, []string{"release"}) || post.ImagePath != "images/release.png" ||
+		post.ImageAltText != "Release banner" || post.Audience != "devs" || post.PostType != models.PostTypeText {
+		t.Errorf("post = %+v, want the recipe's tags, image and audience", post)
+	}
+
+	explicit, err := BuildRecipePost(cfg, "unscheduled", nil, "2099-05-01 10:00")
+	if err != nil {
+		t.Fatalf("BuildRecipePost with a time: %v", err)
+	}
+
+	if !explicit.ScheduledAt.Equal(time.Date(2099, 5, 1, 10, 0, 0, 0, time.UTC)) {
+		t.Errorf("scheduled at %v, want the given time", explicit.ScheduledAt)
+	}
+}
+
+func TestBuildRecipePostErrors(t *testing.T) {
+	cfg := testConfig()
+	cfg.Recipes = map[string]config.Recipe{
+		"release":     {Template: "{{.version}} is out", Schedule: "tomorrow 9am"},
+		"unscheduled": {Template: "Ad hoc"},
+	}
+
+	tests := []struct {
+		name, recipe, at string
+		vars             map[string]string
+	}{
+		{"unknown recipe", "missing", "", nil},
+		{"no schedule", "unscheduled", "", nil},
+		{"past time", "release", "2000-01-01 09:00", map[string]string{"version": "1"}},
+		{"bad phrase", "release", "someday", map[string]string{"version": "1"}},
+		{"missing var", "release", "", nil},
+	}
+
+	for _, tt := range tests {
+		if _, err := BuildRecipePost(cfg, tt.recipe, tt.vars, tt.at); err == nil {
+			t.Errorf("%s: BuildRecipePost succeeded, want an error", tt.name)
+		}
+	}
+}
+
+func TestRenderRecipeUsesTimezone(t *testing.T) {
+	cfg := newYorkConfig(t)
+	cfg.Recipes = map[string]config.Recipe{"dated": {Template: "{{weekday}} {{date}}"}}
+
+	// 02:00 UTC on a Saturday is still Friday evening in New York
+	at := time.Date(2026, 10, 17, 2, 0, 0, 0, time.UTC)
+
+	content, err := RenderRecipe(cfg, "dated", nil, at)
+	if err != nil {
+		t.Fatalf("RenderRecipe: %v", err)
+	}
+
+	if content != "Friday 2026-10-16" {
+		t.Errorf("content = %q, want the New York date", content)
+	}
+}
@@ -0,0 +1,150 @@
+package scheduler
+
+import (
+	"fmt"
+	"log"
+	"maps"
+	"slices"
+	"strings"
+	"time"
+
+	"PostedIn/internal/models"
+
+	"github.com/robfig/cron/v3"
+)
+
+// Named recurrences; any other value is read as a standard five-field cron expression.
+const (
+	RecurrenceDaily   = "daily"
+	RecurrenceWeekly  = "weekly"
+	RecurrenceMonthly = "monthly"
+)
+
+// normalizeRecurrence lower-cases named recurrences and rejects invalid cron expressions and
+// posts whose time is derived from another post or an event.
+func normalizeRecurrence(post *models.Post) error {
+	post.Recurrence = strings.TrimSpace(post.Recurrence)
+	if post.Recurrence == "" {
+		return nil
+	}
+
+	if named := strings.ToLower(post.Recurrence); isNamedRecurrence(named) {
+		post.Recurrence = named
+	} else if _, err := cron.ParseStandard(post.Recurrence); err != nil {
+		return fmt.Errorf("invalid recurrence %q, use %s, %s, %s or a cron expression: %w",
+			post.Recurrence, RecurrenceDaily, RecurrenceWeekly, RecurrenceMonthly, err)
+	}
+
+	switch {
+	case post.DependsOn > 0:
+		return fmt.Errorf("a recurring post cannot depend on another post")
+	case post.Event != "":
+		return fmt.Errorf("a recurring post cannot follow an event")
+	case post.ResultsOf > 0:
+		return fmt.Errorf("a poll results post cannot recur")
+	}
+
+	return nil
+}
+
+// isNamedRecurrence reports whether recurrence is daily, weekly or monthly.
+func isNamedRecurrence(recurrence string) bool {
+	return recurrence == RecurrenceDaily || recurrence == RecurrenceWeekly || recurrence == RecurrenceMonthly
+}
+
+// nextOccurrence returns the first time after from that the recurrence fires, skipping forward past
+// occurrences that are not after now so a late publish does not schedule a post in the past.
+func nextOccurrence(recurrence string, from, now time.Time) (time.Time, error) {
+	if isNamedRecurrence(recurrence) {
+		next := stepRecurrence(recurrence, from, 1)
+		for n := 2; !next.After(now); n++ {
+			next = stepRecurrence(recurrence, from, n)
+		}
+
+		return next, nil
+	}
+
+	schedule, err := cron.ParseStandard(recurrence)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid recurrence %q: %w", recurrence, err)
+	}
+
+	next := schedule.Next(from)
+	if !next.After(now) {
+		next = schedule.Next(now.In(from.Location()))
+	}
+
+	if next.IsZero() {
+		return time.Time{}, fmt.Errorf("recurrence %q never fires again", recurrence)
+	}
+
+	return next, nil
+}
+
+// stepRecurrence returns the nth occurrence of a named recurrence after from. Monthly occurrences
+// keep from's day of month, falling back to the last day of shorter months.
+func stepRecurrence(recurrence string, from time.Time, n int) time.Time {
+	switch recurrence {
+	case RecurrenceDaily:
+		return from.AddDate(0, 0, n)
+	case RecurrenceWeekly:
+		return from.AddDate(0, 0, 7*n)
+	}
+
+	firstOfMonth := time.Date(from.Year(), from.Month()+time.Month(n), 1,
+		from.Hour(), from.Minute(), from.Second(), from.Nanosecond(), from.Location())
+	lastDay := firstOfMonth.AddDate(0, 1, -1).Day()
+
+	return firstOfMonth.AddDate(0, 0, min(from.Day(), lastDay)-1)
+}
+
+// scheduleNextOccurrence adds a fresh scheduled copy of a recurring post that just published, at its
+// next occurrence, and reports whether one was added; the caller holds the lock.
+func (s *Scheduler) scheduleNextOccurrence(post *models.Post, publishedAt time.Time) (models.Post, bool) {
+	if post.Recurrence == "" {
+		return models.Post{}, false
+	}
+
+	scheduledAt, err := nextOccurrence(post.Recurrence, post.ScheduledAt, publishedAt)
+	if err != nil {
+		log.Printf("⚠️ Post %d will not recur: %v", post.ID, err)
+		return models.Post{}, false
+	}
+
+	next := models.Post{
+		ID:              s.nextID,
+		Content:         post.Content,
+		ScheduledAt:     scheduledAt,
+		Status:          models.StatusScheduled,
+		CreatedAt:       publishedAt,
+		PostType:        post.PostType,
+		Language:        post.Language,
+		Variants:        maps.Clone(post.Variants),
+		TargetLanguage:  post.TargetLanguage,
+		APIVersion:      post.APIVersion,
+		ImagePath:       post.ImagePath,
+		ImageAltText:    post.ImageAltText,
+		VideoPath:       post.VideoPath,
+		VideoTitle:      post.VideoTitle,
+		Audience:        post.Audience,
+		Tags:            slices.Clone(post.Tags),
+		Recurrence:      post.Recurrence,
+		Account:         post.Account,
+		AuthorType:      post.AuthorType,
+		OrganizationURN: post.OrganizationURN,
+		Visibility:      post.Visibility,
+	}
+
+	if post.Poll != nil {
+		poll := *post.Poll
+		poll.Options = slices.Clone(post.Poll.Options)
+		next.Poll = &poll
+	}
+
+	next.Record(next.Status, fmt.Sprintf("recurs %s after post %d", post.Recurrence, post.ID))
+
+	s.Posts = append(s.Posts, next)
+	s.nextID++
+
+	return next, true
+}
@@ -0,0 +1,93 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net"
+	"time"
+
+	"PostedIn/internal/config"
+	"PostedIn/internal/models"
+	"PostedIn/pkg/linkedin"
+)
+
+// ErrNotFailed is returned when retrying a post that has not failed.
+var ErrNotFailed = errors.New("only failed posts can be retried")
+
+// RetryPost resets a failed post to scheduled for now, with fresh retry and deferral budgets,
+// and publishes it again. Posts in any other state are rejected with ErrNotFailed.
+func (s *Scheduler) RetryPost(ctx context.Context, id int, cfg *config.Config) error {
+	if err := s.resetFailed(id, cfg); err != nil {
+		return err
+	}
+
+	return s.PublishToLinkedIn(ctx, id, cfg)
+}
+
+// resetFailed puts a failed post back in the schedule, due now.
+func (s *Scheduler) resetFailed(id int, cfg *config.Config) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	post := s.findPost(id)
+	if post == nil {
+		return fmt.Errorf("post %d: %w", id, ErrPostNotFound)
+	}
+
+	if post.Status != models.StatusFailed {
+		return fmt.Errorf("post %d is %s: %w", id, post.Status, ErrNotFailed)
+	}
+
+	now, err := cfg.Now()
+	if err != nil {
+		now = time.Now() // Fallback to system time
+	}
+
+	post.ScheduledAt = now
+	post.Attempts = 0
+	post.DeferredCount = 0
+	post.LastError = ""
+	post.SetStatus(models.StatusScheduled, "retried")
+
+	return s.savePosts()
+}
+
+// retryable reports whether a failed publish may succeed when simply tried again: LinkedIn server
+// errors and network failures. Timeouts are not retried since the next attempt would likely time out too.
+func retryable(err error) bool {
+	if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+		return false
+	}
+
+	if errors.Is(err, linkedin.ErrServerError) {
+		return true
+	}
+
+	var netErr net.Error
+
+	return errors.As(err, &netErr)
+}
+
+// retryPost reschedules a post whose publish failed with a transient error, backing off per the
+// cron retry settings. It returns false when the error is permanent or the attempts are used up,
+// and the post should fail instead.
+func retryPost(post *models.Post, publishErr error, cfg *config.Config) bool {
+	if !retryable(publishErr) {
+		return false
+	}
+
+	delay, ok := cfg.Cron.RetryDelay(post.Attempts)
+	if !ok {
+		return false
+	}
+
+	post.LastError = publishErr.Error()
+	post.ScheduledAt = time.Now().In(post.ScheduledAt.Location()).Add(delay)
+	post.Record(post.Status, fmt.Sprintf("attempt %d failed, retrying", post.Attempts))
+
+	log.Printf("🔁 Publishing post %d failed on attempt %d, retrying in %v: %v", post.ID, post.Attempts, delay, publishErr)
+
+	return true
+}
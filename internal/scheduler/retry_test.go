@@ -0,0 +1,132 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"testing"
+	"time"
+
+	"PostedIn/internal/models"
+	"PostedIn/pkg/linkedin"
+)
+
+// serverError is what the client returns for a 500 from LinkedIn.
+func serverError() error {
+	apiErr := &linkedin.APIError{Kind: "API", StatusCode: http.StatusInternalServerError, Body: "oops"}
+
+	return fmt.Errorf("%w: %w", linkedin.ErrServerError, apiErr)
+}
+
+// failAttempt runs a publish attempt of the post that fails with publishErr.
+func failAttempt(t *testing.T, s *Scheduler, id int, publishErr error) error {
+	t.Helper()
+
+	cfg := testConfig()
+	cfg.Cron.RetryMaxAttempts = 3
+	cfg.Cron.RetryInitialDelaySeconds = 10
+
+	if _, err := s.beginPublish(id, cfg); err != nil {
+		t.Fatalf("beginPublish: %v", err)
+	}
+
+	defer s.endPublish(id)
+
+	_, _, err := s.finishPublish(id, publishOutcome{}, publishErr, cfg)
+
+	return err
+}
+
+func TestServerErrorRetriesWithBackoff(t *testing.T) {
+	s := newTestScheduler(t)
+	post := mustAdd(t, s, "flaky LinkedIn")
+
+	for attempt, wantDelay := range []time.Duration{10 * time.Second, 20 * time.Second} {
+		before := time.Now()
+
+		err := failAttempt(t, s, post.ID, serverError())
+		if !errors.Is(err, ErrPublishDeferred) || !errors.Is(err, linkedin.ErrServerError) {
+			t.Fatalf("attempt %d: error = %v, want a retry caused by the server error", attempt+1, err)
+		}
+
+		retried := findByID(t, s, post.ID)
+		if retried.Status != models.StatusScheduled || retried.Attempts != attempt+1 || retried.LastError == "" {
+			t.Fatalf("attempt %d: post = %+v, want it scheduled again with the attempt counted", attempt+1, retried)
+		}
+
+		if retryAt := retried.ScheduledAt; retryAt.Before(before.Add(wantDelay)) || retryAt.After(time.Now().Add(wantDelay)) {
+			t.Errorf("attempt %d: retry at %v, want %v from now", attempt+1, retryAt, wantDelay)
+		}
+	}
+
+	// The third attempt uses up retry_max_attempts
+	if err := failAttempt(t, s, post.ID, serverError()); err == nil || errors.Is(err, ErrPublishDeferred) {
+		t.Fatalf("last attempt error = %v, want the publish to fail", err)
+	}
+
+	if failed := findByID(t, s, post.ID); failed.Status != models.StatusFailed || failed.Attempts != 3 {
+		t.Errorf("post = %s after %d attempts, want failed after 3", failed.Status, failed.Attempts)
+	}
+}
+
+func TestPermanentErrorFailsAtOnce(t *testing.T) {
+	s := newTestScheduler(t)
+	post := mustAdd(t, s, "rejected")
+
+	rejected := &linkedin.APIError{Kind: "API", StatusCode: http.StatusUnprocessableEntity, Body: "duplicate"}
+	if err := failAttempt(t, s, post.ID, rejected); err == nil || errors.Is(err, ErrPublishDeferred) {
+		t.Fatalf("error = %v, want the publish to fail", err)
+	}
+
+	if failed := findByID(t, s, post.ID); failed.Status != models.StatusFailed || failed.Attempts != 1 {
+		t.Errorf("post = %s after %d attempts, want failed after 1", failed.Status, failed.Attempts)
+	}
+}
+
+func TestRetryPost(t *testing.T) {
+	cfg := testConfig()
+	cfg.DryRun = true
+
+	s := newTestScheduler(t)
+	s.LoadSwitches(cfg)
+
+	post := mustAdd(t, s, "try again")
+
+	if err := s.RetryPost(context.Background(), post.ID, cfg); !errors.Is(err, ErrNotFailed) {
+		t.Fatalf("RetryPost of a scheduled post = %v, want ErrNotFailed", err)
+	}
+
+	if err := failAttempt(t, s, post.ID, errors.New("bad request")); err == nil {
+		t.Fatal("publish did not fail")
+	}
+
+	if err := s.RetryPost(context.Background(), post.ID, cfg); err != nil {
+		t.Fatalf("RetryPost: %v", err)
+	}
+
+	if published := findByID(t, s, post.ID); published.Status != models.StatusPosted || published.LastError != "" {
+		t.Errorf("post = %s (last error %q), want it posted with the error cleared", published.Status, published.LastError)
+	}
+}
+
+func TestRetryable(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{"server error", serverError(), true},
+		{"network", &net.OpError{Op: "dial", Err: errors.New("connection refused")}, true},
+		{"timeout", fmt.Errorf("publish: %w", context.DeadlineExceeded), false},
+		{"client error", &linkedin.APIError{Kind: "API", StatusCode: http.StatusBadRequest}, false},
+		{"other", errors.New("invalid post"), false},
+	}
+
+	for _, tt := range tests {
+		if got := retryable(tt.err); got != tt.want {
+			t.Errorf("%s: retryable = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
@@ -3,29 +3,57 @@ package scheduler
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
+	"net/http"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	"PostedIn/internal/config"
 	"PostedIn/internal/models"
+	"PostedIn/internal/transform"
 	"PostedIn/pkg/linkedin"
 	"PostedIn/pkg/storage"
 )
 
 // Scheduler manages LinkedIn post scheduling and storage operations.
 type Scheduler struct {
-	Posts   []models.Post
-	nextID  int
-	storage *storage.JSONStorage
+	// mu guards Posts and publishing against the cron timers, API handlers and CLI running concurrently.
+	mu           sync.RWMutex
+	Posts        []models.Post
+	publishing   map[int]bool // Posts with a publish attempt in flight
+	nextID       int
+	storage      storage.Storage
+	releaseHooks []func([]models.Post)
+	publishHooks []func(models.Post, error)
+	quiet        bool        // Set on a transaction's staged copy, whose changes are not final yet
+	paused       atomic.Bool // Publishing kill switch, changed only through SetPaused
+	dryRun       atomic.Bool // Publishes only log what they would send, changed only through SetDryRun
+	switchMu     sync.Mutex  // Serializes switch changes, which write and save the shared config
+	bestOfMu     sync.Mutex  // Serializes best-of runs so two never fill the same slot
 }
 
 // NewScheduler creates a new post scheduler with the specified storage file.
 func NewScheduler(storageFile string) *Scheduler {
+	return NewSchedulerWithReplica(storageFile, "")
+}
+
+// NewSchedulerWithReplica creates a post scheduler whose saves are mirrored to the replica file.
+func NewSchedulerWithReplica(storageFile, replicaFile string) *Scheduler {
+	return NewSchedulerWithStorage(storage.NewReplicatedJSONStorage(storageFile, replicaFile))
+}
+
+// NewSchedulerWithStorage creates a post scheduler that loads and saves posts through store.
+func NewSchedulerWithStorage(store storage.Storage) *Scheduler {
 	s := &Scheduler{
 		Posts:   []models.Post{},
 		nextID:  1,
-		storage: storage.NewJSONStorage(storageFile),
+		storage: store,
 	}
 	s.loadPosts()
 
@@ -49,61 +77,474 @@ func (s *Scheduler) loadPosts() {
 }
 
 func (s *Scheduler) savePosts() error {
+	// The staging copy of a transaction has no storage; its posts are saved on commit
+	if s.storage == nil {
+		return nil
+	}
+
 	return s.storage.SavePosts(s.Posts)
 }
 
 // SavePosts saves all posts to storage (exported version).
 func (s *Scheduler) SavePosts() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
 	return s.savePosts()
 }
 
 // AddPost adds a new post to the scheduler with the specified content and schedule time.
 func (s *Scheduler) AddPost(content string, scheduledAt time.Time, cfg *config.Config) error {
+	_, err := s.Add(models.Post{
+		Content:     content,
+		ScheduledAt: scheduledAt,
+		PostType:    models.PostTypeText,
+	}, cfg)
+
+	return err
+}
+
+// AddPollPost adds a new poll post after validating its question, options and duration.
+func (s *Scheduler) AddPollPost(content string, poll models.Poll, scheduledAt time.Time, cfg *config.Config) error {
+	_, err := s.Add(models.Post{
+		Content:     content,
+		ScheduledAt: scheduledAt,
+		PostType:    models.PostTypePoll,
+		Poll:        &poll,
+	}, cfg)
+
+	return err
+}
+
+// ErrInvalidPost is matched by the errors of posts rejected by validation, e.g. empty or too long
+// content, as opposed to failures to store them; retrying such a post cannot succeed.
+var ErrInvalidPost = errors.New("invalid post")
+
+// invalidPostError marks a validation error as ErrInvalidPost, keeping its message.
+type invalidPostError struct {
+	err error
+}
+
+func (e *invalidPostError) Error() string {
+	return e.err.Error()
+}
+
+// Unwrap makes the error match both ErrInvalidPost and the validation error.
+func (e *invalidPostError) Unwrap() []error {
+	return []error{ErrInvalidPost, e.err}
+}
+
+// Add validates a fully specified post, assigns its ID and stores it as scheduled. Posts rejected by
+// validation, including their dependency, return an error matching ErrInvalidPost.
+func (s *Scheduler) Add(post models.Post, cfg *config.Config) (models.Post, error) {
+	if err := NormalizePost(&post, cfg); err != nil {
+		return models.Post{}, &invalidPostError{err}
+	}
+
 	// Get current time in configured timezone
 	now, err := cfg.Now()
 	if err != nil {
 		now = time.Now() // Fallback to system time
 	}
 
-	post := models.Post{
-		ID:          s.nextID,
-		Content:     content,
-		ScheduledAt: scheduledAt,
-		Status:      "scheduled",
-		CreatedAt:   now,
+	post.Status = "scheduled"
+	post.CreatedAt = now
+
+	if err := s.insert(&post); err != nil {
+		return models.Post{}, err
+	}
+
+	if s.quiet {
+		return post, nil
+	}
+
+	// Get timezone for display
+	loc, err := cfg.GetTimezone()
+	if err != nil {
+		loc = time.UTC
+	}
+
+	if post.Status == models.StatusWaiting {
+		fmt.Printf("Post scheduled with ID %d for %d minutes after post %d publishes\n", post.ID, post.OffsetMinutes, post.DependsOn)
+	} else {
+		fmt.Printf("Post scheduled with ID %d for %s\n", post.ID, post.ScheduledAt.In(loc).Format("2006-01-02 15:04 MST"))
 	}
 
-	s.Posts = append(s.Posts, post)
+	return post, nil
+}
+
+// insert assigns the next ID to a validated post, resolves its dependency and saves it.
+func (s *Scheduler) insert(post *models.Post) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	post.ID = s.nextID
+
+	if err := s.resolveDependency(post); err != nil {
+		return &invalidPostError{err}
+	}
+
+	post.Record(post.Status, "created")
+
+	s.Posts = append(s.Posts, *post)
 	s.nextID++
 
-	err = s.savePosts()
+	return s.savePosts()
+}
+
+// NormalizePost validates type-specific fields and fills in defaults before a post is stored.
+func NormalizePost(post *models.Post, cfg *config.Config) error {
+	if post.PostType == "" {
+		post.PostType = models.PostTypeText
+	}
+
+	post.Content = sanitize(post.Content, "content")
+	if strings.TrimSpace(post.Content) == "" {
+		return fmt.Errorf("content is empty")
+	}
+
+	if err := linkedin.ValidateContent(post.Content); err != nil {
+		return err
+	}
+
+	if post.APIVersion != "" {
+		if err := linkedin.ValidateAPIVersion(post.APIVersion); err != nil {
+			return err
+		}
+	}
+
+	if post.ImagePath != "" {
+		if err := normalizeImage(post); err != nil {
+			return err
+		}
+	}
+
+	if post.VideoPath != "" || post.PostType == models.PostTypeVideo {
+		if err := normalizeVideo(post); err != nil {
+			return err
+		}
+	}
+
+	if post.PostType == models.PostTypePoll {
+		if post.Poll == nil {
+			return fmt.Errorf("poll post requires a poll")
+		}
+
+		if err := linkedin.ValidatePoll(post.Poll.Question, post.Poll.Options, post.Poll.Duration); err != nil {
+			return err
+		}
+
+		if post.Poll.Duration == "" {
+			post.Poll.Duration = linkedin.DefaultPollDuration
+		}
+
+		post.Poll.ResultsTemplate = strings.TrimSpace(post.Poll.ResultsTemplate)
+		if err := validateResultsTemplate(post.Poll.ResultsTemplate); err != nil {
+			return err
+		}
+	}
+
+	if post.Audience != "" {
+		if _, err := cfg.Audience(post.Audience); err != nil {
+			return err
+		}
+	}
+
+	post.Account = strings.TrimSpace(post.Account)
+
+	account, err := cfg.ForAccount(post.Account)
 	if err != nil {
 		return err
 	}
 
-	// Get timezone for display
-	loc, err := cfg.GetTimezone()
+	if err := normalizeAuthor(post, account); err != nil {
+		return err
+	}
+
+	if err := normalizeVisibility(post); err != nil {
+		return err
+	}
+
+	if err := resolveEvent(post, cfg); err != nil {
+		return err
+	}
+
+	if err := normalizeRecurrence(post); err != nil {
+		return err
+	}
+
+	tags, err := NormalizeTags(post.Tags)
 	if err != nil {
-		loc = time.UTC
+		return err
 	}
 
-	fmt.Printf("Post scheduled with ID %d for %s\n", post.ID, scheduledAt.In(loc).Format("2006-01-02 15:04 MST"))
+	post.Tags = tags
+
+	return normalizeLanguages(post, cfg)
+}
+
+// normalizeAuthor validates the author type and resolves an organization post's page, defaulting
+// to the account's organization_urn. A post naming a page is an organization post.
+func normalizeAuthor(post *models.Post, account *config.Config) error {
+	post.AuthorType = strings.ToLower(strings.TrimSpace(post.AuthorType))
+	post.OrganizationURN = strings.TrimSpace(post.OrganizationURN)
+
+	if post.AuthorType == "" && post.OrganizationURN != "" {
+		post.AuthorType = models.AuthorOrganization
+	}
+
+	switch post.AuthorType {
+	case "", models.AuthorPerson:
+		if post.OrganizationURN != "" {
+			return fmt.Errorf("a post authored by a person cannot name an organization")
+		}
+
+		post.AuthorType = ""
+
+		return nil
+	case models.AuthorOrganization:
+	default:
+		return fmt.Errorf("invalid author type %q, use %s or %s", post.AuthorType, models.AuthorPerson, models.AuthorOrganization)
+	}
+
+	if post.OrganizationURN == "" {
+		post.OrganizationURN = account.LinkedIn.OrganizationURN
+	}
+
+	if post.OrganizationURN == "" {
+		return fmt.Errorf("an organization post needs an organization_urn, on the post or in the linkedin config")
+	}
+
+	urn, err := linkedin.OrganizationURN(post.OrganizationURN)
+	if err != nil {
+		return err
+	}
+
+	post.OrganizationURN = urn
+
+	return nil
+}
+
+// normalizeImage checks that the attached image can be uploaded and stores its absolute path
+// so publishing does not depend on the working directory.
+func normalizeImage(post *models.Post) error {
+	if post.PostType == models.PostTypePoll {
+		return fmt.Errorf("a poll post cannot also have an image")
+	}
+
+	path, err := filepath.Abs(post.ImagePath)
+	if err != nil {
+		return fmt.Errorf("invalid image path: %w", err)
+	}
+
+	if _, err := linkedin.ValidateImageFile(path); err != nil {
+		return err
+	}
+
+	if err := linkedin.ValidateAltText(post.ImageAltText); err != nil {
+		return err
+	}
+
+	post.ImagePath = path
+
+	return nil
+}
+
+// normalizeVideo checks the attached video's format, size and duration, marks the post as a
+// video post and stores the absolute path so publishing does not depend on the working directory.
+func normalizeVideo(post *models.Post) error {
+	if post.PostType == models.PostTypePoll || post.ImagePath != "" {
+		return fmt.Errorf("a video post cannot also have a poll or an image")
+	}
+
+	if post.VideoPath == "" {
+		return fmt.Errorf("video post requires a video file")
+	}
+
+	path, err := filepath.Abs(post.VideoPath)
+	if err != nil {
+		return fmt.Errorf("invalid video path: %w", err)
+	}
+
+	if _, err := linkedin.ValidateVideoFile(path); err != nil {
+		return err
+	}
+
+	if err := linkedin.ValidateVideoTitle(post.VideoTitle); err != nil {
+		return err
+	}
+
+	post.PostType = models.PostTypeVideo
+	post.VideoPath = path
+
+	return nil
+}
+
+// normalizeLanguages validates the primary language, content variants and publish target.
+func normalizeLanguages(post *models.Post, cfg *config.Config) error {
+	if post.Language == "" && len(post.Variants) == 0 && post.TargetLanguage == "" {
+		return nil
+	}
+
+	primary := post.Language
+	if primary == "" {
+		primary = cfg.Content.DefaultLanguage
+	}
+
+	if primary == "" {
+		primary = transform.DefaultLanguage
+	}
+
+	primary, err := transform.NormalizeLanguage(primary)
+	if err != nil {
+		return err
+	}
+
+	variants, err := transform.NormalizeVariants(primary, post.Variants)
+	if err != nil {
+		return err
+	}
+
+	for lang, text := range variants {
+		variants[lang] = sanitize(text, lang+" variant")
+		if err := linkedin.ValidateContent(variants[lang]); err != nil {
+			return fmt.Errorf("%s variant: %w", lang, err)
+		}
+	}
+
+	post.Language = primary
+	post.Variants = variants
+
+	if post.TargetLanguage != "" && !strings.EqualFold(post.TargetLanguage, transform.AllLanguages) {
+		target, err := transform.NormalizeLanguage(post.TargetLanguage)
+		if err != nil {
+			return err
+		}
+
+		if _, ok := variants[target]; !ok && target != primary {
+			return fmt.Errorf("target language %q has no content variant", target)
+		}
+
+		post.TargetLanguage = target
+	}
 
 	return nil
 }
 
-// GetPosts returns all posts managed by the scheduler.
+// sanitize cleans text that LinkedIn may reject, logging what was changed or looks suspicious.
+func sanitize(text, field string) string {
+	cleaned, warnings := transform.SanitizeContent(text)
+	for _, warning := range warnings {
+		log.Printf("⚠️ Post %s %s", field, warning)
+	}
+
+	return cleaned
+}
+
+// OnPublished registers a callback invoked after every publish attempt that changed a post's status.
+func (s *Scheduler) OnPublished(fn func(models.Post, error)) {
+	s.publishHooks = append(s.publishHooks, fn)
+}
+
+func (s *Scheduler) notifyPublished(post models.Post, err error) {
+	for _, fn := range s.publishHooks {
+		fn(post, err)
+	}
+}
+
+// GetPosts returns a copy of all posts managed by the scheduler, safe to read while it keeps running.
 func (s *Scheduler) GetPosts() []models.Post {
-	return s.Posts
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return clonePosts(s.Posts)
+}
+
+// clonePosts copies posts along with their history, which publishing appends to in place.
+func clonePosts(posts []models.Post) []models.Post {
+	cloned := slices.Clone(posts)
+	for i := range cloned {
+		cloned[i].History = slices.Clone(cloned[i].History)
+	}
+
+	return cloned
+}
+
+// ErrNotEditable is returned when editing a post that already posted or failed.
+var ErrNotEditable = errors.New("only posts that have not published can be edited")
+
+// UpdatePost changes a post's content and/or scheduled time; an empty content or zero time leaves that field unchanged.
+// The edited post is validated like a new one, and an edit it fails returns an error matching ErrInvalidPost.
+func (s *Scheduler) UpdatePost(id int, content string, scheduledAt time.Time, cfg *config.Config) (models.Post, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	post := s.findPost(id)
+
+	switch {
+	case post == nil:
+		return models.Post{}, fmt.Errorf("post %d: %w", id, ErrPostNotFound)
+	case post.Status == models.StatusPosted || post.Status == models.StatusFailed:
+		return models.Post{}, fmt.Errorf("post %d is %s: %w", id, post.Status, ErrNotEditable)
+	case s.publishing[id]:
+		return models.Post{}, fmt.Errorf("post %d: %w", id, ErrPublishInProgress)
+	}
+
+	if content == "" && scheduledAt.IsZero() {
+		return *post, nil
+	}
+
+	// Edit a copy so a rejected edit leaves the stored post as it was
+	edited := *post
+	edited.History = slices.Clone(post.History)
+
+	if post.Poll != nil {
+		poll := *post.Poll
+		edited.Poll = &poll
+	}
+
+	if content != "" {
+		edited.Content = content
+	}
+
+	// An explicit time detaches the post from its event
+	if !scheduledAt.IsZero() {
+		edited.ScheduledAt = scheduledAt
+		edited.Event = ""
+		edited.EventOffsetMinutes = 0
+	}
+
+	if err := NormalizePost(&edited, cfg); err != nil {
+		return models.Post{}, &invalidPostError{err}
+	}
+
+	edited.Record(edited.Status, "edited")
+
+	previous := *post
+	*post = edited
+
+	if err := s.savePosts(); err != nil {
+		*post = previous
+		return models.Post{}, err
+	}
+
+	return edited, nil
 }
 
 // DeletePost removes a post from the scheduler by its ID.
 func (s *Scheduler) DeletePost(id int) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
 	for i, post := range s.Posts {
 		if post.ID != id {
 			continue
 		}
 
+		if waiting := s.waitingDependents(id); len(waiting) > 0 {
+			return fmt.Errorf("post %d: %w (%v); delete or reschedule them first", id, ErrWaitingDependents, waiting)
+		}
+
 		s.Posts = append(s.Posts[:i], s.Posts[i+1:]...)
 
 		err := s.savePosts()
@@ -111,28 +552,66 @@ func (s *Scheduler) DeletePost(id int) error {
 			return err
 		}
 
-		fmt.Printf("Post %d deleted.\n", id)
+		if !s.quiet {
+			fmt.Printf("Post %d deleted.\n", id)
+		}
 
 		return nil
 	}
 
-	return fmt.Errorf("post %d not found", id)
+	return fmt.Errorf("post %d: %w", id, ErrPostNotFound)
 }
 
-// MarkAsPosted marks a post as successfully posted to LinkedIn.
-func (s *Scheduler) MarkAsPosted(id int) error {
-	for i, post := range s.Posts {
-		if post.ID == id {
-			s.Posts[i].Status = "posted"
-			return s.savePosts()
-		}
+// ErrCannotMarkPosted is returned when marking a post as posted that is already posted or publishing.
+var ErrCannotMarkPosted = errors.New("post cannot be marked as posted")
+
+// MarkAsPosted records that a post was published to LinkedIn by hand and returns it. Posts that
+// are already posted or are being published right now are rejected with ErrCannotMarkPosted.
+func (s *Scheduler) MarkAsPosted(id int) (models.Post, error) {
+	s.mu.Lock()
+
+	post := s.findPost(id)
+
+	switch {
+	case post == nil:
+		s.mu.Unlock()
+
+		return models.Post{}, fmt.Errorf("post %d: %w", id, ErrPostNotFound)
+	case post.Status == models.StatusPosted:
+		s.mu.Unlock()
+
+		return models.Post{}, fmt.Errorf("post %d is already posted: %w", id, ErrCannotMarkPosted)
+	case s.publishing[id]:
+		s.mu.Unlock()
+
+		return models.Post{}, fmt.Errorf("post %d is being published: %w", id, ErrCannotMarkPosted)
+	}
+
+	now := time.Now().In(post.ScheduledAt.Location())
+	post.SetStatus(models.StatusPosted, "marked as posted")
+	post.PublishedAt = &now
+	post.CronEntryID = 0
+	released := s.releaseDependents(id, now, true, "")
+	marked := *post
+
+	err := s.savePosts()
+	s.mu.Unlock()
+
+	if err != nil {
+		return models.Post{}, err
 	}
 
-	return fmt.Errorf("post %d not found", id)
+	// Hooks run without the lock so they can schedule the released posts
+	s.notifyReleased(released)
+
+	return marked, nil
 }
 
 // UpdatePostCronEntry updates the cron entry ID for a scheduled post.
 func (s *Scheduler) UpdatePostCronEntry(id, cronEntryID int) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
 	for i, post := range s.Posts {
 		if post.ID == id {
 			s.Posts[i].CronEntryID = cronEntryID
@@ -140,7 +619,7 @@ func (s *Scheduler) UpdatePostCronEntry(id, cronEntryID int) error {
 		}
 	}
 
-	return fmt.Errorf("post %d not found", id)
+	return fmt.Errorf("post %d: %w", id, ErrPostNotFound)
 }
 
 // GetDuePosts returns all posts that are scheduled and ready to be published.
@@ -153,6 +632,9 @@ func (s *Scheduler) GetDuePosts(cfg *config.Config) []models.Post {
 		now = time.Now() // Fallback to system time
 	}
 
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
 	for _, post := range s.Posts {
 		if post.Status == "scheduled" && !post.ScheduledAt.After(now) {
 			duePosts = append(duePosts, post)
@@ -162,75 +644,442 @@ func (s *Scheduler) GetDuePosts(cfg *config.Config) []models.Post {
 	return duePosts
 }
 
+// PublishTimeout returns how long publishing the post may take under the configured timeouts.
+func PublishTimeout(post models.Post, cfg *config.Config) time.Duration {
+	return cfg.Cron.PublishTimeout(post.ImagePath != "" || post.IsVideo())
+}
+
 // PublishToLinkedIn publishes a scheduled post to LinkedIn and updates its status.
+// The publish is bounded by the configured publish timeout.
 func (s *Scheduler) PublishToLinkedIn(ctx context.Context, postID int, cfg *config.Config) error {
-	// Find the post
-	var post *models.Post
-
-	for i, p := range s.Posts {
-		if p.ID == postID {
-			post = &s.Posts[i]
-			break
-		}
+	// Claim the post so listings show it as publishing and a second publish cannot start
+	attempt, err := s.beginPublish(postID, cfg)
+	if err != nil {
+		return err
 	}
 
-	if post == nil {
-		return fmt.Errorf("post %d not found", postID)
+	defer s.endPublish(postID)
+
+	// Act as the post's LinkedIn account: its app credentials, token and cached author
+	account, err := cfg.ForAccount(attempt.Account)
+	if err != nil {
+		return err
 	}
 
-	if post.Status != "scheduled" {
-		return fmt.Errorf("post %d is not scheduled for publishing", postID)
+	if s.IsDryRun() {
+		return s.publishDryRun(attempt, account, cfg)
 	}
 
 	// Create LinkedIn client
 	linkedinConfig := linkedin.NewConfig(
-		cfg.LinkedIn.ClientID,
-		cfg.LinkedIn.ClientSecret,
-		cfg.LinkedIn.RedirectURL,
+		account.LinkedIn.ClientID,
+		account.LinkedIn.ClientSecret,
+		account.LinkedIn.RedirectURL,
 	)
 	client := linkedin.NewClient(linkedinConfig)
 
 	// Load existing token
-	token, err := config.LoadToken(cfg.Storage.TokenFile)
+	token, err := config.LoadToken(account.Storage.TokenFile)
 	if err != nil {
 		return fmt.Errorf("failed to load LinkedIn token: %w", err)
 	}
 
 	if token == nil {
-		return fmt.Errorf("no LinkedIn authentication token found - please authenticate first")
+		return fmt.Errorf("no LinkedIn authentication token found%s - please authenticate first", accountSuffix(attempt.Account))
 	}
 
 	client.SetToken(token)
+	client.SetAPIVersion(apiVersion(&attempt, account))
 
 	if !client.IsAuthenticated() {
-		return fmt.Errorf("LinkedIn token is invalid or expired - please re-authenticate")
+		return fmt.Errorf("LinkedIn token%s is invalid or expired - please re-authenticate", accountSuffix(attempt.Account))
+	}
+
+	// Wait for the account's and the global rate limits so one busy account cannot exceed LinkedIn's limits
+	release, err := acquirePublishSlot(ctx, account)
+	if err != nil {
+		_, _, err = s.finishPublish(postID, publishOutcome{}, err, cfg)
+
+		return err
+	}
+
+	defer release()
+
+	// Publish the selected language variants, probing author URN formats until one is accepted
+	s.mu.Lock()
+	if post := s.findPost(postID); post != nil {
+		post.Record(models.StatusPublishing, "")
+	}
+	s.mu.Unlock()
+
+	timeout := PublishTimeout(attempt, cfg)
+
+	publishCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	// A poll results follow-up is published with the poll's final tallies in place of its template
+	var author, urn string
+
+	err = s.fillPollResults(publishCtx, client, &attempt)
+	if err == nil {
+		author, urn, err = publishVariants(publishCtx, client, &attempt, account)
 	}
 
-	// Publish the post
-	err = client.CreatePost(ctx, post.Content, cfg.LinkedIn.UserID)
+	if err != nil && errors.Is(publishCtx.Err(), context.DeadlineExceeded) {
+		err = fmt.Errorf("publish timed out after %v, raise cron.publish_timeout_seconds (or cron.media_publish_timeout_seconds for image/video posts): %w", timeout, err)
+	}
+
+	if linkedin.StatusCode(err) == http.StatusUnauthorized {
+		err = fmt.Errorf("LinkedIn rejected the token%s - please re-authenticate: %w", accountSuffix(attempt.Account), err)
+	}
+
+	// Cache the author URN format that worked so later publishes skip probing
+	if !attempt.IsOrganization() {
+		rememberAuthor(cfg, attempt.Account, account, author, err)
+	}
+
+	outcome := publishOutcome{RequestID: client.LastRequestID(), PostURN: urn, Content: attempt.Content}
+
+	published, released, err := s.finishPublish(postID, outcome, err, cfg)
 	if err != nil {
-		post.Status = "failed"
+		return err
+	}
+
+	fmt.Printf("✅ Post %d successfully published to LinkedIn!\n", postID)
+
+	if published.RequestID != "" {
+		fmt.Printf("🔎 LinkedIn request ID: %s\n", published.RequestID)
+	}
+
+	s.notifyReleased(released)
+	s.notifyPublished(published, nil)
+
+	return nil
+}
+
+// ErrPublishInProgress is returned when publishing a post that another publish attempt already claimed.
+var ErrPublishInProgress = errors.New("post is already being published")
+
+// beginPublish checks that the post can be published, marks it as publishing and returns a copy
+// to publish from, so the network calls do not hold the lock or point into the posts slice.
+func (s *Scheduler) beginPublish(postID int, cfg *config.Config) (models.Post, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	post := s.findPost(postID)
+
+	switch {
+	case post == nil:
+		return models.Post{}, fmt.Errorf("post %d: %w", postID, ErrPostNotFound)
+	case post.Status != models.StatusScheduled:
+		return models.Post{}, fmt.Errorf("post %d is not scheduled for publishing", postID)
+	case s.publishing[postID]:
+		return models.Post{}, fmt.Errorf("post %d: %w", postID, ErrPublishInProgress)
+	case s.IsPaused():
+		return models.Post{}, ErrPublishingPaused
+	}
+
+	if s.publishing == nil {
+		s.publishing = make(map[int]bool)
+	}
+
+	s.publishing[postID] = true
+
+	return *post, nil
+}
+
+// endPublish clears the publishing mark set by beginPublish.
+func (s *Scheduler) endPublish(postID int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.publishing, postID)
+}
+
+// publishOutcome is what a publish attempt learned from LinkedIn, recorded on the stored post.
+type publishOutcome struct {
+	RequestID string
+	PostURN   string
+	Content   string // Content as published, differing from the stored one for poll results follow-ups
+	DryRun    bool   // Nothing was sent to LinkedIn
+}
+
+// finishPublish records the outcome of a publish attempt on the stored post and saves it. It returns
+// the updated post and the posts it released or scheduled, or the error to report for a failed attempt;
+// hooks are left to the caller so they run without the lock held.
+func (s *Scheduler) finishPublish(postID int, outcome publishOutcome, publishErr error, cfg *config.Config) (models.Post, []models.Post, error) {
+	s.mu.Lock()
+
+	post := s.findPost(postID)
+	if post == nil {
+		s.mu.Unlock()
+
+		if publishErr != nil {
+			return models.Post{}, nil, fmt.Errorf("failed to publish to LinkedIn: %w", publishErr)
+		}
+
+		return models.Post{}, nil, fmt.Errorf("post %d was deleted while it was being published", postID)
+	}
+
+	post.RequestID = outcome.RequestID
+
+	retryAfter, _ := linkedin.RetryAfter(publishErr)
+	if reason := deferReason(publishErr); reason != "" && deferPost(post, reason, retryAfter) {
+		if saveErr := s.savePosts(); saveErr != nil {
+			log.Printf("Failed to save posts after deferring publish: %v", saveErr)
+		}
+
+		err := deferredError(post, publishErr)
+		s.mu.Unlock()
+
+		return models.Post{}, nil, err
+	}
+
+	if publishErr != nil {
+		post.Attempts++
+
+		// Transient failures are retried with backoff before the post is given up on
+		if retryPost(post, publishErr, cfg) {
+			if saveErr := s.savePosts(); saveErr != nil {
+				log.Printf("Failed to save posts after scheduling a publish retry: %v", saveErr)
+			}
+
+			err := deferredError(post, publishErr)
+			s.mu.Unlock()
+
+			return models.Post{}, nil, err
+		}
+
+		post.LastError = publishErr.Error()
+		post.SetStatus(models.StatusFailed, publishErr.Error())
+		released := s.releaseDependents(postID, time.Now().In(post.ScheduledAt.Location()), false, cfg.Cron.DependencyFailurePolicy)
 
 		if saveErr := s.savePosts(); saveErr != nil {
 			log.Printf("Failed to save posts after publish failure: %v", saveErr)
 		}
 
-		return fmt.Errorf("failed to publish to LinkedIn: %w", err)
+		failed := *post
+		s.mu.Unlock()
+
+		s.notifyReleased(released)
+		s.notifyPublished(failed, publishErr)
+
+		return models.Post{}, nil, fmt.Errorf("failed to publish to LinkedIn: %w", publishErr)
 	}
 
-	// Mark as posted
-	post.Status = "posted"
+	// Mark as posted and release posts scheduled relative to this one
+	publishedAt := time.Now().In(post.ScheduledAt.Location())
+	post.Attempts++
+	post.PublishedAt = &publishedAt
 
-	err = s.savePosts()
-	if err != nil {
-		return fmt.Errorf("failed to update post status: %w", err)
+	if outcome.DryRun {
+		post.SetStatus(models.StatusPosted, "dry run, not sent to LinkedIn")
+	} else {
+		post.SetStatus(models.StatusPosted, "")
 	}
 
-	fmt.Printf("✅ Post %d successfully published to LinkedIn!\n", postID)
+	post.LastError = ""
+	post.PostURN = outcome.PostURN
+
+	if post.ResultsOf != 0 {
+		post.Content = outcome.Content
+	}
+	released := s.releaseDependents(postID, publishedAt, true, "")
+
+	// Arm the results post of a poll for when it closes, like a released dependent
+	if followUp, ok := s.scheduleResultsFollowUp(post, publishedAt); ok {
+		released = append(released, followUp)
+	}
+
+	// A recurring post continues as a fresh post at its next occurrence
+	if next, ok := s.scheduleNextOccurrence(post, publishedAt); ok {
+		released = append(released, next)
+	}
+
+	saveErr := s.savePosts()
+	published := *post
+	s.mu.Unlock()
+
+	if saveErr != nil {
+		return models.Post{}, nil, fmt.Errorf("failed to update post status: %w", saveErr)
+	}
+
+	return published, released, nil
+}
+
+// findPost returns the stored post with the given ID, or nil; the caller holds the lock.
+func (s *Scheduler) findPost(id int) *models.Post {
+	for i := range s.Posts {
+		if s.Posts[i].ID == id {
+			return &s.Posts[i]
+		}
+	}
 
 	return nil
 }
 
+// accountSuffix names a non-default account in messages about its token.
+func accountSuffix(account string) string {
+	if account == "" || account == config.DefaultAccount {
+		return ""
+	}
+
+	return fmt.Sprintf(" for account %q", account)
+}
+
+// apiVersion picks the post's LinkedIn-Version override, then the configured default.
+func apiVersion(post *models.Post, cfg *config.Config) string {
+	if post.APIVersion != "" {
+		return post.APIVersion
+	}
+
+	return cfg.LinkedIn.APIVersion
+}
+
+// publishVariants publishes each selected language variant of the post as a separate LinkedIn post
+// and returns the author URN that was accepted and the URN of the first published post.
+func publishVariants(ctx context.Context, client *linkedin.Client, post *models.Post, cfg *config.Config) (string, string, error) {
+	variants, err := transform.SelectVariants(post.Content, post.Language, post.Variants, post.TargetLanguage)
+	if err != nil {
+		return "", "", err
+	}
+
+	// Build poll content when publishing a poll post
+	var content *linkedin.PostContent
+	if post.IsPoll() {
+		content, err = linkedin.NewPollContent(post.Poll.Question, post.Poll.Options, post.Poll.Duration)
+		if err != nil {
+			return "", "", err
+		}
+	}
+
+	var author, urn string
+
+	candidates := authorCandidates(ctx, client, post, cfg)
+	visibility := PostVisibility(post, cfg)
+
+	var audience *linkedin.Audience
+	if post.Audience != "" {
+		named, err := cfg.Audience(post.Audience)
+		if err != nil {
+			return "", "", err
+		}
+
+		audience = &named
+	}
+
+	// Upload the attached image once, or reuse a recent upload of the same file, and reference it
+	// from every variant; the image is owned by the first candidate author, so only that author can publish it
+	if post.ImagePath != "" && len(candidates) > 0 {
+		image, err := uploadCached(ctx, cfg, candidates[0], post.ImagePath, client.UploadImage)
+		if err != nil {
+			return "", "", err
+		}
+
+		content = &linkedin.PostContent{Media: &linkedin.MediaContent{ID: image, AltText: post.ImageAltText}}
+		candidates = candidates[:1]
+	}
+
+	// Videos are uploaded the same way: once, owned by the first candidate author
+	if post.IsVideo() && len(candidates) > 0 {
+		video, err := uploadCached(ctx, cfg, candidates[0], post.VideoPath, client.UploadVideo)
+		if err != nil {
+			return "", "", err
+		}
+
+		content = &linkedin.PostContent{Media: &linkedin.MediaContent{ID: video, Title: post.VideoTitle}}
+		candidates = candidates[:1]
+	}
+
+	for i, variant := range variants {
+		text := publishText(post, variant.Content, cfg)
+
+		author, err = client.CreatePostWithAuthorProbe(ctx, text, content, visibility, audience, candidates)
+		if err != nil {
+			// Not wrapped: a partial fan-out cannot be retried without duplicating published variants
+			if i > 0 {
+				return "", "", fmt.Errorf("published %d of %d language variants, %q failed: %v", i, len(variants), variant.Language, err)
+			}
+
+			return "", "", err
+		}
+
+		if i == 0 {
+			urn = client.LastPostURN()
+		}
+
+		candidates = []string{author}
+	}
+
+	return author, urn, nil
+}
+
+// publishText sanitizes content and applies the configured footer; stored content stays untouched.
+func publishText(post *models.Post, content string, cfg *config.Config) string {
+	text, _ := transform.SanitizeContent(content)
+
+	text, adjusted := transform.ApplyFooter(text, cfg.Content.Footer, linkedin.MaxPostLength)
+	if adjusted {
+		log.Printf("⚠️ Post %d exceeds %d characters with footer - content truncated or footer dropped", post.ID, linkedin.MaxPostLength)
+	}
+
+	return text
+}
+
+// rememberAuthor caches the author URN LinkedIn accepted for the account, or clears the cached one
+// when a publish is rejected with 403 or 422 so the next publish probes the formats again.
+func rememberAuthor(cfg *config.Config, name string, account *config.Config, author string, publishErr error) {
+	cached := account.LinkedIn.AuthorURN
+
+	switch status := linkedin.StatusCode(publishErr); {
+	case publishErr == nil:
+		cached = author
+	case status == http.StatusForbidden || status == http.StatusUnprocessableEntity:
+		cached = ""
+	}
+
+	if cached == account.LinkedIn.AuthorURN {
+		return
+	}
+
+	account.LinkedIn.AuthorURN = cached
+	cfg.StoreAccountIdentity(name, account)
+
+	if err := config.SaveConfig(cfg); err != nil {
+		log.Printf("Failed to cache author URN in config: %v", err)
+	}
+}
+
+// authorCandidates returns the author URNs to try when publishing: an organization post's page, or
+// the member's URN formats, preferring the cached one.
+func authorCandidates(ctx context.Context, client *linkedin.Client, post *models.Post, cfg *config.Config) []string {
+	if post.IsOrganization() {
+		return []string{post.OrganizationURN}
+	}
+
+	if cfg.LinkedIn.AuthorURN != "" {
+		return []string{cfg.LinkedIn.AuthorURN}
+	}
+
+	var candidates []string
+
+	profile, err := client.GetProfile(ctx)
+	if err != nil {
+		log.Printf("⚠️ Could not fetch profile to detect author URN: %v", err)
+	} else {
+		candidates = linkedin.CandidateAuthorURNs(profile)
+	}
+
+	if cfg.LinkedIn.UserID != "" {
+		fallback := linkedin.AuthorURN(cfg.LinkedIn.UserID)
+		if !slices.Contains(candidates, fallback) {
+			candidates = append(candidates, fallback)
+		}
+	}
+
+	return candidates
+}
+
 // DeleteMultiplePosts removes multiple posts from the scheduler by their IDs.
 func (s *Scheduler) DeleteMultiplePosts(ids []int) error {
 	idSet := make(map[int]struct{}, len(ids))
@@ -238,6 +1087,24 @@ func (s *Scheduler) DeleteMultiplePosts(ids []int) error {
 		idSet[id] = struct{}{}
 	}
 
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	// Dependents deleted in the same call do not keep a post from being deleted
+	for id := range idSet {
+		var waiting []int
+
+		for _, dependent := range s.waitingDependents(id) {
+			if _, ok := idSet[dependent]; !ok {
+				waiting = append(waiting, dependent)
+			}
+		}
+
+		if len(waiting) > 0 {
+			return fmt.Errorf("post %d: %w (%v); delete or reschedule them first", id, ErrWaitingDependents, waiting)
+		}
+	}
+
 	newPosts := make([]models.Post, 0, len(s.Posts))
 
 	var notFound []int
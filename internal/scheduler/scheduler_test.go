@@ -0,0 +1,269 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"PostedIn/internal/models"
+)
+
+func TestGetPostsReturnsCopies(t *testing.T) {
+	s := newTestScheduler(t)
+	post := mustAdd(t, s, "original")
+
+	posts := s.GetPosts()
+	posts[0].Content = "changed"
+	posts[0].History[0].Note = "changed"
+
+	got := s.GetPosts()[0]
+	if got.ID != post.ID || got.Content != "original" || got.History[0].Note == "changed" {
+		t.Errorf("stored post = %+v, want it unaffected by changes to a returned copy", got)
+	}
+}
+
+func TestConcurrentAccessIsGuarded(t *testing.T) {
+	s := newTestScheduler(t)
+	cfg := testConfig()
+
+	var wg sync.WaitGroup
+
+	for i := range 10 {
+		wg.Add(3)
+
+		go func() {
+			defer wg.Done()
+
+			post, err := s.Add(models.Post{Content: fmt.Sprintf("post %d", i), ScheduledAt: time.Now().Add(time.Hour)}, cfg)
+			if err != nil {
+				t.Errorf("Add: %v", err)
+				return
+			}
+
+			if _, err := s.MarkAsPosted(post.ID); err != nil {
+				t.Errorf("MarkAsPosted(%d): %v", post.ID, err)
+			}
+		}()
+
+		go func() {
+			defer wg.Done()
+
+			for _, post := range s.GetPosts() {
+				_ = post.Status
+			}
+		}()
+
+		go func() {
+			defer wg.Done()
+			_ = s.GetDuePosts(cfg)
+		}()
+	}
+
+	wg.Wait()
+
+	posts := s.GetPosts()
+	if len(posts) != 10 {
+		t.Fatalf("got %d posts, want 10", len(posts))
+	}
+
+	seen := make(map[int]bool)
+	for _, post := range posts {
+		if seen[post.ID] {
+			t.Errorf("post ID %d assigned twice", post.ID)
+		}
+
+		seen[post.ID] = true
+	}
+}
+
+func TestBeginPublishClaimsPostOnce(t *testing.T) {
+	s := newTestScheduler(t)
+	post := mustAdd(t, s, "claimed")
+
+	if _, err := s.beginPublish(post.ID, testConfig()); err != nil {
+		t.Fatalf("first beginPublish: %v", err)
+	}
+
+	if _, err := s.beginPublish(post.ID, testConfig()); !errors.Is(err, ErrPublishInProgress) {
+		t.Errorf("second beginPublish = %v, want ErrPublishInProgress", err)
+	}
+
+	s.endPublish(post.ID)
+
+	if _, err := s.beginPublish(post.ID, testConfig()); err != nil {
+		t.Errorf("beginPublish after endPublish: %v", err)
+	}
+}
+
+func TestAddSanitizesContent(t *testing.T) {
+	s := newTestScheduler(t)
+
+	post, err := s.Add(models.Post{Content: "Hello\x00 world\xff\r\n", ScheduledAt: time.Now().Add(time.Hour)}, testConfig())
+	if err != nil {
+		t.Fatalf("Add: %v", err)
+	}
+
+	if post.Content != "Hello world\n" {
+		t.Errorf("stored content = %q, want it sanitized", post.Content)
+	}
+
+	if _, err := s.Add(models.Post{Content: "\x00\x01", ScheduledAt: time.Now().Add(time.Hour)}, testConfig()); err == nil {
+		t.Error("Add of content that sanitizes to nothing succeeded")
+	}
+}
+
+func TestAPIVersionOverride(t *testing.T) {
+	cfg := testConfig()
+
+	if got := apiVersion(&models.Post{}, cfg); got != "" {
+		t.Errorf("without any version = %q, want the client default", got)
+	}
+
+	cfg.LinkedIn.APIVersion = "202501"
+	if got := apiVersion(&models.Post{}, cfg); got != "202501" {
+		t.Errorf("configured default = %q, want 202501", got)
+	}
+
+	if got := apiVersion(&models.Post{APIVersion: "202411"}, cfg); got != "202411" {
+		t.Errorf("per-post override = %q, want 202411", got)
+	}
+}
+
+func TestAddValidatesAPIVersion(t *testing.T) {
+	s := newTestScheduler(t)
+	post := models.Post{Content: "versioned", ScheduledAt: time.Now().Add(time.Hour), APIVersion: "2025-01"}
+
+	if _, err := s.Add(post, testConfig()); err == nil {
+		t.Fatal("Add accepted a malformed API version")
+	}
+
+	post.APIVersion = "202501"
+	if _, err := s.Add(post, testConfig()); err != nil {
+		t.Fatalf("Add: %v", err)
+	}
+}
+
+func TestAddValidatesImage(t *testing.T) {
+	dir := t.TempDir()
+	t.Chdir(dir)
+
+	if err := os.WriteFile("chart.png", []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := os.WriteFile("notes.txt", []byte("not an image"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	s := newTestScheduler(t)
+	at := time.Now().Add(time.Hour)
+
+	post, err := s.Add(models.Post{Content: "chart", ScheduledAt: at, ImagePath: "chart.png", ImageAltText: "Q3 growth"}, testConfig())
+	if err != nil {
+		t.Fatalf("Add: %v", err)
+	}
+
+	if want := filepath.Join(dir, "chart.png"); post.ImagePath != want {
+		t.Errorf("ImagePath = %q, want the absolute path %q", post.ImagePath, want)
+	}
+
+	invalid := []models.Post{
+		{Content: "text file", ScheduledAt: at, ImagePath: "notes.txt"},
+		{Content: "missing", ScheduledAt: at, ImagePath: "missing.png"},
+		{Content: "poll", ScheduledAt: at, ImagePath: "chart.png", PostType: models.PostTypePoll,
+			Poll: &models.Poll{Question: "Q?", Options: []string{"A", "B"}}},
+	}
+
+	for _, post := range invalid {
+		if _, err := s.Add(post, testConfig()); err == nil {
+			t.Errorf("Add accepted the %s image post", post.Content)
+		}
+	}
+}
+
+func TestAddValidatesVideo(t *testing.T) {
+	s := newTestScheduler(t)
+	at := time.Now().Add(time.Hour)
+
+	invalid := []models.Post{
+		{Content: "no file", ScheduledAt: at, PostType: models.PostTypeVideo},
+		{Content: "missing file", ScheduledAt: at, VideoPath: filepath.Join(t.TempDir(), "missing.mp4")},
+		{Content: "with image", ScheduledAt: at, VideoPath: "clip.mp4", ImagePath: "chart.png"},
+	}
+
+	for _, post := range invalid {
+		if _, err := s.Add(post, testConfig()); err == nil {
+			t.Errorf("Add accepted the %s video post", post.Content)
+		}
+	}
+}
+
+func TestAddMarksValidationErrors(t *testing.T) {
+	s := newTestScheduler(t)
+
+	_, err := s.Add(models.Post{Content: "  ", ScheduledAt: time.Now().Add(time.Hour)}, testConfig())
+	if !errors.Is(err, ErrInvalidPost) || err.Error() != "content is empty" {
+		t.Errorf("empty content error = %v, want ErrInvalidPost with the validation message", err)
+	}
+
+	_, err = s.Add(models.Post{Content: "orphan", DependsOn: 99}, testConfig())
+	if !errors.Is(err, ErrInvalidPost) || !errors.Is(err, ErrInvalidDependency) {
+		t.Errorf("missing dependency error = %v, want ErrInvalidPost and ErrInvalidDependency", err)
+	}
+
+	// A storage failure is not a validation error
+	s.storage = failingStorage{}
+
+	if _, err := s.Add(models.Post{Content: "unsaved", ScheduledAt: time.Now().Add(time.Hour)}, testConfig()); err == nil || errors.Is(err, ErrInvalidPost) {
+		t.Errorf("storage error = %v, want an error that is not ErrInvalidPost", err)
+	}
+}
+
+func TestUpdatePostValidatesLikeAdd(t *testing.T) {
+	s := newTestScheduler(t)
+	post := mustAdd(t, s, "original")
+
+	updated, err := s.UpdatePost(post.ID, "line one\r\nline\x00 two", time.Time{}, testConfig())
+	if err != nil {
+		t.Fatalf("UpdatePost: %v", err)
+	}
+
+	if updated.Content != "line one\nline two" {
+		t.Errorf("content = %q, want it sanitized like a new post", updated.Content)
+	}
+
+	if _, err := s.UpdatePost(post.ID, "  ", time.Time{}, testConfig()); !errors.Is(err, ErrInvalidPost) {
+		t.Errorf("blank content error = %v, want ErrInvalidPost", err)
+	}
+
+	if got := findByID(t, s, post.ID).Content; got != "line one\nline two" {
+		t.Errorf("content after a rejected edit = %q, want it kept", got)
+	}
+}
+
+func TestUpdatePostRejectsFinishedPosts(t *testing.T) {
+	cfg := testConfig()
+	cfg.DryRun = true
+
+	s := newTestScheduler(t)
+	s.LoadSwitches(cfg)
+
+	post := mustAdd(t, s, "published")
+
+	if err := s.PublishToLinkedIn(context.Background(), post.ID, cfg); err != nil {
+		t.Fatalf("PublishToLinkedIn: %v", err)
+	}
+
+	if _, err := s.UpdatePost(post.ID, "rewritten", time.Time{}, cfg); !errors.Is(err, ErrNotEditable) {
+		t.Errorf("editing a posted post = %v, want ErrNotEditable", err)
+	}
+
+	if got := findByID(t, s, post.ID).Content; got != "published" {
+		t.Errorf("content = %q, want the published content kept", got)
+	}
+}
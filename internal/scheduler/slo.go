@@ -0,0 +1,118 @@
+package scheduler
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	"PostedIn/internal/config"
+	"PostedIn/internal/models"
+)
+
+// SLOWindow reports publish reliability over one rolling window ending now. Latency is how long
+// after its scheduled time a post actually went out.
+type SLOWindow struct {
+	Hours             int      `json:"hours"`
+	Attempts          int      `json:"attempts"`
+	Succeeded         int      `json:"succeeded"`
+	Failed            int      `json:"failed"`
+	SuccessRate       float64  `json:"success_rate"` // Percentage of attempts that published; 100 without attempts
+	P50LatencySeconds float64  `json:"p50_latency_seconds"`
+	P95LatencySeconds float64  `json:"p95_latency_seconds"`
+	Breaches          []string `json:"breaches"`
+}
+
+// ComputeSLOs reports success rate and publish latency percentiles for each configured window,
+// flagging windows that miss the configured targets.
+func ComputeSLOs(posts []models.Post, cfg config.SLOConfig, now time.Time) []SLOWindow {
+	windows := make([]SLOWindow, 0, len(cfg.Windows()))
+
+	for _, hours := range cfg.Windows() {
+		since := now.Add(-time.Duration(hours) * time.Hour)
+		window := SLOWindow{Hours: hours, Breaches: []string{}}
+
+		var latencies []time.Duration
+
+		for _, post := range posts {
+			at, ok := attemptTime(post)
+			if !ok || at.Before(since) || at.After(now) {
+				continue
+			}
+
+			if post.Status == models.StatusFailed {
+				window.Failed++
+				continue
+			}
+
+			window.Succeeded++
+
+			latencies = append(latencies, max(at.Sub(post.ScheduledAt), 0))
+		}
+
+		window.Attempts = window.Succeeded + window.Failed
+		window.SuccessRate = 100
+
+		if window.Attempts > 0 {
+			window.SuccessRate = math.Round(float64(window.Succeeded)/float64(window.Attempts)*1000) / 10
+		}
+
+		window.P50LatencySeconds = Percentile(latencies, 50).Seconds()
+		window.P95LatencySeconds = Percentile(latencies, 95).Seconds()
+		window.Breaches = sloBreaches(window, cfg)
+
+		windows = append(windows, window)
+	}
+
+	return windows
+}
+
+// sloBreaches lists the targets a window misses.
+func sloBreaches(window SLOWindow, cfg config.SLOConfig) []string {
+	breaches := []string{}
+
+	if window.Attempts > 0 && window.SuccessRate < float64(cfg.SuccessTarget()) {
+		breaches = append(breaches, fmt.Sprintf("success rate %.1f%% is below the %d%% target", window.SuccessRate, cfg.SuccessTarget()))
+	}
+
+	if limit := cfg.LatencyTarget(); window.Succeeded > 0 && window.P95LatencySeconds > limit.Seconds() {
+		breaches = append(breaches, fmt.Sprintf("p95 publish latency %.0fs is above the %.0fs target", window.P95LatencySeconds, limit.Seconds()))
+	}
+
+	return breaches
+}
+
+// attemptTime returns when a post's final publish attempt happened, if it had one: the publish
+// time of posted items, and the time of the last failure of failed ones.
+func attemptTime(post models.Post) (time.Time, bool) {
+	switch post.Status {
+	case models.StatusPosted:
+		return postedAt(post), true
+	case models.StatusFailed:
+		for i := len(post.History) - 1; i >= 0; i-- {
+			if post.History[i].Status == models.StatusFailed {
+				return post.History[i].At, true
+			}
+		}
+
+		return post.ScheduledAt, true
+	}
+
+	return time.Time{}, false
+}
+
+// Percentile returns the p-th percentile (0-100) of the durations using the nearest-rank method,
+// or 0 when there are none.
+func Percentile(values []time.Duration, p float64) time.Duration {
+	if len(values) == 0 {
+		return 0
+	}
+
+	sorted := append([]time.Duration(nil), values...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+
+	rank := int(math.Ceil(p / 100 * float64(len(sorted))))
+	rank = min(max(rank, 1), len(sorted))
+
+	return sorted[rank-1]
+}
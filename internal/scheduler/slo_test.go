@@ -0,0 +1,114 @@
+package scheduler
+
+import (
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"PostedIn/internal/config"
+	"PostedIn/internal/models"
+)
+
+func TestPercentile(t *testing.T) {
+	values := []time.Duration{9, 1, 8, 2, 7, 3, 6, 4, 5, 10}
+
+	tests := []struct {
+		p    float64
+		want time.Duration
+	}{
+		{0, 1},
+		{10, 1},
+		{50, 5},
+		{51, 6},
+		{95, 10},
+		{100, 10},
+	}
+
+	for _, tt := range tests {
+		if got := Percentile(values, tt.p); got != tt.want {
+			t.Errorf("Percentile(%v) = %v, want %v", tt.p, got, tt.want)
+		}
+	}
+
+	if got := Percentile(nil, 95); got != 0 {
+		t.Errorf("Percentile of nothing = %v, want 0", got)
+	}
+
+	if !slices.Equal(values[:3], []time.Duration{9, 1, 8}) {
+		t.Error("Percentile sorted its input in place")
+	}
+}
+
+// publishedPost is a posted item that went out delay after its scheduled time, hoursAgo before now.
+func publishedPost(now time.Time, hoursAgo float64, delay time.Duration) models.Post {
+	publishedAt := now.Add(-time.Duration(hoursAgo * float64(time.Hour)))
+
+	return models.Post{Status: models.StatusPosted, ScheduledAt: publishedAt.Add(-delay), PublishedAt: &publishedAt}
+}
+
+func TestComputeSLOs(t *testing.T) {
+	now := time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC)
+	failedAt := now.Add(-2 * time.Hour)
+
+	posts := []models.Post{
+		publishedPost(now, 1, 10*time.Second),
+		publishedPost(now, 2, 20*time.Second),
+		publishedPost(now, 3, 30*time.Second),
+		publishedPost(now, 48, 20*time.Minute), // Only in the weekly window
+		{Status: models.StatusFailed, ScheduledAt: failedAt.Add(-time.Minute), History: []models.StatusChange{
+			{Status: models.StatusScheduled, At: failedAt.Add(-time.Hour)},
+			{Status: models.StatusFailed, At: failedAt},
+		}},
+		{Status: models.StatusScheduled, ScheduledAt: now.Add(time.Hour)},
+		publishedPost(now, 24*30, time.Hour), // Outside every window
+	}
+
+	windows := ComputeSLOs(posts, config.SLOConfig{}, now)
+	if len(windows) != 2 || windows[0].Hours != 24 || windows[1].Hours != 168 {
+		t.Fatalf("windows = %+v, want the default day and week", windows)
+	}
+
+	day, week := windows[0], windows[1]
+
+	if day.Attempts != 4 || day.Succeeded != 3 || day.Failed != 1 || day.SuccessRate != 75 {
+		t.Errorf("day = %+v, want 3 of 4 attempts at 75%%", day)
+	}
+
+	if day.P50LatencySeconds != 20 || day.P95LatencySeconds != 30 {
+		t.Errorf("day latency p50 %v p95 %v, want 20s and 30s", day.P50LatencySeconds, day.P95LatencySeconds)
+	}
+
+	if len(day.Breaches) != 1 || !strings.Contains(day.Breaches[0], "success rate 75.0%") {
+		t.Errorf("day breaches = %v, want only the success rate", day.Breaches)
+	}
+
+	if week.Attempts != 5 || week.P95LatencySeconds != 1200 {
+		t.Errorf("week = %+v, want 5 attempts and a 20 minute p95", week)
+	}
+
+	if len(week.Breaches) != 2 || !strings.Contains(week.Breaches[1], "p95 publish latency 1200s") {
+		t.Errorf("week breaches = %v, want the success rate and the latency", week.Breaches)
+	}
+}
+
+func TestComputeSLOsTargets(t *testing.T) {
+	now := time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC)
+	posts := []models.Post{publishedPost(now, 1, 2*time.Minute)}
+
+	cfg := config.SLOConfig{WindowHours: []int{6, 0}, P95LatencyTargetSeconds: 60}
+
+	windows := ComputeSLOs(posts, cfg, now)
+	if len(windows) != 1 || windows[0].Hours != 6 {
+		t.Fatalf("windows = %+v, want only the 6 hour window", windows)
+	}
+
+	if len(windows[0].Breaches) != 1 || !strings.Contains(windows[0].Breaches[0], "above the 60s target") {
+		t.Errorf("breaches = %v, want the tighter latency target", windows[0].Breaches)
+	}
+
+	empty := ComputeSLOs(nil, cfg, now)[0]
+	if empty.SuccessRate != 100 || len(empty.Breaches) != 0 {
+		t.Errorf("window without attempts = %+v, want 100%% and no breaches", empty)
+	}
+}
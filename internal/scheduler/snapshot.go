@@ -0,0 +1,47 @@
+package scheduler
+
+import (
+	"time"
+
+	"PostedIn/internal/config"
+	"PostedIn/internal/models"
+)
+
+// Snapshot is a coherent view of the posts taken at a single moment, so a listing never shows a
+// post as ready to post while a timer is already publishing it.
+type Snapshot struct {
+	Posts      []models.Post
+	Publishing map[int]bool // Posts with a publish attempt in flight at Now
+	Now        time.Time
+}
+
+// Snapshot copies the posts and the in-flight publishes under the lock, along with the current time.
+func (s *Scheduler) Snapshot(cfg *config.Config) Snapshot {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	now, err := cfg.Now()
+	if err != nil {
+		now = time.Now()
+	}
+
+	publishing := make(map[int]bool, len(s.publishing))
+	for id := range s.publishing {
+		publishing[id] = true
+	}
+
+	return Snapshot{Posts: clonePosts(s.Posts), Publishing: publishing, Now: now}
+}
+
+// Label returns the status to display for a post of the snapshot: "publishing" while an attempt is
+// in flight, "ready to post" for a due scheduled post, and the stored status otherwise.
+func (snap Snapshot) Label(post models.Post) string {
+	switch {
+	case snap.Publishing[post.ID] && post.Status == models.StatusScheduled:
+		return models.StatusPublishing
+	case post.Status == models.StatusScheduled && !post.ScheduledAt.After(snap.Now):
+		return "ready to post"
+	}
+
+	return post.Status
+}
@@ -0,0 +1,123 @@
+package scheduler
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"sync"
+	"testing"
+	"time"
+
+	"PostedIn/internal/models"
+)
+
+func TestSnapshotLabel(t *testing.T) {
+	now := time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC)
+	snap := Snapshot{Now: now, Publishing: map[int]bool{2: true, 4: true}}
+
+	tests := []struct {
+		post models.Post
+		want string
+	}{
+		{models.Post{ID: 1, Status: models.StatusScheduled, ScheduledAt: now.Add(-time.Minute)}, "ready to post"},
+		{models.Post{ID: 2, Status: models.StatusScheduled, ScheduledAt: now.Add(-time.Minute)}, models.StatusPublishing},
+		{models.Post{ID: 3, Status: models.StatusScheduled, ScheduledAt: now.Add(time.Minute)}, models.StatusScheduled},
+		{models.Post{ID: 4, Status: models.StatusPosted, ScheduledAt: now.Add(-time.Minute)}, models.StatusPosted},
+		{models.Post{ID: 5, Status: models.StatusFailed, ScheduledAt: now.Add(-time.Minute)}, models.StatusFailed},
+	}
+
+	for _, tt := range tests {
+		if got := snap.Label(tt.post); got != tt.want {
+			t.Errorf("post %d: Label = %q, want %q", tt.post.ID, got, tt.want)
+		}
+	}
+}
+
+// Run with -race: listings taken while timers publish must each reflect one coherent moment.
+func TestSnapshotDuringConcurrentPublishes(t *testing.T) {
+	const count = 20
+
+	cfg := testConfig()
+	cfg.DryRun = true
+
+	s := newTestScheduler(t)
+	s.LoadSwitches(cfg)
+
+	backup := Backup{Version: BackupVersion}
+	for id := 1; id <= count; id++ {
+		backup.Posts = append(backup.Posts, models.Post{
+			ID:          id,
+			Content:     "due",
+			ScheduledAt: time.Now().Add(-time.Minute),
+			Status:      models.StatusScheduled,
+			PostType:    models.PostTypeText,
+		})
+	}
+
+	data, err := json.Marshal(backup)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := s.Restore(bytes.NewReader(data), cfg); err != nil {
+		t.Fatalf("Restore: %v", err)
+	}
+
+	var wg sync.WaitGroup
+
+	for id := 1; id <= count; id++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			if err := s.PublishToLinkedIn(context.Background(), id, cfg); err != nil {
+				t.Errorf("publish %d: %v", id, err)
+			}
+		}()
+	}
+
+	published := make(map[int]bool)
+	done := make(chan struct{})
+
+	go func() {
+		wg.Wait()
+		close(done)
+	}()
+
+	for listing := true; listing; {
+		select {
+		case <-done:
+			listing = false
+		default:
+		}
+
+		snap := s.Snapshot(cfg)
+
+		for _, post := range snap.Posts {
+			label := snap.Label(post)
+
+			switch {
+			case label == "ready to post" && snap.Publishing[post.ID]:
+				t.Fatalf("post %d listed as ready to post while it is being published", post.ID)
+			case label == models.StatusPublishing && post.Status != models.StatusScheduled:
+				t.Fatalf("post %d listed as publishing with status %q", post.ID, post.Status)
+			case post.Status == models.StatusPosted && post.PublishedAt == nil:
+				t.Fatalf("post %d listed as posted without a publish time", post.ID)
+			case published[post.ID] && post.Status != models.StatusPosted:
+				t.Fatalf("post %d listed as %q after an earlier listing showed it posted", post.ID, label)
+			}
+
+			if post.Status == models.StatusPosted {
+				published[post.ID] = true
+			}
+		}
+	}
+
+	final := s.Snapshot(cfg)
+	for _, post := range final.Posts {
+		if post.Status != models.StatusPosted || final.Publishing[post.ID] {
+			t.Errorf("post %d ended %q (publishing %v), want posted", post.ID, post.Status, final.Publishing[post.ID])
+		}
+	}
+}
@@ -0,0 +1,14 @@
+//go:build sqlite
+
+package scheduler
+
+import (
+	"PostedIn/pkg/storage"
+	"PostedIn/pkg/storage/sqlite"
+)
+
+func init() {
+	openSQLite = func(path string) (storage.Storage, error) {
+		return sqlite.NewStorage(path)
+	}
+}
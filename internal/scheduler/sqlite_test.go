@@ -0,0 +1,32 @@
+//go:build sqlite
+
+package scheduler
+
+import (
+	"path/filepath"
+	"testing"
+
+	"PostedIn/internal/config"
+)
+
+func TestSQLiteBackend(t *testing.T) {
+	cfg := testConfig()
+	cfg.Storage.Backend = config.StorageSQLite
+	cfg.Storage.SQLiteFile = filepath.Join(t.TempDir(), "posts.db")
+
+	s, err := NewSchedulerFromConfig(cfg)
+	if err != nil {
+		t.Fatalf("NewSchedulerFromConfig: %v", err)
+	}
+
+	post := mustAdd(t, s, "stored in SQLite")
+
+	reopened, err := NewSchedulerFromConfig(cfg)
+	if err != nil {
+		t.Fatalf("reopen: %v", err)
+	}
+
+	if posts := reopened.GetPosts(); len(posts) != 1 || posts[0].ID != post.ID {
+		t.Errorf("reopened posts = %+v, want post %d", posts, post.ID)
+	}
+}
@@ -0,0 +1,30 @@
+package scheduler
+
+import (
+	"errors"
+
+	"PostedIn/internal/config"
+	"PostedIn/pkg/storage"
+)
+
+// errSQLiteNotBuilt is returned for the sqlite backend by binaries built without the sqlite tag.
+var errSQLiteNotBuilt = errors.New("storage.backend sqlite needs a binary built with -tags sqlite (and cgo)")
+
+// NewSchedulerFromConfig creates a post scheduler on the configured storage backend.
+func NewSchedulerFromConfig(cfg *config.Config) (*Scheduler, error) {
+	if cfg.Storage.Backend != config.StorageSQLite {
+		return NewSchedulerWithReplica(cfg.Storage.PostsPath(), cfg.Storage.ReplicaFile), nil
+	}
+
+	store, err := openSQLite(cfg.Storage.SQLitePath())
+	if err != nil {
+		return nil, err
+	}
+
+	return NewSchedulerWithStorage(store), nil
+}
+
+// openSQLite opens the SQLite backend; it is set by sqlite.go in builds with the sqlite tag.
+var openSQLite = func(string) (storage.Storage, error) {
+	return nil, errSQLiteNotBuilt
+}
@@ -0,0 +1,108 @@
+package scheduler
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"PostedIn/internal/config"
+	"PostedIn/internal/models"
+	"PostedIn/pkg/storage"
+)
+
+// memoryStorage keeps posts in memory, standing in for a custom storage backend.
+type memoryStorage struct {
+	posts   []models.Post
+	saves   int
+	loadErr error
+}
+
+var _ storage.Storage = (*memoryStorage)(nil)
+
+func (m *memoryStorage) LoadPosts() ([]models.Post, error) {
+	return append([]models.Post(nil), m.posts...), m.loadErr
+}
+
+func (m *memoryStorage) SavePosts(posts []models.Post) error {
+	m.posts = append([]models.Post(nil), posts...)
+	m.saves++
+
+	return nil
+}
+
+// failingStorage loads no posts and fails every save.
+type failingStorage struct{}
+
+func (failingStorage) LoadPosts() ([]models.Post, error) { return nil, nil }
+
+func (failingStorage) SavePosts([]models.Post) error { return errors.New("disk full") }
+
+func TestNewSchedulerFromConfigUsesPostsFile(t *testing.T) {
+	cfg := testConfig()
+	cfg.Storage.PostsFile = filepath.Join(t.TempDir(), "custom.json")
+
+	s, err := NewSchedulerFromConfig(cfg)
+	if err != nil {
+		t.Fatalf("NewSchedulerFromConfig: %v", err)
+	}
+
+	mustAdd(t, s, "stored")
+
+	if _, err := os.Stat(cfg.Storage.PostsFile); err != nil {
+		t.Errorf("posts were not saved to storage.posts_file: %v", err)
+	}
+}
+
+func TestDataPath(t *testing.T) {
+	storage := config.StorageConfig{PostsFile: "a.json", SQLiteFile: "a.db"}
+	if got := storage.DataPath(); got != "a.json" {
+		t.Errorf("json DataPath() = %q, want a.json", got)
+	}
+
+	storage.Backend = config.StorageSQLite
+	if got := storage.DataPath(); got != "a.db" {
+		t.Errorf("sqlite DataPath() = %q, want a.db", got)
+	}
+}
+
+func TestNewSchedulerWithStorage(t *testing.T) {
+	store := &memoryStorage{posts: []models.Post{
+		{ID: 3, Content: "stored", ScheduledAt: time.Now().Add(time.Hour), Status: models.StatusScheduled, PostType: models.PostTypeText},
+	}}
+
+	s := NewSchedulerWithStorage(store)
+	if posts := s.GetPosts(); len(posts) != 1 || posts[0].ID != 3 {
+		t.Fatalf("loaded %+v, want the stored post", posts)
+	}
+
+	post := mustAdd(t, s, "added")
+	if post.ID != 4 {
+		t.Errorf("new post ID = %d, want 4 after the stored posts", post.ID)
+	}
+
+	if store.saves != 1 || len(store.posts) != 2 {
+		t.Fatalf("storage saved %d times holding %d posts, want the add saved through it", store.saves, len(store.posts))
+	}
+
+	if err := s.DeletePost(3); err != nil {
+		t.Fatalf("DeletePost: %v", err)
+	}
+
+	if len(store.posts) != 1 || store.posts[0].ID != 4 {
+		t.Errorf("storage holds %+v after delete, want only post 4", store.posts)
+	}
+}
+
+func TestNewSchedulerWithStorageLoadError(t *testing.T) {
+	s := NewSchedulerWithStorage(&memoryStorage{loadErr: errors.New("backend unavailable")})
+
+	if posts := s.GetPosts(); len(posts) != 0 {
+		t.Fatalf("loaded %d posts from a failing backend, want none", len(posts))
+	}
+
+	if post := mustAdd(t, s, "first"); post.ID != 1 {
+		t.Errorf("new post ID = %d, want 1", post.ID)
+	}
+}
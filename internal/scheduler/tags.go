@@ -0,0 +1,92 @@
+package scheduler
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// maxTagLength bounds a single tag so labels stay readable in listings.
+const maxTagLength = 50
+
+// NormalizeTags trims, lower-cases and strips a leading '#' from each tag, then returns them
+// sorted without duplicates; tags that are empty or contain spaces are rejected.
+func NormalizeTags(tags []string) ([]string, error) {
+	if len(tags) == 0 {
+		return nil, nil
+	}
+
+	normalized := make([]string, 0, len(tags))
+
+	for _, tag := range tags {
+		tag = strings.ToLower(strings.TrimPrefix(strings.TrimSpace(tag), "#"))
+
+		switch {
+		case tag == "":
+			return nil, fmt.Errorf("tags cannot be empty")
+		case strings.ContainsFunc(tag, func(r rune) bool { return r == ' ' || r == '\t' || r == '\n' }):
+			return nil, fmt.Errorf("tag %q cannot contain spaces", tag)
+		case len(tag) > maxTagLength:
+			return nil, fmt.Errorf("tag %q is longer than %d characters", tag, maxTagLength)
+		}
+
+		normalized = append(normalized, tag)
+	}
+
+	slices.Sort(normalized)
+
+	return slices.Compact(normalized), nil
+}
+
+// TagPosts adds the tags to, or with remove set removes them from, every post matching the filter
+// and returns how many posts changed.
+func (s *Scheduler) TagPosts(filter PostFilter, tags []string, remove bool) (int, error) {
+	tags, err := NormalizeTags(tags)
+	if err != nil {
+		return 0, err
+	}
+
+	if len(tags) == 0 {
+		return 0, fmt.Errorf("at least one tag is required")
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	changed := 0
+
+	for i := range s.Posts {
+		post := &s.Posts[i]
+		if !filter.Matches(*post) {
+			continue
+		}
+
+		var updated []string
+		if remove {
+			updated = slices.DeleteFunc(slices.Clone(post.Tags), func(tag string) bool { return slices.Contains(tags, tag) })
+		} else {
+			updated, _ = NormalizeTags(append(slices.Clone(post.Tags), tags...))
+		}
+
+		if slices.Equal(updated, post.Tags) {
+			continue
+		}
+
+		if len(updated) == 0 {
+			updated = nil
+		}
+
+		post.Tags = updated
+		changed++
+	}
+
+	if changed == 0 {
+		return 0, nil
+	}
+
+	if err := s.savePosts(); err != nil {
+		return 0, fmt.Errorf("failed to save tagged posts: %w", err)
+	}
+
+	return changed, nil
+}
@@ -0,0 +1,124 @@
+package scheduler
+
+import (
+	"bytes"
+	"encoding/json"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"PostedIn/internal/models"
+)
+
+func TestNormalizeTags(t *testing.T) {
+	got, err := NormalizeTags([]string{" #Launch", "product", "launch", "PRODUCT"})
+	if err != nil {
+		t.Fatalf("NormalizeTags: %v", err)
+	}
+
+	if !slices.Equal(got, []string{"launch", "product"}) {
+		t.Errorf("NormalizeTags = %v, want launch and product", got)
+	}
+
+	for _, tags := range [][]string{{""}, {"#"}, {"two words"}, {strings.Repeat("a", maxTagLength+1)}} {
+		if _, err := NormalizeTags(tags); err == nil {
+			t.Errorf("NormalizeTags(%q) succeeded, want an error", tags)
+		}
+	}
+}
+
+// restoreTagged loads posts 1-4: two scheduled launch posts on Nov 2 and Nov 5, a draft about the
+// launch on Nov 3 and a scheduled hiring post on Nov 4, all at 09:00 UTC.
+func restoreTagged(t *testing.T, s *Scheduler) {
+	t.Helper()
+
+	day := func(d int) time.Time { return time.Date(2026, 11, d, 9, 0, 0, 0, time.UTC) }
+
+	backup := Backup{Version: BackupVersion, Posts: []models.Post{
+		{ID: 1, Content: "Launch week starts", ScheduledAt: day(2), Status: models.StatusScheduled, Tags: []string{"launch"}},
+		{ID: 2, Content: "Draft about the LAUNCH", ScheduledAt: day(3), Status: models.StatusDraft},
+		{ID: 3, Content: "We are hiring", ScheduledAt: day(4), Status: models.StatusScheduled},
+		{ID: 4, Content: "Launch recap", ScheduledAt: day(5), Status: models.StatusScheduled},
+	}}
+
+	data, err := json.Marshal(backup)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := s.Restore(bytes.NewReader(data), testConfig()); err != nil {
+		t.Fatalf("Restore: %v", err)
+	}
+}
+
+func tagsByID(s *Scheduler) map[int][]string {
+	tags := make(map[int][]string)
+	for _, post := range s.GetPosts() {
+		tags[post.ID] = post.Tags
+	}
+
+	return tags
+}
+
+func TestTagPostsByFilter(t *testing.T) {
+	s := newTestScheduler(t)
+	restoreTagged(t, s)
+
+	filter := PostFilter{
+		Status: models.StatusScheduled,
+		From:   time.Date(2026, 11, 2, 0, 0, 0, 0, time.UTC),
+		To:     time.Date(2026, 11, 5, 9, 0, 0, 0, time.UTC),
+		Search: "launch",
+	}
+
+	changed, err := s.TagPosts(filter, []string{"#Q4", "launch"}, false)
+	if err != nil {
+		t.Fatalf("TagPosts: %v", err)
+	}
+
+	if changed != 2 {
+		t.Errorf("changed %d posts, want the scheduled launch posts 1 and 4", changed)
+	}
+
+	want := map[int][]string{1: {"launch", "q4"}, 2: nil, 3: nil, 4: {"launch", "q4"}}
+	for id, tags := range tagsByID(s) {
+		if !slices.Equal(tags, want[id]) {
+			t.Errorf("post %d tags = %v, want %v", id, tags, want[id])
+		}
+	}
+
+	// Adding tags every match already has changes nothing
+	if changed, err := s.TagPosts(filter, []string{"q4"}, false); err != nil || changed != 0 {
+		t.Errorf("repeated TagPosts = %d, %v, want no change", changed, err)
+	}
+}
+
+func TestTagPostsRemove(t *testing.T) {
+	s := newTestScheduler(t)
+	restoreTagged(t, s)
+
+	if _, err := s.TagPosts(PostFilter{}, []string{"q4"}, false); err != nil {
+		t.Fatalf("TagPosts: %v", err)
+	}
+
+	changed, err := s.TagPosts(PostFilter{Search: "launch"}, []string{"launch", "q4"}, true)
+	if err != nil {
+		t.Fatalf("TagPosts remove: %v", err)
+	}
+
+	if changed != 3 {
+		t.Errorf("removed from %d posts, want the three launch posts", changed)
+	}
+
+	want := map[int][]string{1: nil, 2: nil, 3: {"q4"}, 4: nil}
+	for id, tags := range tagsByID(s) {
+		if !slices.Equal(tags, want[id]) {
+			t.Errorf("post %d tags = %v, want %v", id, tags, want[id])
+		}
+	}
+
+	if _, err := s.TagPosts(PostFilter{}, nil, false); err == nil {
+		t.Error("TagPosts accepted no tags")
+	}
+}
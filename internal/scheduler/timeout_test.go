@@ -0,0 +1,105 @@
+package scheduler
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"PostedIn/internal/config"
+	"PostedIn/internal/models"
+
+	"golang.org/x/oauth2"
+)
+
+func TestPublishTimeoutByPostType(t *testing.T) {
+	cfg := testConfig()
+	cfg.Cron = config.CronConfig{PublishTimeoutSeconds: 30, MediaPublishTimeoutSeconds: 600}
+
+	tests := []struct {
+		name string
+		post models.Post
+		want time.Duration
+	}{
+		{"text", models.Post{PostType: models.PostTypeText}, 30 * time.Second},
+		{"image", models.Post{PostType: models.PostTypeText, ImagePath: "photo.png"}, 10 * time.Minute},
+		{"video", models.Post{PostType: models.PostTypeVideo, VideoPath: "clip.mp4"}, 10 * time.Minute},
+	}
+
+	for _, tt := range tests {
+		if got := PublishTimeout(tt.post, cfg); got != tt.want {
+			t.Errorf("%s: PublishTimeout = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestPublishTimesOut(t *testing.T) {
+	// LinkedIn never answers, so only the configured timeout ends the publish. The server only
+	// notices the client went away once the request body is read.
+	cfg := useFakeLinkedIn(t, http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+		_, _ = io.Copy(io.Discard, r.Body)
+		<-r.Context().Done()
+	}))
+	cfg.Cron.PublishTimeoutSeconds = 1
+
+	s := newTestScheduler(t)
+	post := mustAdd(t, s, "slow network")
+
+	start := time.Now()
+
+	err := s.PublishToLinkedIn(context.Background(), post.ID, cfg)
+	if err == nil || !strings.Contains(err.Error(), "publish timed out after 1s") {
+		t.Fatalf("PublishToLinkedIn = %v, want a timeout error naming the configured timeout", err)
+	}
+
+	if elapsed := time.Since(start); elapsed > 30*time.Second {
+		t.Errorf("publish took %v, want it cut off after the configured second", elapsed)
+	}
+}
+
+// useFakeLinkedIn sends every LinkedIn API request to handler and returns a config holding a
+// valid token and a cached author, so publishes reach the handler without probing or saving.
+func useFakeLinkedIn(t *testing.T, handler http.Handler) *config.Config {
+	t.Helper()
+
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	target, err := url.Parse(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	previous := http.DefaultTransport
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		req = req.Clone(req.Context())
+		req.URL.Scheme, req.URL.Host = target.Scheme, target.Host
+
+		return previous.RoundTrip(req)
+	})
+
+	t.Cleanup(func() { http.DefaultTransport = previous })
+
+	cfg := testConfig()
+	cfg.LinkedIn.AuthorURN = "urn:li:person:abc"
+	cfg.Storage.TokenFile = filepath.Join(t.TempDir(), "token.json")
+
+	token := &oauth2.Token{AccessToken: "token", TokenType: "Bearer", Expiry: time.Now().Add(time.Hour)}
+	if err := config.SaveToken(token, cfg.Storage.TokenFile); err != nil {
+		t.Fatalf("SaveToken: %v", err)
+	}
+
+	return cfg
+}
+
+// roundTripFunc lets a function stand in for an http.RoundTripper.
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
@@ -0,0 +1,41 @@
+package scheduler
+
+import (
+	"fmt"
+	"time"
+
+	"PostedIn/internal/config"
+
+	"golang.org/x/oauth2"
+)
+
+// TokenExpiryWarning returns a warning when the account's stored LinkedIn token expires before the
+// given publish time and cannot be refreshed, so the publish would fail without re-authentication.
+// It returns an empty string when there is nothing to warn about.
+func TokenExpiryWarning(publishAt time.Time, account string, cfg *config.Config) string {
+	if publishAt.IsZero() {
+		return ""
+	}
+
+	view, err := cfg.ForAccount(account)
+	if err != nil {
+		return ""
+	}
+
+	token, err := config.LoadToken(view.Storage.TokenFile)
+	if err != nil || token == nil {
+		return ""
+	}
+
+	return expiryWarning(token, publishAt)
+}
+
+// expiryWarning compares the token's expiry with the publish time.
+func expiryWarning(token *oauth2.Token, publishAt time.Time) string {
+	if token.RefreshToken != "" || token.Expiry.IsZero() || !token.Expiry.Before(publishAt) {
+		return ""
+	}
+
+	return fmt.Sprintf("LinkedIn token expires at %s, before this post is published; re-authenticate before then or publishing will fail",
+		token.Expiry.In(publishAt.Location()).Format("2006-01-02 15:04 MST"))
+}
@@ -0,0 +1,86 @@
+package scheduler
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"PostedIn/internal/config"
+
+	"golang.org/x/oauth2"
+)
+
+func tokenConfig(t *testing.T, token *oauth2.Token) *config.Config {
+	t.Helper()
+
+	cfg := testConfig()
+	cfg.Storage.TokenFile = filepath.Join(t.TempDir(), "token.json")
+
+	if token != nil {
+		if err := config.SaveToken(token, cfg.Storage.TokenFile); err != nil {
+			t.Fatalf("SaveToken: %v", err)
+		}
+	}
+
+	return cfg
+}
+
+func TestTokenExpiryWarning(t *testing.T) {
+	expiry := time.Now().Add(24 * time.Hour)
+	cfg := tokenConfig(t, &oauth2.Token{AccessToken: "access", Expiry: expiry})
+
+	warning := TokenExpiryWarning(expiry.Add(time.Hour), "", cfg)
+	if !strings.Contains(warning, "re-authenticate") {
+		t.Errorf("publishing after expiry: warning = %q, want a re-authentication warning", warning)
+	}
+
+	if warning := TokenExpiryWarning(expiry.Add(-time.Hour), "", cfg); warning != "" {
+		t.Errorf("publishing before expiry: warning = %q, want none", warning)
+	}
+
+	if warning := TokenExpiryWarning(time.Time{}, "", cfg); warning != "" {
+		t.Errorf("post without a publish time: warning = %q, want none", warning)
+	}
+}
+
+func TestTokenExpiryWarningRefreshable(t *testing.T) {
+	expiry := time.Now().Add(time.Hour)
+	cfg := tokenConfig(t, &oauth2.Token{AccessToken: "access", RefreshToken: "refresh", Expiry: expiry})
+
+	if warning := TokenExpiryWarning(expiry.AddDate(0, 0, 7), "", cfg); warning != "" {
+		t.Errorf("refreshable token: warning = %q, want none", warning)
+	}
+}
+
+func TestTokenExpiryWarningWithoutToken(t *testing.T) {
+	cfg := tokenConfig(t, nil)
+
+	if warning := TokenExpiryWarning(time.Now().AddDate(1, 0, 0), "", cfg); warning != "" {
+		t.Errorf("missing token file: warning = %q, want none", warning)
+	}
+
+	if warning := TokenExpiryWarning(time.Now().AddDate(1, 0, 0), "unknown", cfg); warning != "" {
+		t.Errorf("unknown account: warning = %q, want none", warning)
+	}
+}
+
+func TestTokenExpiryWarningUsesAccountToken(t *testing.T) {
+	expiry := time.Now().Add(time.Hour)
+	cfg := tokenConfig(t, &oauth2.Token{AccessToken: "default", RefreshToken: "refresh", Expiry: expiry})
+
+	brand := filepath.Join(t.TempDir(), "brand.json")
+	if err := config.SaveToken(&oauth2.Token{AccessToken: "brand", Expiry: expiry}, brand); err != nil {
+		t.Fatalf("SaveToken: %v", err)
+	}
+
+	cfg.Accounts = map[string]config.AccountConfig{"brand": {TokenFile: brand}}
+
+	if warning := TokenExpiryWarning(expiry.Add(time.Hour), "brand", cfg); warning == "" {
+		t.Error("the brand account's expiring token produced no warning")
+	}
+
+	if warning := TokenExpiryWarning(expiry.Add(time.Hour), "", cfg); warning != "" {
+		t.Errorf("default account with a refresh token: warning = %q, want none", warning)
+	}
+}
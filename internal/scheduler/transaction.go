@@ -0,0 +1,70 @@
+package scheduler
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	// ErrPostNotFound is returned when an operation references a post ID that does not exist.
+	ErrPostNotFound = errors.New("post not found")
+	// ErrTxDone is returned when committing a transaction that was already committed or rolled back.
+	ErrTxDone = errors.New("transaction already ended")
+)
+
+// Tx stages changes on a copy of the scheduler's posts. Its Add, UpdatePost and DeletePost
+// work as usual but nothing is saved or visible until Commit; Rollback discards them.
+type Tx struct {
+	*Scheduler
+	parent *Scheduler
+	done   bool
+}
+
+// Begin starts a transaction over the current posts. The scheduler stays locked until Commit or
+// Rollback, so no timer or request can change the posts underneath it; always end it, usually
+// with a deferred Rollback.
+func (s *Scheduler) Begin() *Tx {
+	s.mu.Lock()
+
+	staged := &Scheduler{
+		Posts:  clonePosts(s.Posts),
+		nextID: s.nextID,
+		quiet:  true,
+	}
+
+	return &Tx{Scheduler: staged, parent: s}
+}
+
+// Commit replaces the scheduler's posts with the staged ones and saves them in a single write.
+// If saving fails the scheduler keeps its previous posts.
+func (tx *Tx) Commit() error {
+	if tx.done {
+		return ErrTxDone
+	}
+
+	defer tx.end()
+
+	previous, previousID := tx.parent.Posts, tx.parent.nextID
+
+	tx.parent.Posts, tx.parent.nextID = tx.Posts, tx.nextID
+
+	if err := tx.parent.savePosts(); err != nil {
+		tx.parent.Posts, tx.parent.nextID = previous, previousID
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// Rollback discards the staged changes; it does nothing after Commit.
+func (tx *Tx) Rollback() {
+	if !tx.done {
+		tx.end()
+	}
+}
+
+// end releases the scheduler locked by Begin.
+func (tx *Tx) end() {
+	tx.done = true
+	tx.parent.mu.Unlock()
+}
@@ -0,0 +1,136 @@
+package scheduler
+
+import (
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"PostedIn/internal/config"
+	"PostedIn/internal/models"
+)
+
+// newTestScheduler returns a scheduler saving to a temporary posts file.
+func newTestScheduler(t *testing.T) *Scheduler {
+	t.Helper()
+
+	return NewScheduler(filepath.Join(t.TempDir(), "posts.json"))
+}
+
+// testConfig returns a config scheduling in UTC.
+func testConfig() *config.Config {
+	return &config.Config{Timezone: config.TimezoneConfig{Location: "UTC"}}
+}
+
+// mustAdd schedules a post with content an hour from now.
+func mustAdd(t *testing.T, s *Scheduler, content string) models.Post {
+	t.Helper()
+
+	post, err := s.Add(models.Post{Content: content, ScheduledAt: time.Now().Add(time.Hour)}, testConfig())
+	if err != nil {
+		t.Fatalf("Add(%q): %v", content, err)
+	}
+
+	return post
+}
+
+func TestTxCommitKeepsConcurrentChanges(t *testing.T) {
+	s := newTestScheduler(t)
+	cfg := testConfig()
+	other := mustAdd(t, s, "other")
+	doomed := mustAdd(t, s, "doomed")
+
+	tx := s.Begin()
+
+	var (
+		wg          sync.WaitGroup
+		concurrent  models.Post
+		addErr      error
+		markErr     error
+		markStarted = make(chan struct{})
+	)
+
+	wg.Add(1)
+
+	go func() {
+		defer wg.Done()
+		close(markStarted)
+
+		_, markErr = s.MarkAsPosted(other.ID)
+		concurrent, addErr = s.Add(models.Post{Content: "concurrent", ScheduledAt: time.Now().Add(time.Hour)}, cfg)
+	}()
+
+	<-markStarted
+
+	staged, err := tx.Add(models.Post{Content: "staged", ScheduledAt: time.Now().Add(time.Hour)}, cfg)
+	if err != nil {
+		t.Fatalf("tx.Add: %v", err)
+	}
+
+	if err := tx.DeletePost(doomed.ID); err != nil {
+		t.Fatalf("tx.DeletePost: %v", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		t.Fatalf("Commit: %v", err)
+	}
+
+	wg.Wait()
+
+	if markErr != nil || addErr != nil {
+		t.Fatalf("concurrent changes failed: %v, %v", markErr, addErr)
+	}
+
+	if concurrent.ID == staged.ID {
+		t.Fatalf("concurrent create reused ID %d of the transaction", staged.ID)
+	}
+
+	posts := map[int]models.Post{}
+	for _, post := range s.GetPosts() {
+		posts[post.ID] = post
+	}
+
+	if posts[other.ID].Status != models.StatusPosted {
+		t.Errorf("post %d status = %q, want %q", other.ID, posts[other.ID].Status, models.StatusPosted)
+	}
+
+	if _, ok := posts[doomed.ID]; ok {
+		t.Errorf("post %d was deleted in the transaction but still exists", doomed.ID)
+	}
+
+	for _, id := range []int{staged.ID, concurrent.ID} {
+		if _, ok := posts[id]; !ok {
+			t.Errorf("post %d is missing after commit", id)
+		}
+	}
+}
+
+func TestTxRollbackDiscardsChanges(t *testing.T) {
+	s := newTestScheduler(t)
+	kept := mustAdd(t, s, "kept")
+
+	tx := s.Begin()
+
+	if _, err := tx.Add(models.Post{Content: "staged", ScheduledAt: time.Now().Add(time.Hour)}, testConfig()); err != nil {
+		t.Fatalf("tx.Add: %v", err)
+	}
+
+	if err := tx.DeletePost(kept.ID); err != nil {
+		t.Fatalf("tx.DeletePost: %v", err)
+	}
+
+	tx.Rollback()
+	tx.Rollback() // A second rollback, as from a deferred call, is harmless
+
+	if err := tx.Commit(); err != ErrTxDone {
+		t.Errorf("Commit after Rollback = %v, want %v", err, ErrTxDone)
+	}
+
+	posts := s.GetPosts()
+	if len(posts) != 1 || posts[0].ID != kept.ID {
+		t.Fatalf("posts after rollback = %+v, want only post %d", posts, kept.ID)
+	}
+
+	// The scheduler is unlocked again
+	mustAdd(t, s, "after")
+}
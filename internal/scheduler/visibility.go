@@ -0,0 +1,67 @@
+package scheduler
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"PostedIn/internal/config"
+	"PostedIn/internal/models"
+	"PostedIn/pkg/linkedin"
+)
+
+// PostVisibility returns the post's own visibility, or the one the visibility rules pick for its time.
+func PostVisibility(post *models.Post, cfg *config.Config) string {
+	if post.Visibility != "" {
+		return post.Visibility
+	}
+
+	return ResolveVisibility(post.ScheduledAt, cfg)
+}
+
+// normalizeVisibility upper-cases and validates a post's visibility; a company page has no
+// connections to limit a post to.
+func normalizeVisibility(post *models.Post) error {
+	if strings.TrimSpace(post.Visibility) == "" {
+		post.Visibility = ""
+		return nil
+	}
+
+	visibility, err := linkedin.NormalizeVisibility(post.Visibility)
+	if err != nil {
+		return err
+	}
+
+	if visibility == linkedin.VisibilityConnections && post.IsOrganization() {
+		return fmt.Errorf("a company page post cannot be limited to %s", linkedin.VisibilityConnections)
+	}
+
+	post.Visibility = visibility
+
+	return nil
+}
+
+// ResolveVisibility returns the visibility for a post published at the given time using the
+// configured day/time rules in the configured timezone, falling back to the default visibility.
+func ResolveVisibility(at time.Time, cfg *config.Config) string {
+	loc, err := cfg.GetTimezone()
+	if err != nil {
+		loc = time.UTC
+	}
+
+	local := at.In(loc)
+
+	for _, rule := range cfg.Visibility.Rules {
+		if rule.Matches(local) {
+			if visibility, err := linkedin.NormalizeVisibility(rule.Visibility); err == nil {
+				return visibility
+			}
+		}
+	}
+
+	if visibility, err := linkedin.NormalizeVisibility(cfg.Visibility.Default); err == nil {
+		return visibility
+	}
+
+	return linkedin.VisibilityPublic
+}
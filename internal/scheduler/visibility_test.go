@@ -0,0 +1,162 @@
+package scheduler
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"PostedIn/internal/config"
+	"PostedIn/internal/models"
+	"PostedIn/pkg/linkedin"
+)
+
+func visibilityConfig() *config.Config {
+	cfg := testConfig()
+	cfg.Timezone.Location = "America/New_York"
+	cfg.Visibility = config.VisibilityConfig{
+		Default: "public",
+		Rules: []config.VisibilityRule{
+			{Days: []string{"weekend"}, Visibility: "connections"},
+			{Days: []string{"weekdays"}, From: "22:00", To: "06:00", Visibility: "logged_in"},
+		},
+	}
+
+	return cfg
+}
+
+func TestResolveVisibility(t *testing.T) {
+	cfg := visibilityConfig()
+	newYork, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skipf("timezone data unavailable: %v", err)
+	}
+
+	tests := []struct {
+		name string
+		at   time.Time
+		want string
+	}{
+		{"weekend", time.Date(2025, 6, 7, 10, 0, 0, 0, newYork), linkedin.VisibilityConnections},
+		{"weekday daytime", time.Date(2025, 6, 4, 10, 0, 0, 0, newYork), linkedin.VisibilityPublic},
+		{"weekday late evening", time.Date(2025, 6, 4, 23, 30, 0, 0, newYork), linkedin.VisibilityLoggedIn},
+		{"weekday early morning", time.Date(2025, 6, 4, 5, 59, 0, 0, newYork), linkedin.VisibilityLoggedIn},
+		{"window end is exclusive", time.Date(2025, 6, 4, 6, 0, 0, 0, newYork), linkedin.VisibilityPublic},
+		// Saturday 03:00 UTC is still Friday evening in New York
+		{"configured timezone", time.Date(2025, 6, 7, 3, 0, 0, 0, time.UTC), linkedin.VisibilityLoggedIn},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ResolveVisibility(tt.at, cfg); got != tt.want {
+				t.Errorf("ResolveVisibility(%v) = %q, want %q", tt.at, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestResolveVisibilityDefault(t *testing.T) {
+	at := time.Date(2025, 6, 7, 10, 0, 0, 0, time.UTC)
+
+	if got := ResolveVisibility(at, testConfig()); got != linkedin.VisibilityPublic {
+		t.Errorf("without rules = %q, want %q", got, linkedin.VisibilityPublic)
+	}
+
+	cfg := testConfig()
+	cfg.Visibility.Default = "logged_in"
+
+	if got := ResolveVisibility(at, cfg); got != linkedin.VisibilityLoggedIn {
+		t.Errorf("with a default = %q, want %q", got, linkedin.VisibilityLoggedIn)
+	}
+}
+
+func TestPostVisibility(t *testing.T) {
+	cfg := visibilityConfig()
+	saturday := time.Date(2025, 6, 7, 15, 0, 0, 0, time.UTC)
+
+	post := models.Post{ScheduledAt: saturday}
+	if got := PostVisibility(&post, cfg); got != linkedin.VisibilityConnections {
+		t.Errorf("rule visibility = %q, want %q", got, linkedin.VisibilityConnections)
+	}
+
+	post.Visibility = linkedin.VisibilityPublic
+	if got := PostVisibility(&post, cfg); got != linkedin.VisibilityPublic {
+		t.Errorf("explicit visibility = %q, want it kept", got)
+	}
+}
+
+func TestNormalizeVisibility(t *testing.T) {
+	tests := []struct {
+		name    string
+		post    models.Post
+		want    string
+		wantErr bool
+	}{
+		{"empty", models.Post{Visibility: "  "}, "", false},
+		{"lower case", models.Post{Visibility: "connections"}, linkedin.VisibilityConnections, false},
+		{"logged in", models.Post{Visibility: "logged_in"}, linkedin.VisibilityLoggedIn, false},
+		{"unknown", models.Post{Visibility: "friends"}, "friends", true},
+		{"company connections", models.Post{AuthorType: models.AuthorOrganization, Visibility: "CONNECTIONS"}, "CONNECTIONS", true},
+		{"company logged in", models.Post{AuthorType: models.AuthorOrganization, Visibility: "LOGGED_IN"}, linkedin.VisibilityLoggedIn, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			post := tt.post
+
+			err := normalizeVisibility(&post)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("normalizeVisibility(%q) error = %v, wantErr %v", tt.post.Visibility, err, tt.wantErr)
+			}
+
+			if post.Visibility != tt.want {
+				t.Errorf("visibility = %q, want %q", post.Visibility, tt.want)
+			}
+		})
+	}
+}
+
+func TestPublishSendsPostVisibility(t *testing.T) {
+	var (
+		mu   sync.Mutex
+		sent []string
+	)
+
+	cfg := useFakeLinkedIn(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			Visibility string `json:"visibility"`
+		}
+		_ = json.NewDecoder(r.Body).Decode(&body)
+
+		mu.Lock()
+		sent = append(sent, body.Visibility)
+		mu.Unlock()
+
+		w.Header().Set("x-restli-id", "urn:li:share:42")
+		w.WriteHeader(http.StatusCreated)
+	}))
+
+	s := newTestScheduler(t)
+
+	for _, visibility := range []string{"connections", "", "logged_in"} {
+		post, err := s.Add(models.Post{Content: "visibility " + visibility, ScheduledAt: time.Now().Add(time.Hour), Visibility: visibility}, cfg)
+		if err != nil {
+			t.Fatalf("Add: %v", err)
+		}
+
+		if err := s.PublishToLinkedIn(context.Background(), post.ID, cfg); err != nil {
+			t.Fatalf("publish with visibility %q: %v", visibility, err)
+		}
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+
+	want := []string{linkedin.VisibilityConnections, linkedin.VisibilityPublic, linkedin.VisibilityLoggedIn}
+	if !slices.Equal(sent, want) {
+		t.Errorf("payload visibilities = %q, want %q", sent, want)
+	}
+}
@@ -0,0 +1,82 @@
+// Package sheets syncs scheduled posts from a Google Sheet into the scheduler.
+package sheets
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+const (
+	sheetsAPIBase  = "https://sheets.googleapis.com/v4/spreadsheets"
+	requestTimeout = 30 * time.Second
+)
+
+// Source provides the rows of a sheet range, header row first.
+type Source interface {
+	Rows(ctx context.Context) ([][]string, error)
+}
+
+// HTTPSource reads a sheet range through the Google Sheets REST API using an API key.
+type HTTPSource struct {
+	SheetID string
+	Range   string
+	APIKey  string
+	client  *http.Client
+}
+
+// NewHTTPSource creates a Sheets API source for the given sheet and A1 range.
+func NewHTTPSource(sheetID, rng, apiKey string) *HTTPSource {
+	return &HTTPSource{
+		SheetID: sheetID,
+		Range:   rng,
+		APIKey:  apiKey,
+		client:  &http.Client{Timeout: requestTimeout},
+	}
+}
+
+// Rows fetches the values of the configured range.
+func (s *HTTPSource) Rows(ctx context.Context) ([][]string, error) {
+	endpoint := fmt.Sprintf("%s/%s/values/%s?key=%s",
+		sheetsAPIBase, url.PathEscape(s.SheetID), url.PathEscape(s.Range), url.QueryEscape(s.APIKey))
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, http.NoBody)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch sheet: %w", err)
+	}
+
+	defer func() {
+		if closeErr := resp.Body.Close(); closeErr != nil {
+			log.Printf("Warning: failed to close response body: %v", closeErr)
+		}
+	}()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response: %w", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("sheets API error (%d): %s", resp.StatusCode, string(body))
+	}
+
+	var result struct {
+		Values [][]string `json:"values"`
+	}
+
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, fmt.Errorf("failed to parse sheet values: %w", err)
+	}
+
+	return result.Values, nil
+}
@@ -0,0 +1,282 @@
+package sheets
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+	"time"
+
+	"PostedIn/internal/config"
+	"PostedIn/internal/models"
+	"PostedIn/internal/scheduler"
+)
+
+const (
+	defaultPollInterval      = 15 * time.Minute
+	defaultContentColumn     = "content"
+	defaultScheduledAtColumn = "scheduled_at"
+	dateLength               = 10
+	dateTimeMinLength        = 16
+	cursorFilePerm           = 0o600
+)
+
+// PostAdder stores new posts; *scheduler.Scheduler satisfies it.
+type PostAdder interface {
+	Add(post models.Post, cfg *config.Config) (models.Post, error)
+}
+
+// cursor records how far a sheet range has been synced.
+type cursor struct {
+	SheetID string `json:"sheet_id"`
+	Range   string `json:"range"`
+	LastRow int    `json:"last_row"` // Number of data rows (excluding the header) already processed
+}
+
+// Syncer pulls new sheet rows into scheduled posts, remembering the last synced row.
+type Syncer struct {
+	source     Source
+	adder      PostAdder
+	cfg        *config.Config
+	cursorFile string
+	onAdded    func(models.Post)
+}
+
+// NewSyncer creates a syncer reading rows from source and storing posts through adder.
+func NewSyncer(source Source, adder PostAdder, cfg *config.Config) *Syncer {
+	return &Syncer{
+		source:     source,
+		adder:      adder,
+		cfg:        cfg,
+		cursorFile: cfg.Sheets.Cursor(),
+	}
+}
+
+// NewSyncerFromConfig creates a syncer for the sheet configured in cfg, or nil when sync is disabled.
+func NewSyncerFromConfig(cfg *config.Config, adder PostAdder) (*Syncer, error) {
+	if !cfg.Sheets.Enabled {
+		return nil, nil
+	}
+
+	if cfg.Sheets.SheetID == "" || cfg.Sheets.Range == "" || cfg.Sheets.APIKey == "" {
+		return nil, fmt.Errorf("sheets.sheet_id, sheets.range and sheets.api_key are required when sheets sync is enabled")
+	}
+
+	source := NewHTTPSource(cfg.Sheets.SheetID, cfg.Sheets.Range, cfg.Sheets.APIKey)
+
+	return NewSyncer(source, adder, cfg), nil
+}
+
+// OnAdded registers a callback invoked for every post created from the sheet.
+func (s *Syncer) OnAdded(fn func(models.Post)) {
+	s.onAdded = fn
+}
+
+// Interval returns the configured poll interval.
+func (s *Syncer) Interval() time.Duration {
+	if s.cfg.Sheets.PollIntervalMinutes > 0 {
+		return time.Duration(s.cfg.Sheets.PollIntervalMinutes) * time.Minute
+	}
+
+	return defaultPollInterval
+}
+
+// Run syncs immediately and then on every poll interval until ctx is cancelled.
+func (s *Syncer) Run(ctx context.Context) {
+	interval := s.Interval()
+	log.Printf("📊 Google Sheets sync started (every %v)", interval)
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := s.Sync(ctx); err != nil {
+			log.Printf("❌ Google Sheets sync failed: %v", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Sync creates posts for rows added since the last sync and returns how many were created.
+// Rows with empty content, unparseable or past times, or posts the scheduler rejects as invalid are
+// logged and skipped; a row that fails to be stored stops the sync, to be retried on the next one.
+func (s *Syncer) Sync(ctx context.Context) (int, error) {
+	rows, err := s.source.Rows(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	if len(rows) == 0 {
+		return 0, nil
+	}
+
+	contentCol, timeCol, err := s.columns(rows[0])
+	if err != nil {
+		return 0, err
+	}
+
+	cur := s.loadCursor()
+	data := rows[1:]
+
+	if cur.LastRow >= len(data) {
+		return 0, nil
+	}
+
+	now, err := s.cfg.Now()
+	if err != nil {
+		now = time.Now()
+	}
+
+	created := 0
+
+	for i := cur.LastRow; i < len(data); i++ {
+		rowNumber := i + 2 // 1-based sheet row including the header
+
+		post, err := s.parseRow(data[i], contentCol, timeCol)
+		if err != nil {
+			log.Printf("⚠️ Skipping sheet row %d: %v", rowNumber, err)
+			continue
+		}
+
+		if post.ScheduledAt.Before(now) {
+			log.Printf("⚠️ Skipping sheet row %d: scheduled time is in the past", rowNumber)
+			continue
+		}
+
+		added, err := s.adder.Add(post, s.cfg)
+		if errors.Is(err, scheduler.ErrInvalidPost) {
+			log.Printf("⚠️ Skipping sheet row %d: %v", rowNumber, err)
+			continue
+		}
+
+		if err != nil {
+			// Keep the cursor before this row so it is retried on the next sync
+			cur.LastRow = i
+			if saveErr := s.saveCursor(cur); saveErr != nil {
+				log.Printf("⚠️ Failed to save sheets cursor: %v", saveErr)
+			}
+
+			return created, fmt.Errorf("failed to add post from sheet row %d: %w", rowNumber, err)
+		}
+
+		created++
+
+		if s.onAdded != nil {
+			s.onAdded(added)
+		}
+	}
+
+	cur.LastRow = len(data)
+	if err := s.saveCursor(cur); err != nil {
+		return created, fmt.Errorf("failed to save sheets cursor: %w", err)
+	}
+
+	if created > 0 {
+		log.Printf("📊 Imported %d post(s) from Google Sheets", created)
+	}
+
+	return created, nil
+}
+
+// columns locates the content and scheduled time columns in the header row.
+func (s *Syncer) columns(header []string) (int, int, error) {
+	contentName := s.cfg.Sheets.ContentColumn
+	if contentName == "" {
+		contentName = defaultContentColumn
+	}
+
+	timeName := s.cfg.Sheets.ScheduledAtColumn
+	if timeName == "" {
+		timeName = defaultScheduledAtColumn
+	}
+
+	contentCol, timeCol := -1, -1
+
+	for i, name := range header {
+		name = strings.TrimSpace(name)
+
+		switch {
+		case strings.EqualFold(name, contentName):
+			contentCol = i
+		case strings.EqualFold(name, timeName):
+			timeCol = i
+		}
+	}
+
+	if contentCol < 0 || timeCol < 0 {
+		return 0, 0, fmt.Errorf("sheet header must contain %q and %q columns", contentName, timeName)
+	}
+
+	return contentCol, timeCol, nil
+}
+
+// parseRow maps a sheet row onto a text post.
+func (s *Syncer) parseRow(row []string, contentCol, timeCol int) (models.Post, error) {
+	content := cell(row, contentCol)
+	if content == "" {
+		return models.Post{}, fmt.Errorf("content is empty")
+	}
+
+	when := cell(row, timeCol)
+	if len(when) < dateTimeMinLength {
+		return models.Post{}, fmt.Errorf("scheduled time %q must be in 'YYYY-MM-DD HH:MM' format", when)
+	}
+
+	scheduledAt, err := s.cfg.ParseTimeInTimezone(when[:dateLength], strings.TrimSpace(when[dateLength:]))
+	if err != nil {
+		return models.Post{}, fmt.Errorf("invalid scheduled time %q: %w", when, err)
+	}
+
+	return models.Post{
+		Content:     content,
+		ScheduledAt: scheduledAt,
+		PostType:    models.PostTypeText,
+	}, nil
+}
+
+func cell(row []string, i int) string {
+	if i >= len(row) {
+		return ""
+	}
+
+	return strings.TrimSpace(row[i])
+}
+
+// loadCursor reads the sync cursor, starting over when the sheet or range changed.
+func (s *Syncer) loadCursor() cursor {
+	fresh := cursor{SheetID: s.cfg.Sheets.SheetID, Range: s.cfg.Sheets.Range}
+
+	data, err := os.ReadFile(s.cursorFile)
+	if err != nil {
+		return fresh
+	}
+
+	var cur cursor
+	if err := json.Unmarshal(data, &cur); err != nil {
+		log.Printf("⚠️ Ignoring unreadable sheets cursor %s: %v", s.cursorFile, err)
+		return fresh
+	}
+
+	if cur.SheetID != fresh.SheetID || cur.Range != fresh.Range {
+		return fresh
+	}
+
+	return cur
+}
+
+func (s *Syncer) saveCursor(cur cursor) error {
+	data, err := json.MarshalIndent(cur, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(s.cursorFile, data, cursorFilePerm)
+}
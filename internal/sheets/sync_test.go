@@ -0,0 +1,269 @@
+package sheets
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"PostedIn/internal/config"
+	"PostedIn/internal/models"
+	"PostedIn/internal/scheduler"
+	"PostedIn/pkg/linkedin"
+)
+
+// fakeSource serves fixed rows in place of the Sheets API.
+type fakeSource struct {
+	rows [][]string
+	err  error
+}
+
+func (f *fakeSource) Rows(context.Context) ([][]string, error) {
+	return f.rows, f.err
+}
+
+// fakeAdder records added posts, failing for content listed in fail.
+type fakeAdder struct {
+	added []models.Post
+	fail  map[string]bool
+}
+
+func (f *fakeAdder) Add(post models.Post, _ *config.Config) (models.Post, error) {
+	if f.fail[post.Content] {
+		return models.Post{}, errors.New("store unavailable")
+	}
+
+	post.ID = len(f.added) + 1
+	f.added = append(f.added, post)
+
+	return post, nil
+}
+
+func sheetsConfig(t *testing.T) *config.Config {
+	t.Helper()
+
+	return &config.Config{
+		Timezone: config.TimezoneConfig{Location: "UTC"},
+		Sheets: config.SheetsConfig{
+			Enabled:    true,
+			SheetID:    "sheet-1",
+			Range:      "Posts!A:B",
+			APIKey:     "key",
+			CursorFile: filepath.Join(t.TempDir(), "cursor.json"),
+		},
+	}
+}
+
+func future(days int) string {
+	return time.Now().UTC().AddDate(0, 0, days).Format("2006-01-02") + " 09:00"
+}
+
+func TestSyncCreatesPostsOnce(t *testing.T) {
+	cfg := sheetsConfig(t)
+	source := &fakeSource{rows: [][]string{
+		{"Content", "Scheduled_At"},
+		{"first post", future(1)},
+		{"", future(1)},
+		{"bad time", "tomorrow"},
+		{"too late", "2000-01-01 09:00"},
+		{"second post", future(2)},
+	}}
+	adder := &fakeAdder{}
+
+	var notified []int
+
+	syncer := NewSyncer(source, adder, cfg)
+	syncer.OnAdded(func(post models.Post) { notified = append(notified, post.ID) })
+
+	created, err := syncer.Sync(context.Background())
+	if err != nil {
+		t.Fatalf("Sync: %v", err)
+	}
+
+	if created != 2 || len(adder.added) != 2 {
+		t.Fatalf("created %d posts (%d added), want 2", created, len(adder.added))
+	}
+
+	if adder.added[0].Content != "first post" || adder.added[1].Content != "second post" {
+		t.Errorf("added %q and %q", adder.added[0].Content, adder.added[1].Content)
+	}
+
+	if adder.added[0].PostType != models.PostTypeText {
+		t.Errorf("post type = %q, want text", adder.added[0].PostType)
+	}
+
+	if len(notified) != 2 {
+		t.Errorf("OnAdded called %d times, want 2", len(notified))
+	}
+
+	if created, err := syncer.Sync(context.Background()); err != nil || created != 0 {
+		t.Fatalf("second Sync = %d, %v, want nothing new", created, err)
+	}
+
+	source.rows = append(source.rows, []string{"appended", future(3)})
+
+	if created, err := syncer.Sync(context.Background()); err != nil || created != 1 {
+		t.Fatalf("Sync after append = %d, %v, want 1", created, err)
+	}
+
+	if last := adder.added[len(adder.added)-1]; last.Content != "appended" {
+		t.Errorf("last added %q, want the appended row", last.Content)
+	}
+}
+
+func TestSyncRetriesFailedRow(t *testing.T) {
+	cfg := sheetsConfig(t)
+	source := &fakeSource{rows: [][]string{
+		{"content", "scheduled_at"},
+		{"ok", future(1)},
+		{"flaky", future(1)},
+		{"after", future(1)},
+	}}
+	adder := &fakeAdder{fail: map[string]bool{"flaky": true}}
+	syncer := NewSyncer(source, adder, cfg)
+
+	created, err := syncer.Sync(context.Background())
+	if err == nil {
+		t.Fatal("Sync succeeded despite a failing row")
+	}
+
+	if created != 1 {
+		t.Fatalf("created %d before the failure, want 1", created)
+	}
+
+	delete(adder.fail, "flaky")
+
+	created, err = syncer.Sync(context.Background())
+	if err != nil {
+		t.Fatalf("retry Sync: %v", err)
+	}
+
+	if created != 2 {
+		t.Fatalf("retry created %d, want the failed row and the one after it", created)
+	}
+
+	if adder.added[1].Content != "flaky" || adder.added[2].Content != "after" {
+		t.Errorf("retry added %q and %q", adder.added[1].Content, adder.added[2].Content)
+	}
+}
+
+func TestSyncCustomColumns(t *testing.T) {
+	cfg := sheetsConfig(t)
+	cfg.Sheets.ContentColumn = "Text"
+	cfg.Sheets.ScheduledAtColumn = "When"
+
+	source := &fakeSource{rows: [][]string{
+		{"When", "Notes", " text "},
+		{future(1), "ignored", "custom columns"},
+	}}
+	adder := &fakeAdder{}
+
+	if _, err := NewSyncer(source, adder, cfg).Sync(context.Background()); err != nil {
+		t.Fatalf("Sync: %v", err)
+	}
+
+	if len(adder.added) != 1 || adder.added[0].Content != "custom columns" {
+		t.Fatalf("added %+v, want the custom content column", adder.added)
+	}
+}
+
+func TestSyncMissingColumn(t *testing.T) {
+	source := &fakeSource{rows: [][]string{{"content"}, {"no time column"}}}
+
+	if _, err := NewSyncer(source, &fakeAdder{}, sheetsConfig(t)).Sync(context.Background()); err == nil {
+		t.Fatal("Sync accepted a header without a scheduled_at column")
+	}
+}
+
+func TestSyncSourceError(t *testing.T) {
+	source := &fakeSource{err: errors.New("quota exceeded")}
+
+	if _, err := NewSyncer(source, &fakeAdder{}, sheetsConfig(t)).Sync(context.Background()); err == nil {
+		t.Fatal("Sync ignored a source error")
+	}
+}
+
+func TestSyncResetsCursorForNewRange(t *testing.T) {
+	cfg := sheetsConfig(t)
+	source := &fakeSource{rows: [][]string{
+		{"content", "scheduled_at"},
+		{"row", future(1)},
+	}}
+	adder := &fakeAdder{}
+
+	if _, err := NewSyncer(source, adder, cfg).Sync(context.Background()); err != nil {
+		t.Fatalf("Sync: %v", err)
+	}
+
+	cfg.Sheets.Range = "Other!A:B"
+
+	created, err := NewSyncer(source, adder, cfg).Sync(context.Background())
+	if err != nil {
+		t.Fatalf("Sync after range change: %v", err)
+	}
+
+	if created != 1 {
+		t.Fatalf("created %d after the range changed, want the rows synced again", created)
+	}
+}
+
+func TestNewSyncerFromConfig(t *testing.T) {
+	cfg := sheetsConfig(t)
+	cfg.Sheets.Enabled = false
+
+	syncer, err := NewSyncerFromConfig(cfg, &fakeAdder{})
+	if err != nil || syncer != nil {
+		t.Fatalf("disabled sheets = %v, %v, want no syncer", syncer, err)
+	}
+
+	cfg = sheetsConfig(t)
+	cfg.Sheets.APIKey = ""
+
+	if _, err := NewSyncerFromConfig(cfg, &fakeAdder{}); err == nil {
+		t.Fatal("NewSyncerFromConfig accepted a missing api_key")
+	}
+
+	cfg = sheetsConfig(t)
+	cfg.Sheets.PollIntervalMinutes = 5
+
+	syncer, err = NewSyncerFromConfig(cfg, &fakeAdder{})
+	if err != nil {
+		t.Fatalf("NewSyncerFromConfig: %v", err)
+	}
+
+	if syncer.Interval() != 5*time.Minute {
+		t.Errorf("Interval = %v, want 5m", syncer.Interval())
+	}
+
+	cfg.Sheets.PollIntervalMinutes = 0
+	if syncer.Interval() != defaultPollInterval {
+		t.Errorf("default Interval = %v, want %v", syncer.Interval(), defaultPollInterval)
+	}
+}
+
+func TestSyncSkipsInvalidRow(t *testing.T) {
+	cfg := sheetsConfig(t)
+	source := &fakeSource{rows: [][]string{
+		{"content", "scheduled_at"},
+		{strings.Repeat("x", linkedin.MaxPostLength+1), future(1)},
+		{"valid", future(1)},
+	}}
+	sched := scheduler.NewScheduler(filepath.Join(t.TempDir(), "posts.json"))
+	syncer := NewSyncer(source, sched, cfg)
+
+	created, err := syncer.Sync(context.Background())
+	if err != nil {
+		t.Fatalf("Sync: %v", err)
+	}
+
+	if posts := sched.GetPosts(); created != 1 || len(posts) != 1 || posts[0].Content != "valid" {
+		t.Fatalf("created %d (%d stored), want only the valid row after the invalid one", created, len(posts))
+	}
+
+	// The invalid row is not retried on the next sync
+	if created, err := syncer.Sync(context.Background()); err != nil || created != 0 {
+		t.Fatalf("second Sync = %d, %v, want nothing new", created, err)
+	}
+}
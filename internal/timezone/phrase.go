@@ -0,0 +1,226 @@
+package timezone
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// defaultHour is used when a phrase names a day but no time, e.g. "tomorrow".
+const defaultHour = 9
+
+var weekdays = map[string]time.Weekday{
+	"sunday":    time.Sunday,
+	"monday":    time.Monday,
+	"tuesday":   time.Tuesday,
+	"wednesday": time.Wednesday,
+	"thursday":  time.Thursday,
+	"friday":    time.Friday,
+	"saturday":  time.Saturday,
+}
+
+var relativeUnits = map[string]time.Duration{
+	"m": time.Minute, "min": time.Minute, "mins": time.Minute, "minute": time.Minute, "minutes": time.Minute,
+	"h": time.Hour, "hr": time.Hour, "hrs": time.Hour, "hour": time.Hour, "hours": time.Hour,
+}
+
+// ParseSchedule parses a schedule time in now's location, given either in the strict
+// 'YYYY-MM-DD HH:MM' format or as a quick phrase:
+//
+//	now, in 30 minutes, in 2 hours, in 3 days, in a week
+//	today 17:00, tomorrow 9am, tomorrow at 9:30pm, friday noon, next monday 10am
+//	9am, 14:30 (today, or tomorrow once that time has passed)
+//
+// A day without a time means 09:00.
+func ParseSchedule(input string, now time.Time) (time.Time, error) {
+	phrase := strings.ToLower(strings.Join(strings.Fields(input), " "))
+	if phrase == "" {
+		return time.Time{}, fmt.Errorf("empty schedule time")
+	}
+
+	if t, err := time.ParseInLocation("2006-01-02 15:04", phrase, now.Location()); err == nil {
+		return t, nil
+	}
+
+	if phrase == "now" {
+		return now, nil
+	}
+
+	if rest, ok := strings.CutPrefix(phrase, "in "); ok {
+		return parseRelative(rest, now)
+	}
+
+	return parseDayAndClock(phrase, now)
+}
+
+// parseRelative parses "2 hours", "30min", "a day" or "1 week" as an offset from now.
+func parseRelative(s string, now time.Time) (time.Time, error) {
+	amount, unit := splitAmount(s)
+
+	n := 1
+	if amount != "a" && amount != "an" {
+		var err error
+
+		n, err = strconv.Atoi(amount)
+		if err != nil || n < 0 {
+			return time.Time{}, fmt.Errorf("unrecognized time phrase %q", "in "+s)
+		}
+	}
+
+	switch unit {
+	case "d", "day", "days":
+		return now.AddDate(0, 0, n), nil
+	case "w", "week", "weeks":
+		return now.AddDate(0, 0, 7*n), nil
+	}
+
+	if d, ok := relativeUnits[unit]; ok {
+		return now.Add(time.Duration(n) * d), nil
+	}
+
+	return time.Time{}, fmt.Errorf("unrecognized time unit %q, use minutes, hours, days or weeks", unit)
+}
+
+// splitAmount separates "2 hours" or "2hours" into the amount and the unit.
+func splitAmount(s string) (string, string) {
+	if amount, unit, ok := strings.Cut(s, " "); ok {
+		return amount, unit
+	}
+
+	i := strings.IndexFunc(s, func(r rune) bool { return r < '0' || r > '9' })
+	if i <= 0 {
+		return s, ""
+	}
+
+	return s[:i], s[i:]
+}
+
+// parseDayAndClock parses an optional day ("today", "tomorrow", a weekday, optionally "next")
+// followed by an optional "at" and a clock time.
+func parseDayAndClock(phrase string, now time.Time) (time.Time, error) {
+	fields := strings.Fields(phrase)
+	days := 0
+	dayGiven := false
+	rollover := 1 // Days to add when the time already passed: a bare clock time means tomorrow
+
+	switch {
+	case fields[0] == "today":
+		dayGiven, rollover = true, 0
+		fields = fields[1:]
+	case fields[0] == "tomorrow":
+		dayGiven, rollover = true, 0
+		days = 1
+		fields = fields[1:]
+	default:
+		next := false
+		if fields[0] == "next" && len(fields) > 1 {
+			next = true
+			fields = fields[1:]
+		}
+
+		if weekday, ok := weekdays[fields[0]]; ok {
+			dayGiven, rollover = true, 7
+			days = (int(weekday) - int(now.Weekday()) + 7) % 7
+
+			if next && days == 0 {
+				days = 7
+			}
+
+			fields = fields[1:]
+		} else if next {
+			return time.Time{}, fmt.Errorf("unrecognized time phrase %q", phrase)
+		}
+	}
+
+	if len(fields) > 0 && fields[0] == "at" {
+		fields = fields[1:]
+	}
+
+	hour, minute := defaultHour, 0
+
+	if len(fields) > 0 {
+		var err error
+
+		hour, minute, err = parseClock(strings.Join(fields, ""))
+		if err != nil {
+			return time.Time{}, fmt.Errorf("unrecognized time phrase %q: %w", phrase, err)
+		}
+	} else if !dayGiven {
+		return time.Time{}, fmt.Errorf("unrecognized time phrase %q", phrase)
+	}
+
+	t := time.Date(now.Year(), now.Month(), now.Day()+days, hour, minute, 0, 0, now.Location())
+
+	// A bare clock time or today's weekday whose time already passed means the next occurrence
+	if t.Before(now) {
+		t = t.AddDate(0, 0, rollover)
+	}
+
+	return t, nil
+}
+
+// parseClock parses "9am", "9:30pm", "14:30", "noon" or "midnight" into hour and minute.
+func parseClock(s string) (int, int, error) {
+	switch s {
+	case "noon":
+		return 12, 0, nil
+	case "midnight":
+		return 0, 0, nil
+	}
+
+	meridiem := ""
+	if strings.HasSuffix(s, "am") || strings.HasSuffix(s, "pm") {
+		meridiem = s[len(s)-2:]
+		s = s[:len(s)-2]
+	}
+
+	hourStr, minuteStr, hasMinutes := strings.Cut(s, ":")
+	if !hasMinutes && meridiem == "" {
+		return 0, 0, fmt.Errorf("time %q needs am/pm or HH:MM", s)
+	}
+
+	hour, err := strconv.Atoi(hourStr)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid hour %q", hourStr)
+	}
+
+	minute := 0
+	if hasMinutes {
+		minute, err = strconv.Atoi(minuteStr)
+		if err != nil || len(minuteStr) != 2 || minute > 59 {
+			return 0, 0, fmt.Errorf("invalid minutes %q", minuteStr)
+		}
+	}
+
+	switch meridiem {
+	case "":
+		if hour < 0 || hour > 23 {
+			return 0, 0, fmt.Errorf("invalid hour %d", hour)
+		}
+	default:
+		if hour < 1 || hour > 12 {
+			return 0, 0, fmt.Errorf("invalid hour %d for %s", hour, meridiem)
+		}
+
+		hour %= 12
+		if meridiem == "pm" {
+			hour += 12
+		}
+	}
+
+	return hour, minute, nil
+}
+
+// NextWeekly parses a weekly slot such as "tuesday 9am" or "friday 14:30" and returns its first
+// occurrence at or after now, in now's location.
+func NextWeekly(slot string, now time.Time) (time.Time, error) {
+	phrase := strings.ToLower(strings.Join(strings.Fields(slot), " "))
+
+	day, clock, _ := strings.Cut(phrase, " ")
+	if _, ok := weekdays[day]; !ok || clock == "" {
+		return time.Time{}, fmt.Errorf("slot %q must be a weekday and a time, e.g. \"tuesday 9am\"", slot)
+	}
+
+	return parseDayAndClock(phrase, now)
+}
@@ -0,0 +1,89 @@
+package timezone
+
+import (
+	"testing"
+	"time"
+)
+
+func TestNextWeekly(t *testing.T) {
+	// Wednesday 2026-10-14 12:00 UTC
+	now := time.Date(2026, 10, 14, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		slot string
+		want time.Time
+	}{
+		{"friday 9am", time.Date(2026, 10, 16, 9, 0, 0, 0, time.UTC)},
+		{"Wednesday 14:30", time.Date(2026, 10, 14, 14, 30, 0, 0, time.UTC)},
+		{"wednesday 12:00", now},
+		{"wednesday 9am", time.Date(2026, 10, 21, 9, 0, 0, 0, time.UTC)},
+		{"monday  noon", time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)},
+	}
+
+	for _, tt := range tests {
+		got, err := NextWeekly(tt.slot, now)
+		if err != nil {
+			t.Errorf("NextWeekly(%q): %v", tt.slot, err)
+			continue
+		}
+
+		if !got.Equal(tt.want) {
+			t.Errorf("NextWeekly(%q) = %v, want %v", tt.slot, got, tt.want)
+		}
+	}
+
+	for _, slot := range []string{"", "9am", "tomorrow 9am", "friday", "next friday 9am", "friday 25:00"} {
+		if _, err := NextWeekly(slot, now); err == nil {
+			t.Errorf("NextWeekly(%q) succeeded, want an error", slot)
+		}
+	}
+}
+
+func TestParseSchedule(t *testing.T) {
+	loc, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skipf("timezone data unavailable: %v", err)
+	}
+
+	// Wednesday 2026-10-14 12:00 in New York
+	now := time.Date(2026, 10, 14, 12, 0, 0, 0, loc)
+
+	tests := []struct {
+		input string
+		want  time.Time
+	}{
+		{"2026-10-20 08:15", time.Date(2026, 10, 20, 8, 15, 0, 0, loc)},
+		{"now", now},
+		{"in 2 hours", now.Add(2 * time.Hour)},
+		{"in 30min", now.Add(30 * time.Minute)},
+		{"in a day", time.Date(2026, 10, 15, 12, 0, 0, 0, loc)},
+		{"in 1 week", time.Date(2026, 10, 21, 12, 0, 0, 0, loc)},
+		{"tomorrow 9am", time.Date(2026, 10, 15, 9, 0, 0, 0, loc)},
+		{"Tomorrow at 9:30pm", time.Date(2026, 10, 15, 21, 30, 0, 0, loc)},
+		{"tomorrow", time.Date(2026, 10, 15, 9, 0, 0, 0, loc)},
+		{"today 17:00", time.Date(2026, 10, 14, 17, 0, 0, 0, loc)},
+		{"friday noon", time.Date(2026, 10, 16, 12, 0, 0, 0, loc)},
+		{"next wednesday 10am", time.Date(2026, 10, 21, 10, 0, 0, 0, loc)},
+		{"2pm", time.Date(2026, 10, 14, 14, 0, 0, 0, loc)},
+		{"11:00", time.Date(2026, 10, 15, 11, 0, 0, 0, loc)},
+		{"midnight", time.Date(2026, 10, 15, 0, 0, 0, 0, loc)},
+	}
+
+	for _, tt := range tests {
+		got, err := ParseSchedule(tt.input, now)
+		if err != nil {
+			t.Errorf("ParseSchedule(%q): %v", tt.input, err)
+			continue
+		}
+
+		if !got.Equal(tt.want) {
+			t.Errorf("ParseSchedule(%q) = %v, want %v", tt.input, got, tt.want)
+		}
+	}
+
+	for _, input := range []string{"", "soon", "in two hours", "in 3 fortnights", "next 9am", "tomorrow 25:00", "9", "13pm", "10:5"} {
+		if _, err := ParseSchedule(input, now); err == nil {
+			t.Errorf("ParseSchedule(%q) succeeded, want an error", input)
+		}
+	}
+}
@@ -0,0 +1,50 @@
+// Package transform provides content transformations applied to LinkedIn posts at publish time.
+package transform
+
+import "strings"
+
+const (
+	footerSeparator = "\n\n"
+	ellipsis        = "..."
+)
+
+// ApplyFooter appends the footer to the content separated by a blank line.
+// When the combined text exceeds maxLen runes the content is truncated so the
+// footer is always kept intact. If the footer alone cannot fit, it is dropped and
+// the content is returned unchanged. The returned bool reports whether the limit
+// forced either adjustment.
+func ApplyFooter(content, footer string, maxLen int) (string, bool) {
+	footer = strings.TrimSpace(footer)
+	if footer == "" {
+		return content, false
+	}
+
+	body := strings.TrimRight(content, " \t\n")
+	combined := body + footerSeparator + footer
+
+	if maxLen <= 0 || runeLen(combined) <= maxLen {
+		return combined, false
+	}
+
+	available := maxLen - runeLen(footerSeparator) - runeLen(footer)
+	if available <= runeLen(ellipsis) {
+		return content, true
+	}
+
+	truncated := truncateRunes(body, available-runeLen(ellipsis))
+
+	return strings.TrimRight(truncated, " \t\n") + ellipsis + footerSeparator + footer, true
+}
+
+func runeLen(s string) int {
+	return len([]rune(s))
+}
+
+func truncateRunes(s string, n int) string {
+	runes := []rune(s)
+	if len(runes) <= n {
+		return s
+	}
+
+	return string(runes[:n])
+}
@@ -0,0 +1,39 @@
+package transform
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestApplyFooter(t *testing.T) {
+	tests := []struct {
+		name     string
+		content  string
+		footer   string
+		maxLen   int
+		want     string
+		adjusted bool
+	}{
+		{"no footer", "Hello", "  ", 100, "Hello", false},
+		{"appended", "Hello\n\n", "Bye", 100, "Hello\n\nBye", false},
+		{"unlimited", "Hello", "Bye", 0, "Hello\n\nBye", false},
+		{"truncated", "Hello world", "Bye", 14, "Hello...\n\nBye", true},
+		{"footer too long", "Hello", "Goodbye", 10, "Hello", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, adjusted := ApplyFooter(tt.content, tt.footer, tt.maxLen)
+			if got != tt.want || adjusted != tt.adjusted {
+				t.Errorf("ApplyFooter(%q, %q, %d) = %q, %v, want %q, %v", tt.content, tt.footer, tt.maxLen, got, adjusted, tt.want, tt.adjusted)
+			}
+		})
+	}
+}
+
+func TestApplyFooterCountsRunes(t *testing.T) {
+	got, adjusted := ApplyFooter(strings.Repeat("é", 10), "ü", 13)
+	if adjusted || got != strings.Repeat("é", 10)+"\n\nü" {
+		t.Errorf("ApplyFooter = %q, %v, want the footer appended without truncation", got, adjusted)
+	}
+}
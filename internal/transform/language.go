@@ -0,0 +1,131 @@
+package transform
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+const (
+	// AllLanguages selects every language variant, publishing each as a separate post.
+	AllLanguages = "all"
+	// DefaultLanguage is the primary language assumed when none is configured.
+	DefaultLanguage = "en"
+)
+
+// languageCodePattern matches BCP 47 style codes such as "en", "pt-BR" or "zh-Hant".
+var languageCodePattern = regexp.MustCompile(`^[a-zA-Z]{2,3}(-[a-zA-Z0-9]{2,8})*$`)
+
+// Variant is a single language version of a post's content.
+type Variant struct {
+	Language string
+	Content  string
+}
+
+// NormalizeLanguage validates a language code and returns it in canonical BCP 47 case, so
+// "PT-br" and "pt-BR" name the same variant: a lowercase language, a title-case script
+// ("zh-Hant"), an uppercase region ("pt-BR", "es-419") and variants in lowercase.
+func NormalizeLanguage(code string) (string, error) {
+	code = strings.TrimSpace(code)
+	if !languageCodePattern.MatchString(code) {
+		return "", fmt.Errorf("invalid language code %q (expected e.g. en, de, pt-BR)", code)
+	}
+
+	parts := strings.Split(strings.ToLower(code), "-")
+
+	for i := 1; i < len(parts); i++ {
+		part := parts[i]
+
+		switch {
+		case len(part) == 4 && i == 1 && isLetters(part):
+			parts[i] = strings.ToUpper(part[:1]) + part[1:]
+		case len(part) == 2 && isLetters(part), len(part) == 3 && isDigits(part):
+			parts[i] = strings.ToUpper(part)
+		}
+	}
+
+	return strings.Join(parts, "-"), nil
+}
+
+// isLetters reports whether s consists of ASCII letters only.
+func isLetters(s string) bool {
+	return strings.Trim(s, "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ") == ""
+}
+
+// isDigits reports whether s consists of ASCII digits only.
+func isDigits(s string) bool {
+	return strings.Trim(s, "0123456789") == ""
+}
+
+// NormalizeVariants validates the language codes and contents of a variants map and
+// returns it keyed by normalized codes. A variant may not repeat the primary language.
+func NormalizeVariants(primary string, variants map[string]string) (map[string]string, error) {
+	if len(variants) == 0 {
+		return nil, nil
+	}
+
+	normalized := make(map[string]string, len(variants))
+
+	for code, content := range variants {
+		lang, err := NormalizeLanguage(code)
+		if err != nil {
+			return nil, err
+		}
+
+		if strings.EqualFold(lang, primary) {
+			return nil, fmt.Errorf("variant %q duplicates the primary language", lang)
+		}
+
+		if _, exists := normalized[lang]; exists {
+			return nil, fmt.Errorf("variant %q is specified more than once", lang)
+		}
+
+		if strings.TrimSpace(content) == "" {
+			return nil, fmt.Errorf("content for language %q cannot be empty", lang)
+		}
+
+		normalized[lang] = content
+	}
+
+	return normalized, nil
+}
+
+// SelectVariants returns the contents to publish for the target language.
+// An empty target selects the primary content, AllLanguages fans out to the primary
+// content followed by every variant in language order.
+func SelectVariants(content, primary string, variants map[string]string, target string) ([]Variant, error) {
+	if primary == "" {
+		primary = DefaultLanguage
+	}
+
+	primaryVariant := Variant{Language: primary, Content: content}
+
+	switch {
+	case target == "" || strings.EqualFold(target, primary):
+		return []Variant{primaryVariant}, nil
+	case strings.EqualFold(target, AllLanguages):
+		selected := []Variant{primaryVariant}
+
+		languages := make([]string, 0, len(variants))
+		for lang := range variants {
+			languages = append(languages, lang)
+		}
+
+		sort.Strings(languages)
+
+		for _, lang := range languages {
+			selected = append(selected, Variant{Language: lang, Content: variants[lang]})
+		}
+
+		return selected, nil
+	}
+
+	for lang, text := range variants {
+		if strings.EqualFold(lang, target) {
+			return []Variant{{Language: lang, Content: text}}, nil
+		}
+	}
+
+	return nil, fmt.Errorf("no content variant for language %q", target)
+}
@@ -0,0 +1,55 @@
+package transform
+
+import "testing"
+
+func TestNormalizeLanguage(t *testing.T) {
+	tests := []struct {
+		code    string
+		want    string
+		wantErr bool
+	}{
+		{code: "en", want: "en"},
+		{code: "EN", want: "en"},
+		{code: "pt-br", want: "pt-BR"},
+		{code: "PT-br", want: "pt-BR"},
+		{code: " de-DE ", want: "de-DE"},
+		{code: "zh-hant", want: "zh-Hant"},
+		{code: "ZH-HANT-tw", want: "zh-Hant-TW"},
+		{code: "es-419", want: "es-419"},
+		{code: "sr-latn-rs", want: "sr-Latn-RS"},
+		{code: "de-CH-1996", want: "de-CH-1996"},
+		{code: "sl-ROZAJ", want: "sl-rozaj"},
+		{code: "english", wantErr: true},
+		{code: "e", wantErr: true},
+		{code: "pt_BR", wantErr: true},
+		{code: "", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.code, func(t *testing.T) {
+			got, err := NormalizeLanguage(tt.code)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("NormalizeLanguage(%q) error = %v, wantErr %v", tt.code, err, tt.wantErr)
+			}
+
+			if got != tt.want {
+				t.Errorf("NormalizeLanguage(%q) = %q, want %q", tt.code, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNormalizeVariantsMatchesDifferentlyCasedCodes(t *testing.T) {
+	variants, err := NormalizeVariants("en", map[string]string{"PT-br": "Olá"})
+	if err != nil {
+		t.Fatalf("NormalizeVariants: %v", err)
+	}
+
+	if variants["pt-BR"] != "Olá" {
+		t.Errorf("variants = %v, want the pt-BR key", variants)
+	}
+
+	if _, err := NormalizeVariants("pt-BR", map[string]string{"pt-br": "Olá"}); err == nil {
+		t.Error("a variant repeating the primary language in another case was accepted")
+	}
+}
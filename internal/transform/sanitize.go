@@ -0,0 +1,74 @@
+package transform
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// SanitizeContent removes sequences LinkedIn is known to reject and returns the cleaned
+// text with a warning for every kind of problem found. Invalid UTF-8 (including encoded
+// surrogate halves) and control characters other than newline and tab are stripped;
+// invisible formatting characters are kept but reported since they often render oddly.
+func SanitizeContent(s string) (string, []string) {
+	var (
+		b         strings.Builder
+		invalid   int
+		controls  int
+		invisible int
+	)
+
+	b.Grow(len(s))
+
+	for i := 0; i < len(s); {
+		r, size := utf8.DecodeRuneInString(s[i:])
+		i += size
+
+		switch {
+		case r == utf8.RuneError && size <= 1:
+			invalid++
+		case r == '\r':
+			// Normalize Windows line endings by dropping the carriage return
+		case r != '\n' && r != '\t' && unicode.IsControl(r):
+			controls++
+		default:
+			if isInvisibleFormat(r) {
+				invisible++
+			}
+
+			b.WriteRune(r)
+		}
+	}
+
+	var warnings []string
+
+	if invalid > 0 {
+		warnings = append(warnings, fmt.Sprintf("removed %d invalid UTF-8 byte(s)", invalid))
+	}
+
+	if controls > 0 {
+		warnings = append(warnings, fmt.Sprintf("removed %d control character(s)", controls))
+	}
+
+	if invisible > 0 {
+		warnings = append(warnings, fmt.Sprintf("contains %d invisible formatting character(s) (zero-width or bidirectional)", invisible))
+	}
+
+	return b.String(), warnings
+}
+
+// isInvisibleFormat reports zero-width and bidirectional override characters.
+// The zero-width joiner is excluded because emoji sequences rely on it.
+func isInvisibleFormat(r rune) bool {
+	switch {
+	case r == '\u200d':
+		return false
+	case r >= '\u200b' && r <= '\u200f', r >= '\u202a' && r <= '\u202e', r >= '\u2066' && r <= '\u2069':
+		return true
+	case r == '\ufeff':
+		return true
+	}
+
+	return false
+}
@@ -0,0 +1,34 @@
+package transform
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestSanitizeContent(t *testing.T) {
+	tests := []struct {
+		name     string
+		in       string
+		want     string
+		warnings []string
+	}{
+		{"clean", "Hello\n\tworld 👋", "Hello\n\tworld 👋", nil},
+		{"invalid UTF-8", "bad\xff\xfebytes", "badbytes", []string{"removed 2 invalid UTF-8 byte(s)"}},
+		{"surrogate half", "a\xed\xa0\x80b", "ab", []string{"removed 3 invalid UTF-8 byte(s)"}},
+		{"control characters", "bell\a and nul\x00", "bell and nul", []string{"removed 2 control character(s)"}},
+		{"windows line endings", "one\r\ntwo", "one\ntwo", nil},
+		{"invisible", "zero​width", "zero​width", []string{"contains 1 invisible formatting character(s) (zero-width or bidirectional)"}},
+		{"emoji joiner kept", "👩‍💻", "👩‍💻", nil},
+	}
+
+	for _, tt := range tests {
+		got, warnings := SanitizeContent(tt.in)
+		if got != tt.want {
+			t.Errorf("%s: SanitizeContent = %q, want %q", tt.name, got, tt.want)
+		}
+
+		if !slices.Equal(warnings, tt.warnings) {
+			t.Errorf("%s: warnings = %q, want %q", tt.name, warnings, tt.warnings)
+		}
+	}
+}
@@ -0,0 +1,40 @@
+package transform
+
+import (
+	"strings"
+	"unicode"
+)
+
+// Similarity returns how alike two texts are, from 0 (no words in common) to 1 (the same words),
+// as the overlap (Jaccard index) of their lower-cased word sets. Punctuation, emoji, word order
+// and repeated words are ignored, so light edits of a post still score close to 1.
+func Similarity(a, b string) float64 {
+	wordsA, wordsB := wordSet(a), wordSet(b)
+	if len(wordsA) == 0 || len(wordsB) == 0 {
+		return 0
+	}
+
+	common := 0
+
+	for word := range wordsA {
+		if wordsB[word] {
+			common++
+		}
+	}
+
+	return float64(common) / float64(len(wordsA)+len(wordsB)-common)
+}
+
+// wordSet splits text into its distinct lower-cased words of letters and digits.
+func wordSet(text string) map[string]bool {
+	words := strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+
+	set := make(map[string]bool, len(words))
+	for _, word := range words {
+		set[word] = true
+	}
+
+	return set
+}
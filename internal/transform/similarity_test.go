@@ -0,0 +1,28 @@
+package transform
+
+import (
+	"math"
+	"testing"
+)
+
+func TestSimilarity(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b string
+		want float64
+	}{
+		{"identical", "Launching our new API today", "Launching our new API today", 1},
+		{"case, punctuation and order", "Launching our new API today!", "today: our NEW api... launching 🚀", 1},
+		{"repeated words", "go go go", "go", 1},
+		{"half overlap", "alpha beta gamma", "beta gamma delta", 0.5},
+		{"disjoint", "quarterly results are in", "hiring a designer", 0},
+		{"empty", "", "anything", 0},
+		{"only symbols", "🚀🚀", "🚀", 0},
+	}
+
+	for _, tt := range tests {
+		if got := Similarity(tt.a, tt.b); math.Abs(got-tt.want) > 1e-9 {
+			t.Errorf("%s: Similarity = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
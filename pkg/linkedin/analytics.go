@@ -0,0 +1,88 @@
+package linkedin
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+)
+
+// SocialMetadataURL is the LinkedIn endpoint reporting the reactions and comments of a post.
+const SocialMetadataURL = APIBaseURL + "/socialMetadata"
+
+// PostStats holds the engagement of a published post.
+type PostStats struct {
+	Reactions int // Reactions of every type
+	Comments  int
+}
+
+// socialMetadataResponse is the part of a socialMetadata response that carries the counts.
+type socialMetadataResponse struct {
+	ReactionSummaries map[string]struct {
+		Count int `json:"count"`
+	} `json:"reactionSummaries"`
+	CommentSummary struct {
+		Count int `json:"count"`
+	} `json:"commentSummary"`
+}
+
+// GetPostStats fetches the current reaction and comment counts of the post published as postURN.
+func (c *Client) GetPostStats(ctx context.Context, postURN string) (PostStats, error) {
+	if c.token == nil {
+		return PostStats{}, fmt.Errorf("no access token available")
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "GET", SocialMetadataURL+"/"+url.PathEscape(postURN), http.NoBody)
+	if err != nil {
+		return PostStats{}, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.Header.Set("Authorization", "Bearer "+c.token.AccessToken)
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("User-Agent", "PostedIn/1.0")
+	req.Header.Set("LinkedIn-Version", c.apiVersion())
+
+	client := &http.Client{
+		Timeout: httpTimeout,
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return PostStats{}, fmt.Errorf("failed to get post stats: %w", err)
+	}
+
+	defer func() {
+		if closeErr := resp.Body.Close(); closeErr != nil {
+			fmt.Printf("Warning: failed to close response body: %v\n", closeErr)
+		}
+	}()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return PostStats{}, fmt.Errorf("failed to read response: %w", err)
+	}
+
+	c.lastRequestID = RequestID(resp.Header)
+
+	if resp.StatusCode == http.StatusServiceUnavailable {
+		return PostStats{}, fmt.Errorf("%w: %w", ErrServiceUnavailable, c.apiError("API", resp.StatusCode, body))
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return PostStats{}, c.apiError("API", resp.StatusCode, body)
+	}
+
+	var metadata socialMetadataResponse
+	if err := json.Unmarshal(body, &metadata); err != nil {
+		return PostStats{}, fmt.Errorf("failed to parse post stats: %w", err)
+	}
+
+	stats := PostStats{Comments: metadata.CommentSummary.Count}
+	for _, summary := range metadata.ReactionSummaries {
+		stats.Reactions += summary.Count
+	}
+
+	return stats, nil
+}
@@ -0,0 +1,56 @@
+package linkedin
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Audience is a set of targeting facets for an organization post's distribution. Each facet
+// lists the values to target; staff count ranges are enum names such as SIZE_11_TO_50, the
+// other facets are entity URNs such as urn:li:geo:103644278.
+type Audience struct {
+	GeoLocations     []string `json:"geoLocations,omitempty"`
+	Industries       []string `json:"industries,omitempty"`
+	JobFunctions     []string `json:"jobFunctions,omitempty"`
+	Seniorities      []string `json:"seniorities,omitempty"`
+	StaffCountRanges []string `json:"staffCountRanges,omitempty"`
+}
+
+// Validate checks that the audience targets something and that URN facets hold URNs.
+func (a Audience) Validate() error {
+	urnFacets := map[string][]string{
+		"geoLocations": a.GeoLocations,
+		"industries":   a.Industries,
+		"jobFunctions": a.JobFunctions,
+		"seniorities":  a.Seniorities,
+	}
+
+	empty := len(a.StaffCountRanges) == 0
+
+	for facet, values := range urnFacets {
+		for _, value := range values {
+			if !strings.HasPrefix(value, "urn:li:") {
+				return fmt.Errorf("%s value %q is not a LinkedIn URN", facet, value)
+			}
+		}
+
+		if len(values) > 0 {
+			empty = false
+		}
+	}
+
+	if empty {
+		return fmt.Errorf("audience has no targeting facets")
+	}
+
+	return nil
+}
+
+// targetEntities returns the distribution targetEntities for the audience; nil targets everyone.
+func (a *Audience) targetEntities() []interface{} {
+	if a == nil {
+		return []interface{}{}
+	}
+
+	return []interface{}{a}
+}
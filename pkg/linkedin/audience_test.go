@@ -0,0 +1,83 @@
+package linkedin
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestAudienceValidate(t *testing.T) {
+	valid := []Audience{
+		{GeoLocations: []string{"urn:li:geo:103644278"}},
+		{StaffCountRanges: []string{"SIZE_11_TO_50"}},
+		{Industries: []string{"urn:li:industry:4"}, Seniorities: []string{"urn:li:seniority:5"}},
+	}
+
+	for _, audience := range valid {
+		if err := audience.Validate(); err != nil {
+			t.Errorf("Validate(%+v): %v", audience, err)
+		}
+	}
+
+	invalid := []Audience{
+		{},
+		{GeoLocations: []string{}},
+		{JobFunctions: []string{"engineering"}},
+	}
+
+	for _, audience := range invalid {
+		if err := audience.Validate(); err == nil {
+			t.Errorf("Validate(%+v) succeeded, want an error", audience)
+		}
+	}
+}
+
+func TestCreatePostAudiencePayload(t *testing.T) {
+	var distribution map[string]interface{}
+
+	useFakeAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+
+		var post struct {
+			Distribution map[string]interface{} `json:"distribution"`
+		}
+
+		if err := json.Unmarshal(body, &post); err != nil {
+			t.Errorf("post payload is not JSON: %v", err)
+		}
+
+		distribution = post.Distribution
+
+		w.WriteHeader(http.StatusCreated)
+	})
+
+	client := newTestClient()
+	audience := &Audience{
+		GeoLocations:     []string{"urn:li:geo:103644278"},
+		StaffCountRanges: []string{"SIZE_11_TO_50"},
+	}
+
+	if _, err := client.CreatePostWithAuthorProbe(context.Background(), "targeted", nil, "", audience, []string{"urn:li:organization:1"}); err != nil {
+		t.Fatalf("CreatePostWithAuthorProbe: %v", err)
+	}
+
+	want := []interface{}{map[string]interface{}{
+		"geoLocations":     []interface{}{"urn:li:geo:103644278"},
+		"staffCountRanges": []interface{}{"SIZE_11_TO_50"},
+	}}
+
+	if got := distribution["targetEntities"]; !reflect.DeepEqual(got, want) {
+		t.Errorf("targetEntities = %v, want %v", got, want)
+	}
+
+	if _, err := client.CreatePostWithAuthorProbe(context.Background(), "everyone", nil, "", nil, []string{"urn:li:organization:1"}); err != nil {
+		t.Fatalf("CreatePostWithAuthorProbe without audience: %v", err)
+	}
+
+	if got := distribution["targetEntities"]; !reflect.DeepEqual(got, []interface{}{}) {
+		t.Errorf("targetEntities without audience = %v, want empty", got)
+	}
+}
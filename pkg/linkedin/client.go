@@ -5,10 +5,13 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
+	"strings"
 	"time"
+	"unicode/utf8"
 
 	"golang.org/x/oauth2"
 )
@@ -17,6 +20,21 @@ const (
 	httpTimeout = 30 * time.Second
 )
 
+// MaxPostLength is the maximum number of characters LinkedIn accepts in a post's commentary.
+const MaxPostLength = 3000
+
+// ErrContentTooLong is returned for post content over MaxPostLength characters.
+var ErrContentTooLong = errors.New("post content is too long")
+
+// ValidateContent checks that content fits in a post, counting characters rather than bytes.
+func ValidateContent(content string) error {
+	if n := utf8.RuneCountInString(content); n > MaxPostLength {
+		return fmt.Errorf("%w: %d characters, LinkedIn allows at most %d", ErrContentTooLong, n, MaxPostLength)
+	}
+
+	return nil
+}
+
 const (
 	// AuthURL is the LinkedIn OAuth authorization endpoint.
 	AuthURL = "https://www.linkedin.com/oauth/v2/authorization"
@@ -30,6 +48,17 @@ const (
 	PostsURL = APIBaseURL + "/posts"
 )
 
+// OAuth scopes.
+const (
+	// ScopeMemberSocial allows posting on behalf of the member.
+	ScopeMemberSocial = "w_member_social"
+	// ScopeOrganizationSocial allows posting on behalf of organizations the member administers.
+	ScopeOrganizationSocial = "w_organization_social"
+)
+
+// DefaultScopes lists the OAuth scopes the scheduler requests.
+var DefaultScopes = []string{"openid", "profile", ScopeMemberSocial, "email"}
+
 // Config holds LinkedIn OAuth configuration parameters.
 type Config struct {
 	ClientID     string
@@ -40,9 +69,13 @@ type Config struct {
 
 // Client provides LinkedIn API functionality with OAuth authentication.
 type Client struct {
-	config *oauth2.Config
-	token  *oauth2.Token
-	client *http.Client
+	config  *oauth2.Config
+	token   *oauth2.Token
+	client  *http.Client
+	version string // LinkedIn-Version header; DefaultAPIVersion when empty
+	// lastRequestID is the request ID LinkedIn returned with the most recent response
+	lastRequestID string
+	lastPostURN   string // URN of the most recently created post
 }
 
 // Post represents a LinkedIn post structure for API requests.
@@ -52,6 +85,13 @@ type Post struct {
 	Visibility     string                 `json:"visibility"`
 	Distribution   map[string]interface{} `json:"distribution"`
 	LifecycleState string                 `json:"lifecycleState"`
+	Content        *PostContent           `json:"content,omitempty"`
+}
+
+// PostContent holds optional rich content attached to a post.
+type PostContent struct {
+	Poll  *PollContent  `json:"poll,omitempty"`
+	Media *MediaContent `json:"media,omitempty"`
 }
 
 // NewConfig creates a new LinkedIn OAuth configuration.
@@ -60,7 +100,7 @@ func NewConfig(clientID, clientSecret, redirectURL string) *Config {
 		ClientID:     clientID,
 		ClientSecret: clientSecret,
 		RedirectURL:  redirectURL,
-		Scopes:       []string{"openid", "profile", "w_member_social", "email"},
+		Scopes:       DefaultScopes,
 	}
 }
 
@@ -121,7 +161,7 @@ func (c *Client) GetProfile(ctx context.Context) (map[string]interface{}, error)
 	req.Header.Set("Authorization", "Bearer "+c.token.AccessToken)
 	req.Header.Set("Accept", "application/json")
 	req.Header.Set("User-Agent", "PostedIn/1.0")
-	req.Header.Set("LinkedIn-Version", "202506")
+	req.Header.Set("LinkedIn-Version", c.apiVersion())
 
 	client := &http.Client{
 		Timeout: httpTimeout,
@@ -143,8 +183,10 @@ func (c *Client) GetProfile(ctx context.Context) (map[string]interface{}, error)
 		return nil, fmt.Errorf("failed to read response: %w", err)
 	}
 
+	c.lastRequestID = RequestID(resp.Header)
+
 	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("API error (%d): %s", resp.StatusCode, string(body))
+		return nil, c.apiError("API", resp.StatusCode, body)
 	}
 
 	var profile map[string]interface{}
@@ -155,28 +197,92 @@ func (c *Client) GetProfile(ctx context.Context) (map[string]interface{}, error)
 	return profile, nil
 }
 
-// CreatePost creates a new LinkedIn post with the given text content.
-func (c *Client) CreatePost(ctx context.Context, text, userID string) error {
-	if c.token == nil {
-		return fmt.Errorf("no access token available")
+// CreatePost creates a new LinkedIn post with the given text content, authored by the member or
+// organization with the given ID according to authorType (AuthorPerson or AuthorOrganization).
+func (c *Client) CreatePost(ctx context.Context, text, authorType, id string) error {
+	author, err := AuthorURNFor(authorType, id)
+	if err != nil {
+		return err
+	}
+
+	err = c.createPost(ctx, newPost(author, text, nil, VisibilityPublic, nil))
+
+	return err
+}
+
+// CreatePoll creates a new LinkedIn poll post with the given commentary, question and options,
+// authored like CreatePost.
+func (c *Client) CreatePoll(ctx context.Context, text, question string, options []string, duration, authorType, id string) error {
+	content, err := NewPollContent(question, options, duration)
+	if err != nil {
+		return err
+	}
+
+	author, err := AuthorURNFor(authorType, id)
+	if err != nil {
+		return err
+	}
+
+	err = c.createPost(ctx, newPost(author, text, content, VisibilityPublic, nil))
+
+	return err
+}
+
+// CreatePostWithAuthorProbe publishes the post trying each candidate author URN in order
+// and returns the URN LinkedIn accepted. A candidate is skipped only when LinkedIn rejects
+// the author (403/422); any other failure is returned immediately. An empty visibility means public,
+// and a nil audience distributes the post to everyone.
+func (c *Client) CreatePostWithAuthorProbe(ctx context.Context, text string, content *PostContent, visibility string, audience *Audience, candidates []string) (string, error) {
+	if len(candidates) == 0 {
+		return "", fmt.Errorf("no author URN candidates available - please re-authenticate")
+	}
+
+	var lastErr error
+
+	for _, author := range candidates {
+		err := c.createPost(ctx, newPost(author, text, content, visibility, audience))
+		if err == nil {
+			return author, nil
+		}
+
+		if status := StatusCode(err); status != http.StatusForbidden && status != http.StatusUnprocessableEntity {
+			return "", err
+		}
+
+		lastErr = err
+	}
+
+	return "", fmt.Errorf("no working author URN format found (tried %s): %w", strings.Join(candidates, ", "), lastErr)
+}
+
+// newPost builds a published post payload using the Posts API format.
+func newPost(author, text string, content *PostContent, visibility string, audience *Audience) Post {
+	if visibility == "" {
+		visibility = VisibilityPublic
 	}
 
-	// Create the post payload using the new Posts API format
-	post := Post{
-		Author:     "urn:li:person:" + userID,
+	return Post{
+		Author:     author,
 		Commentary: text,
-		Visibility: "PUBLIC",
+		Visibility: visibility,
 		Distribution: map[string]interface{}{
 			"feedDistribution":               "MAIN_FEED",
-			"targetEntities":                 []interface{}{},
+			"targetEntities":                 audience.targetEntities(),
 			"thirdPartyDistributionChannels": []interface{}{},
 		},
 		LifecycleState: "PUBLISHED",
+		Content:        content,
+	}
+}
+
+// createPost sends the post payload; failed responses are returned as an *APIError.
+func (c *Client) createPost(ctx context.Context, post Post) error {
+	if c.token == nil {
+		return fmt.Errorf("no access token available")
 	}
 
 	// Debug: print the post payload
 	fmt.Printf("DEBUG: Creating post with author: %s\n", post.Author)
-	fmt.Printf("DEBUG: User ID: %s\n", userID)
 
 	jsonData, err := json.Marshal(post)
 	if err != nil {
@@ -192,7 +298,7 @@ func (c *Client) CreatePost(ctx context.Context, text, userID string) error {
 	req.Header.Set("Authorization", "Bearer "+c.token.AccessToken)
 	req.Header.Set("Accept", "application/json")
 	req.Header.Set("User-Agent", "PostedIn/1.0")
-	req.Header.Set("LinkedIn-Version", "202506")
+	req.Header.Set("LinkedIn-Version", c.apiVersion())
 
 	client := &http.Client{
 		Timeout: httpTimeout,
@@ -214,13 +320,33 @@ func (c *Client) CreatePost(ctx context.Context, text, userID string) error {
 		return fmt.Errorf("failed to read response: %w", err)
 	}
 
+	c.lastRequestID = RequestID(resp.Header)
+
+	if resp.StatusCode == http.StatusServiceUnavailable {
+		return fmt.Errorf("%w: %w", ErrServiceUnavailable, c.apiError("API", resp.StatusCode, body))
+	}
+
+	if resp.StatusCode == http.StatusTooManyRequests {
+		return &RateLimitError{
+			RetryAfter: parseRetryAfter(resp.Header.Get("Retry-After"), time.Now()),
+			err:        c.apiError("API", resp.StatusCode, body),
+		}
+	}
+
 	if resp.StatusCode != http.StatusCreated {
-		return fmt.Errorf("API error (%d): %s", resp.StatusCode, string(body))
+		return c.apiError("API", resp.StatusCode, body)
 	}
 
+	c.lastPostURN = resp.Header.Get("x-restli-id")
+
 	return nil
 }
 
+// LastPostURN returns the URN LinkedIn assigned to the most recently created post, or "" when it sent none.
+func (c *Client) LastPostURN() string {
+	return c.lastPostURN
+}
+
 // IsAuthenticated checks if the client has a valid access token.
 func (c *Client) IsAuthenticated() bool {
 	return c.token != nil && c.token.Valid()
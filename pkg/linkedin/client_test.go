@@ -0,0 +1,33 @@
+package linkedin
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestValidateContent(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		wantErr bool
+	}{
+		{"empty", "", false},
+		{"at the limit", strings.Repeat("a", MaxPostLength), false},
+		{"over the limit", strings.Repeat("a", MaxPostLength+1), true},
+		{"multibyte at the limit", strings.Repeat("é", MaxPostLength), false},
+		{"multibyte over the limit", strings.Repeat("🚀", MaxPostLength+1), true},
+	}
+
+	for _, tt := range tests {
+		err := ValidateContent(tt.content)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("%s: ValidateContent = %v, wantErr %v", tt.name, err, tt.wantErr)
+			continue
+		}
+
+		if tt.wantErr && !errors.Is(err, ErrContentTooLong) {
+			t.Errorf("%s: error %v is not ErrContentTooLong", tt.name, err)
+		}
+	}
+}
@@ -0,0 +1,129 @@
+package linkedin
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// Outcomes of CheckCredentials.
+const (
+	CredentialsRejected          = "rejected"            // LinkedIn refused the client ID or secret
+	CredentialsNeedAuthorization = "needs_authorization" // The app credentials were accepted; a user still has to sign in
+	CredentialsUnknown           = "unknown"             // LinkedIn answered in a way that does not tell either way
+)
+
+// probeCode is sent as the authorization code when probing the token endpoint. It can never be
+// valid, so a correct client ID and secret produce invalid_grant/invalid_request, not a token.
+const probeCode = "postedin-credential-check"
+
+// CredentialCheck describes what LinkedIn said about the configured app credentials.
+type CredentialCheck struct {
+	Status string
+	Detail string
+}
+
+// CheckCredentials tells clearly wrong app credentials apart from ones that only need user
+// authorization, without a browser. It makes one HEAD request to the authorization URL and one
+// token request with a dummy code; nothing is retried so repeated runs cannot trip rate limits.
+func (c *Client) CheckCredentials(ctx context.Context) (CredentialCheck, error) {
+	client := &http.Client{
+		Timeout: httpTimeout,
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+
+	check, err := c.checkAuthURL(ctx, client)
+	if err != nil || check.Status == CredentialsRejected {
+		return check, err
+	}
+
+	return c.checkTokenEndpoint(ctx, client)
+}
+
+// checkAuthURL verifies LinkedIn accepts the client ID and redirect URL in the authorization URL.
+func (c *Client) checkAuthURL(ctx context.Context, client *http.Client) (CredentialCheck, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodHead, c.GetAuthURL("credential-check"), http.NoBody)
+	if err != nil {
+		return CredentialCheck{}, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return CredentialCheck{}, fmt.Errorf("authorization URL request failed: %w", err)
+	}
+
+	_ = resp.Body.Close()
+
+	if resp.StatusCode >= http.StatusBadRequest && resp.StatusCode < http.StatusInternalServerError {
+		return CredentialCheck{
+			Status: CredentialsRejected,
+			Detail: fmt.Sprintf("authorization URL was refused (%d), the client ID is probably wrong", resp.StatusCode),
+		}, nil
+	}
+
+	// Errors such as an unknown redirect_uri are reported by redirecting with an error parameter
+	if location, err := resp.Location(); err == nil {
+		if oauthErr := location.Query().Get("error"); oauthErr != "" {
+			return CredentialCheck{
+				Status: CredentialsRejected,
+				Detail: fmt.Sprintf("authorization URL was refused: %s %s", oauthErr, location.Query().Get("error_description")),
+			}, nil
+		}
+	}
+
+	return CredentialCheck{Status: CredentialsNeedAuthorization}, nil
+}
+
+// checkTokenEndpoint exchanges a dummy code to find out whether the client secret is accepted.
+func (c *Client) checkTokenEndpoint(ctx context.Context, client *http.Client) (CredentialCheck, error) {
+	form := url.Values{
+		"grant_type":    {"authorization_code"},
+		"code":          {probeCode},
+		"redirect_uri":  {c.config.RedirectURL},
+		"client_id":     {c.config.ClientID},
+		"client_secret": {c.config.ClientSecret},
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.config.Endpoint.TokenURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return CredentialCheck{}, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return CredentialCheck{}, fmt.Errorf("token endpoint request failed: %w", err)
+	}
+
+	defer func() { _ = resp.Body.Close() }()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return CredentialCheck{}, fmt.Errorf("failed to read response: %w", err)
+	}
+
+	var oauthErr struct {
+		Error       string `json:"error"`
+		Description string `json:"error_description"`
+	}
+
+	_ = json.Unmarshal(body, &oauthErr)
+
+	detail := strings.TrimSpace(oauthErr.Error + " " + oauthErr.Description)
+
+	switch {
+	case resp.StatusCode == http.StatusUnauthorized || oauthErr.Error == "invalid_client" || oauthErr.Error == "unauthorized_client":
+		return CredentialCheck{Status: CredentialsRejected, Detail: "client ID or secret was rejected: " + detail}, nil
+	case oauthErr.Error == "invalid_grant" || oauthErr.Error == "invalid_request":
+		return CredentialCheck{Status: CredentialsNeedAuthorization, Detail: "app credentials accepted, sign in to authorize"}, nil
+	default:
+		return CredentialCheck{Status: CredentialsUnknown, Detail: fmt.Sprintf("unexpected token endpoint response (%d): %s", resp.StatusCode, string(body))}, nil
+	}
+}
@@ -0,0 +1,83 @@
+package linkedin
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"testing"
+)
+
+func TestCheckCredentials(t *testing.T) {
+	tests := []struct {
+		name         string
+		authStatus   int
+		authLocation string
+		tokenStatus  int
+		tokenBody    string
+		want         string
+		wantRequests int
+	}{
+		{"needs authorization", http.StatusOK, "", http.StatusBadRequest, `{"error": "invalid_grant", "error_description": "code expired"}`, CredentialsNeedAuthorization, 2},
+		{"login redirect", http.StatusFound, "https://www.linkedin.com/login", http.StatusBadRequest, `{"error": "invalid_request"}`, CredentialsNeedAuthorization, 2},
+		{"unknown client ID", http.StatusNotFound, "", 0, "", CredentialsRejected, 1},
+		{"redirect URL refused", http.StatusFound, "http://localhost:8080/callback?error=invalid_redirect_uri&error_description=not+registered", 0, "", CredentialsRejected, 1},
+		{"wrong secret", http.StatusOK, "", http.StatusUnauthorized, `{"error": "invalid_client"}`, CredentialsRejected, 2},
+		{"unexpected response", http.StatusOK, "", http.StatusInternalServerError, "<html>oops</html>", CredentialsUnknown, 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			requests := 0
+
+			useFakeAPI(t, func(w http.ResponseWriter, r *http.Request) {
+				requests++
+
+				switch r.URL.Path {
+				case "/oauth/v2/authorization":
+					if r.Method != http.MethodHead || r.URL.Query().Get("client_id") != "id" {
+						t.Errorf("authorization request = %s %s, want a HEAD with the client ID", r.Method, r.URL)
+					}
+
+					if tt.authLocation != "" {
+						w.Header().Set("Location", tt.authLocation)
+					}
+
+					w.WriteHeader(tt.authStatus)
+				case "/oauth/v2/accessToken":
+					if err := r.ParseForm(); err != nil || r.PostForm.Get("code") != probeCode || r.PostForm.Get("client_secret") != "secret" {
+						t.Errorf("token request form = %v, want the probe code and credentials", r.PostForm)
+					}
+
+					w.WriteHeader(tt.tokenStatus)
+					_, _ = io.WriteString(w, tt.tokenBody)
+				default:
+					t.Errorf("unexpected request %s %s", r.Method, r.URL)
+				}
+			})
+
+			check, err := NewClient(NewConfig("id", "secret", "http://localhost:8080/callback")).CheckCredentials(context.Background())
+			if err != nil {
+				t.Fatalf("CheckCredentials: %v", err)
+			}
+
+			if check.Status != tt.want {
+				t.Errorf("status = %q (%s), want %q", check.Status, check.Detail, tt.want)
+			}
+
+			// Each check is a single attempt per endpoint so repeated runs cannot lock the app out
+			if requests != tt.wantRequests {
+				t.Errorf("made %d requests, want %d", requests, tt.wantRequests)
+			}
+		})
+	}
+}
+
+func TestCheckCredentialsUnreachable(t *testing.T) {
+	useFakeAPI(t, func(http.ResponseWriter, *http.Request) {
+		panic(http.ErrAbortHandler)
+	})
+
+	if _, err := NewClient(NewConfig("id", "secret", "http://localhost:8080/callback")).CheckCredentials(context.Background()); err == nil {
+		t.Fatal("CheckCredentials reported an outcome without reaching LinkedIn")
+	}
+}
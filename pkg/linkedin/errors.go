@@ -0,0 +1,127 @@
+package linkedin
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ErrServiceUnavailable is wrapped by errors for 503 responses, which LinkedIn returns during
+// maintenance windows and outages; the request can be retried later.
+var ErrServiceUnavailable = errors.New("LinkedIn is temporarily unavailable")
+
+// ErrServerError is wrapped by errors for 5xx responses other than 503, which usually pass on retry.
+var ErrServerError = errors.New("LinkedIn server error")
+
+// ErrTooManyRequests is wrapped by errors for 429 responses, which LinkedIn returns when it throttles
+// the member or the app; RetryAfter tells how long to wait.
+var ErrTooManyRequests = errors.New("LinkedIn rate limit exceeded")
+
+// RateLimitError is returned for 429 responses and carries the wait LinkedIn asked for.
+type RateLimitError struct {
+	RetryAfter time.Duration // 0 when LinkedIn sent no usable Retry-After header
+	err        error
+}
+
+func (e *RateLimitError) Error() string {
+	if e.RetryAfter > 0 {
+		return fmt.Sprintf("%v, retry after %v: %v", ErrTooManyRequests, e.RetryAfter, e.err)
+	}
+
+	return fmt.Sprintf("%v: %v", ErrTooManyRequests, e.err)
+}
+
+// Unwrap makes the error match both ErrTooManyRequests and the underlying API error.
+func (e *RateLimitError) Unwrap() []error {
+	return []error{ErrTooManyRequests, e.err}
+}
+
+// RetryAfter returns the wait LinkedIn asked for when err is or wraps a RateLimitError.
+func RetryAfter(err error) (time.Duration, bool) {
+	var rateLimited *RateLimitError
+	if !errors.As(err, &rateLimited) {
+		return 0, false
+	}
+
+	return rateLimited.RetryAfter, true
+}
+
+// parseRetryAfter reads a Retry-After header given in seconds or as an HTTP date; it returns 0
+// when the header is missing, invalid or already past.
+func parseRetryAfter(value string, now time.Time) time.Duration {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return 0
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil {
+		return max(time.Duration(seconds)*time.Second, 0)
+	}
+
+	if at, err := http.ParseTime(value); err == nil {
+		return max(at.Sub(now), 0)
+	}
+
+	return 0
+}
+
+// requestIDHeaders lists the response headers identifying a request to LinkedIn support, most specific first.
+var requestIDHeaders = []string{"X-Li-Uuid", "X-Li-Request-Id", "X-Request-Id", "X-Li-Fabric"}
+
+// RequestID returns the LinkedIn request ID from response headers, or an empty string.
+func RequestID(header http.Header) string {
+	for _, name := range requestIDHeaders {
+		if id := header.Get(name); id != "" {
+			return id
+		}
+	}
+
+	return ""
+}
+
+// LastRequestID returns the request ID of the most recent LinkedIn API response, for quoting to LinkedIn support.
+func (c *Client) LastRequestID() string {
+	return c.lastRequestID
+}
+
+// APIError is a failed LinkedIn API response. Errors from the client wrap it, so callers can branch
+// on the status with errors.As or StatusCode.
+type APIError struct {
+	Kind       string // "API" for API calls, "upload" for media uploads
+	StatusCode int
+	Body       string
+	RequestID  string // LinkedIn's request ID for the response, when it sent one
+}
+
+func (e *APIError) Error() string {
+	if e.RequestID == "" {
+		return fmt.Sprintf("%s error (%d): %s", e.Kind, e.StatusCode, e.Body)
+	}
+
+	return fmt.Sprintf("%s error (%d, request ID %s): %s", e.Kind, e.StatusCode, e.RequestID, e.Body)
+}
+
+// StatusCode returns the HTTP status of the APIError in err's chain, or 0 when there is none.
+func StatusCode(err error) int {
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		return 0
+	}
+
+	return apiErr.StatusCode
+}
+
+// apiError describes a failed API response, including the request ID of that response when LinkedIn sent one.
+// Server errors also wrap ErrServerError; callers wrap 503 responses in ErrServiceUnavailable themselves.
+func (c *Client) apiError(kind string, status int, body []byte) error {
+	err := &APIError{Kind: kind, StatusCode: status, Body: string(body), RequestID: c.lastRequestID}
+
+	if status >= http.StatusInternalServerError && status != http.StatusServiceUnavailable {
+		return fmt.Errorf("%w: %w", ErrServerError, err)
+	}
+
+	return err
+}
@@ -0,0 +1,159 @@
+package linkedin
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestCreatePostServiceUnavailable(t *testing.T) {
+	status := http.StatusServiceUnavailable
+
+	useFakeAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(status)
+	})
+
+	client := newTestClient()
+
+	err := client.CreatePost(context.Background(), "during maintenance", AuthorPerson, "abc")
+	if !errors.Is(err, ErrServiceUnavailable) {
+		t.Fatalf("503 error = %v, want ErrServiceUnavailable", err)
+	}
+
+	if code := StatusCode(err); code != http.StatusServiceUnavailable {
+		t.Errorf("StatusCode = %d, want 503", code)
+	}
+
+	status = http.StatusBadRequest
+
+	if err := client.CreatePost(context.Background(), "bad request", AuthorPerson, "abc"); errors.Is(err, ErrServiceUnavailable) {
+		t.Errorf("400 error = %v, want it not to look like maintenance", err)
+	}
+}
+
+func TestRequestID(t *testing.T) {
+	tests := []struct {
+		header http.Header
+		want   string
+	}{
+		{http.Header{"X-Li-Fabric": {"prod-lor1"}}, "prod-lor1"},
+		{http.Header{"X-Li-Fabric": {"prod-lor1"}, "X-Li-Uuid": {"uuid-1"}}, "uuid-1"},
+		{http.Header{"X-Request-Id": {"req-2"}}, "req-2"},
+		{http.Header{}, ""},
+	}
+
+	for _, tt := range tests {
+		if got := RequestID(tt.header); got != tt.want {
+			t.Errorf("RequestID(%v) = %q, want %q", tt.header, got, tt.want)
+		}
+	}
+}
+
+func TestCreatePostErrorIncludesRequestID(t *testing.T) {
+	useFakeAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Li-Uuid", "AQE3h0Xr")
+		w.WriteHeader(http.StatusUnprocessableEntity)
+		_, _ = w.Write([]byte(`{"message": "duplicate content"}`))
+	})
+
+	client := newTestClient()
+
+	err := client.CreatePost(context.Background(), "rejected", AuthorPerson, "abc")
+	if err == nil {
+		t.Fatal("CreatePost succeeded despite a 422")
+	}
+
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || apiErr.RequestID != "AQE3h0Xr" {
+		t.Fatalf("error = %v, want an APIError with the request ID", err)
+	}
+
+	if !strings.Contains(err.Error(), "request ID AQE3h0Xr") {
+		t.Errorf("error message %q does not quote the request ID", err)
+	}
+
+	if client.LastRequestID() != "AQE3h0Xr" {
+		t.Errorf("LastRequestID = %q, want the failed response's ID", client.LastRequestID())
+	}
+}
+
+func TestGetProfileRecordsRequestID(t *testing.T) {
+	useFakeAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Li-Request-Id", "profile-req")
+		_, _ = w.Write([]byte(`{"sub": "abc"}`))
+	})
+
+	client := newTestClient()
+
+	if _, err := client.GetProfile(context.Background()); err != nil {
+		t.Fatalf("GetProfile: %v", err)
+	}
+
+	if client.LastRequestID() != "profile-req" {
+		t.Errorf("LastRequestID = %q, want the successful response's ID", client.LastRequestID())
+	}
+}
+
+func TestCreatePostTooManyRequests(t *testing.T) {
+	retryAfter := "120"
+
+	useFakeAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		if retryAfter != "" {
+			w.Header().Set("Retry-After", retryAfter)
+		}
+
+		w.WriteHeader(http.StatusTooManyRequests)
+	})
+
+	client := newTestClient()
+
+	err := client.CreatePost(context.Background(), "throttled", AuthorPerson, "abc")
+	if !errors.Is(err, ErrTooManyRequests) {
+		t.Fatalf("429 error = %v, want ErrTooManyRequests", err)
+	}
+
+	if wait, ok := RetryAfter(err); !ok || wait != 2*time.Minute {
+		t.Errorf("RetryAfter = %v, %v, want 2m", wait, ok)
+	}
+
+	if code := StatusCode(err); code != http.StatusTooManyRequests {
+		t.Errorf("StatusCode = %d, want 429", code)
+	}
+
+	retryAfter = ""
+
+	err = client.CreatePost(context.Background(), "throttled again", AuthorPerson, "abc")
+	if wait, ok := RetryAfter(err); !ok || wait != 0 {
+		t.Errorf("RetryAfter without the header = %v, %v, want 0 and throttled", wait, ok)
+	}
+
+	if _, ok := RetryAfter(errors.New("other")); ok {
+		t.Error("RetryAfter matched an error that is not a 429")
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		value string
+		want  time.Duration
+	}{
+		{"", 0},
+		{"30", 30 * time.Second},
+		{" 5 ", 5 * time.Second},
+		{"-10", 0},
+		{now.Add(90 * time.Second).Format(http.TimeFormat), 90 * time.Second},
+		{now.Add(-time.Minute).Format(http.TimeFormat), 0},
+		{"soon", 0},
+	}
+
+	for _, tt := range tests {
+		if got := parseRetryAfter(tt.value, now); got != tt.want {
+			t.Errorf("parseRetryAfter(%q) = %v, want %v", tt.value, got, tt.want)
+		}
+	}
+}
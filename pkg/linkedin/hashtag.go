@@ -0,0 +1,105 @@
+package linkedin
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+var (
+	// hashtagPattern matches a #tag that starts a word; # inside words, URLs and entities is not a hashtag.
+	hashtagPattern = regexp.MustCompile(`(?:^|[^\p{L}\p{N}_&#/])#([\p{L}\p{N}_]+)`)
+	// spacedHashtagPattern matches "# tag", which LinkedIn shows as plain text.
+	spacedHashtagPattern = regexp.MustCompile(`(?m)(?:^|\s)#[ \t]+([\p{L}\p{N}_]+)`)
+	// doubleHashtagPattern matches "##tag", which LinkedIn does not link either.
+	doubleHashtagPattern = regexp.MustCompile(`(?:^|\s)##+([\p{L}\p{N}_]+)`)
+	// mentionPattern matches a mention in LinkedIn's @[Name](urn) format.
+	mentionPattern = regexp.MustCompile(`@\[([^\]]*)\]\(([^)]*)\)`)
+	// plainMentionPattern matches an @name that is not in the mention format, skipping email addresses.
+	plainMentionPattern = regexp.MustCompile(`(?:^|[^\p{L}\p{N}_.@])@([\p{L}\p{N}_][\p{L}\p{N}_.\-]*)`)
+)
+
+// ContentTags lists the hashtags and mentions in a post's text, with a warning for each one LinkedIn
+// would not link as intended.
+type ContentTags struct {
+	Hashtags []string `json:"hashtags"`
+	Mentions []string `json:"mentions"`
+	Warnings []string `json:"warnings"`
+}
+
+// ScanContent finds the hashtags and mentions in text. It never rejects the text: LinkedIn publishes
+// malformed hashtags and mentions as plain text, so they are only reported as warnings.
+func ScanContent(text string) ContentTags {
+	tags := ContentTags{Hashtags: []string{}, Mentions: []string{}, Warnings: []string{}}
+
+	for _, match := range hashtagPattern.FindAllStringSubmatchIndex(text, -1) {
+		name := text[match[2]:match[3]]
+		hashtag := "#" + name
+
+		if !slices.Contains(tags.Hashtags, hashtag) {
+			tags.Hashtags = append(tags.Hashtags, hashtag)
+		}
+
+		if !strings.ContainsFunc(name, unicode.IsLetter) {
+			tags.Warnings = append(tags.Warnings, fmt.Sprintf("%s has no letters, so LinkedIn will not link it", hashtag))
+		}
+
+		if rest, ok := cutHashtag(text[match[3]:]); ok {
+			separator, _ := utf8.DecodeRuneInString(rest)
+			tags.Warnings = append(tags.Warnings, fmt.Sprintf("%s%s is cut off at %q, hashtags can only contain letters, digits and underscores", hashtag, rest, separator))
+		}
+	}
+
+	for _, match := range spacedHashtagPattern.FindAllStringSubmatch(text, -1) {
+		tags.Warnings = append(tags.Warnings, fmt.Sprintf("\"# %s\" has a space after the #, so LinkedIn will not link it", match[1]))
+	}
+
+	for _, match := range doubleHashtagPattern.FindAllStringSubmatch(text, -1) {
+		tags.Warnings = append(tags.Warnings, fmt.Sprintf("\"##%s\" has more than one #, so LinkedIn will not link it", match[1]))
+	}
+
+	for _, match := range mentionPattern.FindAllStringSubmatch(text, -1) {
+		name, urn := strings.TrimSpace(match[1]), strings.TrimSpace(match[2])
+
+		switch {
+		case name == "":
+			tags.Warnings = append(tags.Warnings, fmt.Sprintf("mention %s has no name", match[0]))
+		case !strings.HasPrefix(urn, PersonURNPrefix) && !strings.HasPrefix(urn, OrganizationURNPrefix):
+			tags.Warnings = append(tags.Warnings, fmt.Sprintf("mention %s must reference a %s or %s URN", match[0], PersonURNPrefix, OrganizationURNPrefix))
+		default:
+			tags.Mentions = append(tags.Mentions, "@"+name)
+		}
+	}
+
+	for _, match := range plainMentionPattern.FindAllStringSubmatch(text, -1) {
+		tags.Warnings = append(tags.Warnings, fmt.Sprintf("@%s is published as plain text, mention someone as @[Name](urn:li:person:ID)", strings.TrimRight(match[1], ".-")))
+	}
+
+	return tags
+}
+
+// cutHashtag returns the text a hashtag was meant to continue with when it is followed by a hyphen
+// or dot and more letters, as in #e-commerce or #node.js.
+func cutHashtag(rest string) (string, bool) {
+	separator, size := utf8.DecodeRuneInString(rest)
+	if !strings.ContainsRune("-.", separator) {
+		return "", false
+	}
+
+	word := strings.IndexFunc(rest[size:], func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r) && r != '_'
+	})
+
+	if word == -1 {
+		word = len(rest) - size
+	}
+
+	if word == 0 {
+		return "", false
+	}
+
+	return rest[:size+word], true
+}
@@ -0,0 +1,220 @@
+package linkedin
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+const (
+	// ImagesURL is the LinkedIn Images API endpoint.
+	ImagesURL = APIBaseURL + "/images"
+	// MaxImageSize is the largest image file accepted for upload, in bytes.
+	MaxImageSize = 10 << 20
+	// maxAltTextLength is the maximum number of characters LinkedIn accepts in image alt text.
+	maxAltTextLength = 4086
+	sniffLength      = 512
+)
+
+// ImageTypes lists the image content types LinkedIn accepts for feed posts.
+var ImageTypes = []string{"image/jpeg", "image/png", "image/gif"}
+
+// MediaContent references an uploaded image or video in a post payload.
+type MediaContent struct {
+	ID      string `json:"id"`
+	AltText string `json:"altText,omitempty"`
+	Title   string `json:"title,omitempty"` // Video title
+}
+
+// ValidateImageFile checks that the file exists, is a supported image type and fits the size limit.
+// It returns the detected content type.
+func ValidateImageFile(path string) (string, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return "", fmt.Errorf("image file: %w", err)
+	}
+
+	if info.IsDir() {
+		return "", fmt.Errorf("image path %s is a directory", path)
+	}
+
+	if info.Size() == 0 {
+		return "", fmt.Errorf("image file %s is empty", path)
+	}
+
+	if info.Size() > MaxImageSize {
+		return "", fmt.Errorf("image file %s is %d bytes, LinkedIn accepts at most %d", path, info.Size(), MaxImageSize)
+	}
+
+	file, err := os.Open(filepath.Clean(path))
+	if err != nil {
+		return "", fmt.Errorf("failed to open image: %w", err)
+	}
+
+	defer func() {
+		_ = file.Close()
+	}()
+
+	head := make([]byte, sniffLength)
+
+	n, err := io.ReadFull(file, head)
+	if err != nil && !errors.Is(err, io.ErrUnexpectedEOF) {
+		return "", fmt.Errorf("failed to read image: %w", err)
+	}
+
+	contentType := http.DetectContentType(head[:n])
+	for _, valid := range ImageTypes {
+		if contentType == valid {
+			return contentType, nil
+		}
+	}
+
+	return "", fmt.Errorf("image file %s is %s, supported types are %s", path, contentType, strings.Join(ImageTypes, ", "))
+}
+
+// ValidateAltText checks the alt text length.
+func ValidateAltText(altText string) error {
+	if len([]rune(altText)) > maxAltTextLength {
+		return fmt.Errorf("image alt text must be at most %d characters", maxAltTextLength)
+	}
+
+	return nil
+}
+
+// UploadImage uploads a local image owned by the given author URN and returns the image URN to
+// reference in a post. It runs LinkedIn's two-step flow: initialize the upload to get an upload
+// URL, then PUT the binary to it.
+func (c *Client) UploadImage(ctx context.Context, owner, path string) (string, error) {
+	if c.token == nil {
+		return "", fmt.Errorf("no access token available")
+	}
+
+	contentType, err := ValidateImageFile(path)
+	if err != nil {
+		return "", err
+	}
+
+	uploadURL, image, err := c.initializeImageUpload(ctx, owner)
+	if err != nil {
+		return "", fmt.Errorf("image upload: initialize failed: %w", err)
+	}
+
+	if err := c.putImage(ctx, uploadURL, path, contentType); err != nil {
+		return "", fmt.Errorf("image upload: sending %s failed: %w", image, err)
+	}
+
+	return image, nil
+}
+
+// initializeImageUpload registers an upload and returns the upload URL and the image URN.
+func (c *Client) initializeImageUpload(ctx context.Context, owner string) (string, string, error) {
+	payload, err := json.Marshal(map[string]interface{}{
+		"initializeUploadRequest": map[string]string{"owner": owner},
+	})
+	if err != nil {
+		return "", "", fmt.Errorf("failed to marshal upload request: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", ImagesURL+"?action=initializeUpload", bytes.NewBuffer(payload))
+	if err != nil {
+		return "", "", fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	c.setAPIHeaders(req)
+
+	body, status, err := c.do(req)
+	if err != nil {
+		return "", "", err
+	}
+
+	if status != http.StatusOK {
+		return "", "", c.apiError("API", status, body)
+	}
+
+	var result struct {
+		Value struct {
+			UploadURL string `json:"uploadUrl"`
+			Image     string `json:"image"`
+		} `json:"value"`
+	}
+
+	if err := json.Unmarshal(body, &result); err != nil {
+		return "", "", fmt.Errorf("failed to parse upload response: %w", err)
+	}
+
+	if result.Value.UploadURL == "" || result.Value.Image == "" {
+		return "", "", fmt.Errorf("upload response is missing the upload URL or image URN")
+	}
+
+	return result.Value.UploadURL, result.Value.Image, nil
+}
+
+// putImage sends the image binary to the upload URL.
+func (c *Client) putImage(ctx context.Context, uploadURL, path, contentType string) error {
+	data, err := os.ReadFile(filepath.Clean(path))
+	if err != nil {
+		return fmt.Errorf("failed to read image: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "PUT", uploadURL, bytes.NewReader(data))
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", contentType)
+	req.Header.Set("Authorization", "Bearer "+c.token.AccessToken)
+
+	body, status, err := c.do(req)
+	if err != nil {
+		return err
+	}
+
+	if status != http.StatusOK && status != http.StatusCreated {
+		return c.apiError("upload", status, body)
+	}
+
+	return nil
+}
+
+// setAPIHeaders sets the headers shared by LinkedIn REST API requests.
+func (c *Client) setAPIHeaders(req *http.Request) {
+	req.Header.Set("Authorization", "Bearer "+c.token.AccessToken)
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("User-Agent", "PostedIn/1.0")
+	req.Header.Set("LinkedIn-Version", c.apiVersion())
+}
+
+// do sends the request and returns the response body and status code.
+func (c *Client) do(req *http.Request) ([]byte, int, error) {
+	client := &http.Client{
+		Timeout: httpTimeout,
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, 0, fmt.Errorf("request failed: %w", err)
+	}
+
+	defer func() {
+		if closeErr := resp.Body.Close(); closeErr != nil {
+			fmt.Printf("Warning: failed to close response body: %v\n", closeErr)
+		}
+	}()
+
+	c.lastRequestID = RequestID(resp.Header)
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, resp.StatusCode, fmt.Errorf("failed to read response: %w", err)
+	}
+
+	return body, resp.StatusCode, nil
+}
@@ -0,0 +1,155 @@
+package linkedin
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// pngHeader is enough of a PNG file for content type detection.
+const pngHeader = "\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR"
+
+func writeFile(t *testing.T, name, data string) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), name)
+	if err := os.WriteFile(path, []byte(data), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	return path
+}
+
+func TestValidateImageFile(t *testing.T) {
+	png := writeFile(t, "chart.png", pngHeader)
+
+	contentType, err := ValidateImageFile(png)
+	if err != nil || contentType != "image/png" {
+		t.Fatalf("ValidateImageFile(png) = %q, %v", contentType, err)
+	}
+
+	oversized := filepath.Join(t.TempDir(), "huge.png")
+	if err := os.WriteFile(oversized, []byte(pngHeader), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := os.Truncate(oversized, MaxImageSize+1); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := map[string]string{
+		"missing":   filepath.Join(t.TempDir(), "missing.png"),
+		"directory": t.TempDir(),
+		"empty":     writeFile(t, "empty.png", ""),
+		"not image": writeFile(t, "notes.png", "just some text"),
+		"too large": oversized,
+	}
+
+	for name, path := range tests {
+		if _, err := ValidateImageFile(path); err == nil {
+			t.Errorf("%s: ValidateImageFile accepted %s", name, path)
+		}
+	}
+}
+
+func TestUploadImageSequence(t *testing.T) {
+	var steps []string
+
+	useFakeAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/rest/images" && r.URL.Query().Get("action") == "initializeUpload":
+			steps = append(steps, "initialize")
+
+			body, _ := io.ReadAll(r.Body)
+			if !strings.Contains(string(body), `"owner":"urn:li:person:abc"`) {
+				t.Errorf("initialize body = %s, want the owner", body)
+			}
+
+			_, _ = io.WriteString(w, `{"value": {"uploadUrl": "https://www.linkedin.com/dms-uploads/img1", "image": "urn:li:image:img1"}}`)
+		case r.Method == http.MethodPut && r.URL.Path == "/dms-uploads/img1":
+			steps = append(steps, "upload")
+
+			if r.Header.Get("Content-Type") != "image/png" {
+				t.Errorf("upload Content-Type = %q, want image/png", r.Header.Get("Content-Type"))
+			}
+
+			if body, _ := io.ReadAll(r.Body); string(body) != pngHeader {
+				t.Errorf("uploaded %d bytes, want the image file", len(body))
+			}
+
+			w.WriteHeader(http.StatusCreated)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	image, err := newTestClient().UploadImage(context.Background(), "urn:li:person:abc", writeFile(t, "chart.png", pngHeader))
+	if err != nil {
+		t.Fatalf("UploadImage: %v", err)
+	}
+
+	if image != "urn:li:image:img1" {
+		t.Errorf("image = %q, want urn:li:image:img1", image)
+	}
+
+	if strings.Join(steps, ",") != "initialize,upload" {
+		t.Errorf("steps = %v, want initialize then upload", steps)
+	}
+}
+
+func TestUploadImageStageErrors(t *testing.T) {
+	tests := []struct {
+		name       string
+		initStatus int
+		initBody   string
+		putStatus  int
+		wantErr    string
+		wantSteps  int
+	}{
+		{"initialize rejected", http.StatusForbidden, `{"message": "denied"}`, http.StatusCreated, "initialize failed", 1},
+		{"initialize incomplete", http.StatusOK, `{"value": {"image": "urn:li:image:img1"}}`, http.StatusCreated, "missing the upload URL", 1},
+		{"upload rejected", http.StatusOK, `{"value": {"uploadUrl": "https://www.linkedin.com/up", "image": "urn:li:image:img1"}}`, http.StatusBadRequest, "sending urn:li:image:img1 failed", 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			steps := 0
+
+			useFakeAPI(t, func(w http.ResponseWriter, r *http.Request) {
+				steps++
+
+				if r.Method == http.MethodPut {
+					w.WriteHeader(tt.putStatus)
+					return
+				}
+
+				w.WriteHeader(tt.initStatus)
+				_, _ = io.WriteString(w, tt.initBody)
+			})
+
+			_, err := newTestClient().UploadImage(context.Background(), "urn:li:person:abc", writeFile(t, "chart.png", pngHeader))
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("error = %v, want it to contain %q", err, tt.wantErr)
+			}
+
+			if steps != tt.wantSteps {
+				t.Errorf("made %d requests, want %d", steps, tt.wantSteps)
+			}
+		})
+	}
+}
+
+func TestUploadImageValidatesBeforeUploading(t *testing.T) {
+	useFakeAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request %s %s for an invalid file", r.Method, r.URL)
+	})
+
+	if _, err := newTestClient().UploadImage(context.Background(), "urn:li:person:abc", writeFile(t, "notes.txt", "text")); err == nil {
+		t.Fatal("UploadImage accepted a text file")
+	}
+}
@@ -0,0 +1,233 @@
+package linkedin
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+const (
+	// MinPollOptions is the minimum number of options a LinkedIn poll accepts.
+	MinPollOptions = 2
+	// MaxPollOptions is the maximum number of options a LinkedIn poll accepts.
+	MaxPollOptions = 4
+	// MaxPollQuestionLength is the maximum number of characters in a poll question.
+	MaxPollQuestionLength = 140
+	// MaxPollOptionLength is the maximum number of characters in a single poll option.
+	MaxPollOptionLength = 30
+	// DefaultPollDuration is used when no poll duration is specified.
+	DefaultPollDuration = "THREE_DAYS"
+)
+
+// PollDurations lists the poll durations supported by LinkedIn.
+var PollDurations = []string{"ONE_DAY", "THREE_DAYS", "SEVEN_DAYS", "FOURTEEN_DAYS"}
+
+// pollLengths maps each poll duration to how long the poll stays open.
+var pollLengths = map[string]time.Duration{
+	"ONE_DAY":       24 * time.Hour,
+	"THREE_DAYS":    3 * 24 * time.Hour,
+	"SEVEN_DAYS":    7 * 24 * time.Hour,
+	"FOURTEEN_DAYS": 14 * 24 * time.Hour,
+}
+
+// PollContent represents the poll section of a post payload.
+type PollContent struct {
+	Question string       `json:"question"`
+	Options  []PollOption `json:"options"`
+	Settings PollSettings `json:"settings"`
+}
+
+// PollOption represents a single poll answer.
+type PollOption struct {
+	Text string `json:"text"`
+}
+
+// PollSettings holds poll configuration such as its duration.
+type PollSettings struct {
+	Duration string `json:"duration"`
+}
+
+// ValidatePoll checks the poll question, option count and lengths, and duration.
+func ValidatePoll(question string, options []string, duration string) error {
+	question = strings.TrimSpace(question)
+	if question == "" {
+		return fmt.Errorf("poll question cannot be empty")
+	}
+
+	if utf8.RuneCountInString(question) > MaxPollQuestionLength {
+		return fmt.Errorf("poll question exceeds %d characters", MaxPollQuestionLength)
+	}
+
+	if len(options) < MinPollOptions || len(options) > MaxPollOptions {
+		return fmt.Errorf("poll must have between %d and %d options, got %d", MinPollOptions, MaxPollOptions, len(options))
+	}
+
+	seen := make(map[string]struct{}, len(options))
+
+	for i, option := range options {
+		option = strings.TrimSpace(option)
+		if option == "" {
+			return fmt.Errorf("poll option %d cannot be empty", i+1)
+		}
+
+		if utf8.RuneCountInString(option) > MaxPollOptionLength {
+			return fmt.Errorf("poll option %d exceeds %d characters", i+1, MaxPollOptionLength)
+		}
+
+		key := strings.ToLower(option)
+		if _, ok := seen[key]; ok {
+			return fmt.Errorf("poll option %q is duplicated", option)
+		}
+
+		seen[key] = struct{}{}
+	}
+
+	if duration != "" && !isPollDuration(duration) {
+		return fmt.Errorf("invalid poll duration %q (allowed: %s)", duration, strings.Join(PollDurations, ", "))
+	}
+
+	return nil
+}
+
+// NewPollContent validates the poll and builds its post content payload.
+func NewPollContent(question string, options []string, duration string) (*PostContent, error) {
+	if err := ValidatePoll(question, options, duration); err != nil {
+		return nil, err
+	}
+
+	if duration == "" {
+		duration = DefaultPollDuration
+	}
+
+	pollOptions := make([]PollOption, 0, len(options))
+	for _, option := range options {
+		pollOptions = append(pollOptions, PollOption{Text: strings.TrimSpace(option)})
+	}
+
+	return &PostContent{
+		Poll: &PollContent{
+			Question: strings.TrimSpace(question),
+			Options:  pollOptions,
+			Settings: PollSettings{Duration: duration},
+		},
+	}, nil
+}
+
+func isPollDuration(duration string) bool {
+	for _, d := range PollDurations {
+		if d == duration {
+			return true
+		}
+	}
+
+	return false
+}
+
+// PollLength returns how long a poll with the given duration stays open; an empty duration means the default.
+func PollLength(duration string) time.Duration {
+	if duration == "" {
+		duration = DefaultPollDuration
+	}
+
+	return pollLengths[duration]
+}
+
+// PollResults holds the tallies of a published poll.
+type PollResults struct {
+	Question string
+	Options  []PollOptionResult
+	Voters   int // Unique members who voted
+}
+
+// PollOptionResult holds the votes cast for one poll option.
+type PollOptionResult struct {
+	Text  string
+	Votes int
+}
+
+// pollPostResponse is the part of a Posts API response that carries the poll tallies.
+type pollPostResponse struct {
+	Content struct {
+		Poll *struct {
+			Question string `json:"question"`
+			Options  []struct {
+				Text      string `json:"text"`
+				VoteCount int    `json:"voteCount"`
+			} `json:"options"`
+			UniqueVotersCount int `json:"uniqueVotersCount"`
+		} `json:"poll"`
+	} `json:"content"`
+}
+
+// GetPollResults fetches the current tallies of the poll published as postURN.
+func (c *Client) GetPollResults(ctx context.Context, postURN string) (PollResults, error) {
+	if c.token == nil {
+		return PollResults{}, fmt.Errorf("no access token available")
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "GET", PostsURL+"/"+url.PathEscape(postURN), http.NoBody)
+	if err != nil {
+		return PollResults{}, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.Header.Set("Authorization", "Bearer "+c.token.AccessToken)
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("User-Agent", "PostedIn/1.0")
+	req.Header.Set("LinkedIn-Version", c.apiVersion())
+
+	client := &http.Client{
+		Timeout: httpTimeout,
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return PollResults{}, fmt.Errorf("failed to get poll results: %w", err)
+	}
+
+	defer func() {
+		if closeErr := resp.Body.Close(); closeErr != nil {
+			fmt.Printf("Warning: failed to close response body: %v\n", closeErr)
+		}
+	}()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return PollResults{}, fmt.Errorf("failed to read response: %w", err)
+	}
+
+	c.lastRequestID = RequestID(resp.Header)
+
+	if resp.StatusCode == http.StatusServiceUnavailable {
+		return PollResults{}, fmt.Errorf("%w: %w", ErrServiceUnavailable, c.apiError("API", resp.StatusCode, body))
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return PollResults{}, c.apiError("API", resp.StatusCode, body)
+	}
+
+	var post pollPostResponse
+	if err := json.Unmarshal(body, &post); err != nil {
+		return PollResults{}, fmt.Errorf("failed to parse post: %w", err)
+	}
+
+	if post.Content.Poll == nil {
+		return PollResults{}, fmt.Errorf("post %s has no poll", postURN)
+	}
+
+	results := PollResults{
+		Question: post.Content.Poll.Question,
+		Voters:   post.Content.Poll.UniqueVotersCount,
+	}
+
+	for _, option := range post.Content.Poll.Options {
+		results.Options = append(results.Options, PollOptionResult{Text: option.Text, Votes: option.VoteCount})
+	}
+
+	return results, nil
+}
@@ -0,0 +1,67 @@
+package linkedin
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestValidatePoll(t *testing.T) {
+	tests := []struct {
+		name     string
+		question string
+		options  []string
+		duration string
+		wantErr  string
+	}{
+		{"valid", "Tabs or spaces?", []string{"Tabs", "Spaces"}, "", ""},
+		{"valid duration", "Favourite?", []string{"A", "B", "C", "D"}, "SEVEN_DAYS", ""},
+		{"empty question", "  ", []string{"A", "B"}, "", "question cannot be empty"},
+		{"long question", strings.Repeat("q", MaxPollQuestionLength+1), []string{"A", "B"}, "", "exceeds 140"},
+		{"one option", "Q?", []string{"A"}, "", "between 2 and 4"},
+		{"five options", "Q?", []string{"A", "B", "C", "D", "E"}, "", "between 2 and 4"},
+		{"empty option", "Q?", []string{"A", " "}, "", "option 2 cannot be empty"},
+		{"long option", "Q?", []string{"A", strings.Repeat("o", MaxPollOptionLength+1)}, "", "option 2 exceeds 30"},
+		{"duplicate option", "Q?", []string{"Yes", " yes "}, "", "duplicated"},
+		{"bad duration", "Q?", []string{"A", "B"}, "TWO_DAYS", "invalid poll duration"},
+	}
+
+	for _, tt := range tests {
+		err := ValidatePoll(tt.question, tt.options, tt.duration)
+
+		switch {
+		case tt.wantErr == "" && err != nil:
+			t.Errorf("%s: unexpected error %v", tt.name, err)
+		case tt.wantErr != "" && (err == nil || !strings.Contains(err.Error(), tt.wantErr)):
+			t.Errorf("%s: error = %v, want it to contain %q", tt.name, err, tt.wantErr)
+		}
+	}
+}
+
+func TestNewPollContentPayload(t *testing.T) {
+	content, err := NewPollContent(" Tabs or spaces? ", []string{" Tabs", "Spaces "}, "")
+	if err != nil {
+		t.Fatalf("NewPollContent: %v", err)
+	}
+
+	data, err := json.Marshal(newPost("urn:li:person:abc", "Vote!", content, "", nil))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := `"content":{"poll":{"question":"Tabs or spaces?","options":[{"text":"Tabs"},{"text":"Spaces"}],"settings":{"duration":"THREE_DAYS"}}}`
+	if !strings.Contains(string(data), want) {
+		t.Errorf("payload = %s, want it to contain %s", data, want)
+	}
+}
+
+func TestPollLength(t *testing.T) {
+	if got := PollLength(""); got != 3*24*time.Hour {
+		t.Errorf("PollLength(\"\") = %v, want the default three days", got)
+	}
+
+	if got := PollLength("FOURTEEN_DAYS"); got != 14*24*time.Hour {
+		t.Errorf("PollLength(FOURTEEN_DAYS) = %v", got)
+	}
+}
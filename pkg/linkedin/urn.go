@@ -0,0 +1,133 @@
+package linkedin
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+const (
+	// PersonURNPrefix is the URN prefix for member authors used by the Posts API.
+	PersonURNPrefix = "urn:li:person:"
+	// MemberURNPrefix is the URN prefix used by some accounts with numeric member IDs.
+	MemberURNPrefix = "urn:li:member:"
+	// OrganizationURNPrefix is the URN prefix for company pages posting as the author.
+	OrganizationURNPrefix = "urn:li:organization:"
+
+	urnPrefix = "urn:li:"
+)
+
+// profileIDFields lists the profile fields that may carry the member identifier, in order of preference.
+// OpenID Connect userinfo returns "sub" while the legacy /v2/me endpoint returns "id".
+var profileIDFields = []string{"sub", "id"}
+
+// AuthorURN returns the author URN for a user ID, keeping IDs that are already full URNs unchanged.
+func AuthorURN(userID string) string {
+	if strings.HasPrefix(userID, urnPrefix) {
+		return userID
+	}
+
+	return PersonURNPrefix + userID
+}
+
+// Author types: a post is published as the authenticated member or as a company page they administer.
+const (
+	AuthorPerson       = "person"
+	AuthorOrganization = "organization"
+)
+
+// OrganizationURN returns the author URN of a company page from its numeric ID or full organization URN.
+func OrganizationURN(id string) (string, error) {
+	id = strings.TrimSpace(id)
+	if !isNumeric(strings.TrimPrefix(id, OrganizationURNPrefix)) {
+		return "", fmt.Errorf("invalid organization %q, use the numeric page ID or %s{id}", id, OrganizationURNPrefix)
+	}
+
+	return OrganizationURNPrefix + strings.TrimPrefix(id, OrganizationURNPrefix), nil
+}
+
+// AuthorURNFor returns the author URN for an author type and a member or organization ID.
+// An empty author type is a person.
+func AuthorURNFor(authorType, id string) (string, error) {
+	switch authorType {
+	case "", AuthorPerson:
+		return AuthorURN(id), nil
+	case AuthorOrganization:
+		return OrganizationURN(id)
+	default:
+		return "", fmt.Errorf("invalid author type %q, use %s or %s", authorType, AuthorPerson, AuthorOrganization)
+	}
+}
+
+// CandidateAuthorURNs returns the possible author URNs for a profile payload, most likely first.
+func CandidateAuthorURNs(profile map[string]interface{}) []string {
+	var candidates []string
+
+	seen := make(map[string]struct{})
+	add := func(urn string) {
+		if _, ok := seen[urn]; ok {
+			return
+		}
+
+		seen[urn] = struct{}{}
+		candidates = append(candidates, urn)
+	}
+
+	for _, field := range profileIDFields {
+		id, ok := profile[field].(string)
+		if !ok || strings.TrimSpace(id) == "" {
+			continue
+		}
+
+		id = strings.TrimSpace(id)
+		add(AuthorURN(id))
+
+		if isNumeric(id) {
+			add(MemberURNPrefix + id)
+		}
+	}
+
+	return candidates
+}
+
+// ProfileIDFields returns the profile fields that may carry the member identifier, in default order of preference.
+func ProfileIDFields() []string {
+	return slices.Clone(profileIDFields)
+}
+
+// ProfileUserID returns the member identifier of a profile payload, read from the preferred field
+// when it is set and present, and otherwise from the first of ProfileIDFields that is present.
+func ProfileUserID(profile map[string]interface{}, preferred string) (string, error) {
+	fields := profileIDFields
+	if preferred != "" {
+		fields = append([]string{preferred}, profileIDFields...)
+	}
+
+	for _, field := range fields {
+		if id, ok := profile[field].(string); ok && strings.TrimSpace(id) != "" {
+			return strings.TrimSpace(id), nil
+		}
+	}
+
+	return "", fmt.Errorf("profile response has no usable member identifier (checked fields: %s)", strings.Join(profileIDFields, ", "))
+}
+
+// ResolveAuthorURN returns the most likely author URN for a profile payload.
+func ResolveAuthorURN(profile map[string]interface{}) (string, error) {
+	id, err := ProfileUserID(profile, "")
+	if err != nil {
+		return "", err
+	}
+
+	return AuthorURN(id), nil
+}
+
+func isNumeric(s string) bool {
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+
+	return s != ""
+}
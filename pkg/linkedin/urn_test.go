@@ -0,0 +1,49 @@
+package linkedin
+
+import "testing"
+
+func TestProfileUserID(t *testing.T) {
+	tests := []struct {
+		name      string
+		profile   map[string]interface{}
+		preferred string
+		want      string
+		wantErr   bool
+	}{
+		{name: "openid", profile: map[string]interface{}{"sub": " abc "}, want: "abc"},
+		{name: "legacy", profile: map[string]interface{}{"id": "123"}, want: "123"},
+		{name: "sub first", profile: map[string]interface{}{"sub": "abc", "id": "123"}, want: "abc"},
+		{name: "preferred", profile: map[string]interface{}{"sub": "abc", "id": "123"}, preferred: "id", want: "123"},
+		{name: "preferred missing", profile: map[string]interface{}{"sub": "abc"}, preferred: "id", want: "abc"},
+		{name: "not a string", profile: map[string]interface{}{"id": 123}, wantErr: true},
+		{name: "empty", profile: map[string]interface{}{}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ProfileUserID(tt.profile, tt.preferred)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("ProfileUserID = %q, want an error", got)
+				}
+
+				return
+			}
+
+			if err != nil || got != tt.want {
+				t.Fatalf("ProfileUserID = %q, %v, want %q", got, err, tt.want)
+			}
+		})
+	}
+}
+
+func TestResolveAuthorURN(t *testing.T) {
+	urn, err := ResolveAuthorURN(map[string]interface{}{"sub": "abc", "id": "123"})
+	if err != nil || urn != PersonURNPrefix+"abc" {
+		t.Fatalf("ResolveAuthorURN = %q, %v, want %q", urn, err, PersonURNPrefix+"abc")
+	}
+
+	if _, err := ResolveAuthorURN(map[string]interface{}{}); err == nil {
+		t.Fatal("ResolveAuthorURN accepted a profile without an identifier")
+	}
+}
@@ -0,0 +1,35 @@
+package linkedin
+
+import (
+	"fmt"
+	"regexp"
+)
+
+// DefaultAPIVersion is the LinkedIn-Version header sent when no version is configured.
+const DefaultAPIVersion = "202506"
+
+// apiVersionPattern matches LinkedIn's YYYYMM versions, optionally with a .RR revision.
+var apiVersionPattern = regexp.MustCompile(`^20\d{2}(0[1-9]|1[0-2])(\.\d{2})?$`)
+
+// ValidateAPIVersion checks that a LinkedIn-Version value has the YYYYMM or YYYYMM.RR format.
+func ValidateAPIVersion(version string) error {
+	if !apiVersionPattern.MatchString(version) {
+		return fmt.Errorf("LinkedIn API version %q must be in YYYYMM or YYYYMM.RR format", version)
+	}
+
+	return nil
+}
+
+// SetAPIVersion overrides the LinkedIn-Version header for subsequent requests; empty restores the default.
+func (c *Client) SetAPIVersion(version string) {
+	c.version = version
+}
+
+// apiVersion returns the LinkedIn-Version header value for requests.
+func (c *Client) apiVersion() string {
+	if c.version == "" {
+		return DefaultAPIVersion
+	}
+
+	return c.version
+}
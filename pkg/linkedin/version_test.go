@@ -0,0 +1,103 @@
+package linkedin
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"golang.org/x/oauth2"
+)
+
+// roundTripFunc lets a function stand in for an http.RoundTripper.
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+// useFakeAPI starts a fake server running handler and sends every request, including those to the
+// LinkedIn API, to it until the test ends. It returns the server URL.
+func useFakeAPI(t *testing.T, handler http.HandlerFunc) string {
+	t.Helper()
+
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	target, err := url.Parse(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	previous := http.DefaultTransport
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		req = req.Clone(req.Context())
+		req.URL.Scheme, req.URL.Host = target.Scheme, target.Host
+
+		return previous.RoundTrip(req)
+	})
+
+	t.Cleanup(func() { http.DefaultTransport = previous })
+
+	return server.URL
+}
+
+func newTestClient() *Client {
+	client := NewClient(NewConfig("id", "secret", "http://localhost/callback"))
+	client.SetToken(&oauth2.Token{AccessToken: "token"})
+
+	return client
+}
+
+func TestValidateAPIVersion(t *testing.T) {
+	for _, version := range []string{"202506", "202412", "202506.01"} {
+		if err := ValidateAPIVersion(version); err != nil {
+			t.Errorf("ValidateAPIVersion(%q): %v", version, err)
+		}
+	}
+
+	for _, version := range []string{"", "2025-06", "202513", "202500", "20250601", "202506.1", "v202506"} {
+		if err := ValidateAPIVersion(version); err == nil {
+			t.Errorf("ValidateAPIVersion(%q) accepted an invalid version", version)
+		}
+	}
+}
+
+func TestAPIVersionHeader(t *testing.T) {
+	var sent []string
+
+	useFakeAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		sent = append(sent, r.Header.Get("LinkedIn-Version"))
+		w.WriteHeader(http.StatusCreated)
+	})
+
+	client := newTestClient()
+
+	if err := client.CreatePost(context.Background(), "default", AuthorPerson, "abc"); err != nil {
+		t.Fatalf("CreatePost: %v", err)
+	}
+
+	client.SetAPIVersion("202411")
+
+	if err := client.CreatePost(context.Background(), "override", AuthorPerson, "abc"); err != nil {
+		t.Fatalf("CreatePost: %v", err)
+	}
+
+	client.SetAPIVersion("")
+
+	if err := client.CreatePost(context.Background(), "restored", AuthorPerson, "abc"); err != nil {
+		t.Fatalf("CreatePost: %v", err)
+	}
+
+	want := []string{DefaultAPIVersion, "202411", DefaultAPIVersion}
+	if len(sent) != len(want) {
+		t.Fatalf("sent %d requests, want %d", len(sent), len(want))
+	}
+
+	for i := range want {
+		if sent[i] != want[i] {
+			t.Errorf("request %d LinkedIn-Version = %q, want %q", i+1, sent[i], want[i])
+		}
+	}
+}
@@ -0,0 +1,407 @@
+package linkedin
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+const (
+	// VideosURL is the LinkedIn Videos API endpoint.
+	VideosURL = APIBaseURL + "/videos"
+	// MinVideoSize and MaxVideoSize bound the video file size LinkedIn accepts, in bytes.
+	MinVideoSize = 75 << 10
+	MaxVideoSize = 500 << 20
+	// MinVideoDuration and MaxVideoDuration bound the video length LinkedIn accepts.
+	MinVideoDuration = 3 * time.Second
+	MaxVideoDuration = 30 * time.Minute
+	// maxVideoTitleLength is the maximum number of characters of a video title.
+	maxVideoTitleLength = 400
+	videoPollInterval   = 5 * time.Second
+	maxVideoProcessing  = 5 * time.Minute
+)
+
+// Video processing states reported by the Videos API.
+const (
+	videoAvailable        = "AVAILABLE"
+	videoProcessingFailed = "PROCESSING_FAILED"
+)
+
+// uploadInstruction is one part of a video upload: the byte range to PUT to the upload URL.
+type uploadInstruction struct {
+	UploadURL string `json:"uploadUrl"`
+	FirstByte int64  `json:"firstByte"`
+	LastByte  int64  `json:"lastByte"`
+}
+
+// ValidateVideoFile checks that the file exists, is an MP4 within LinkedIn's size limits and
+// that its duration is within the accepted range. It returns the video duration.
+func ValidateVideoFile(path string) (time.Duration, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return 0, fmt.Errorf("video file: %w", err)
+	}
+
+	if info.IsDir() {
+		return 0, fmt.Errorf("video path %s is a directory", path)
+	}
+
+	if info.Size() < MinVideoSize || info.Size() > MaxVideoSize {
+		return 0, fmt.Errorf("video file %s is %d bytes, LinkedIn accepts %d to %d", path, info.Size(), MinVideoSize, MaxVideoSize)
+	}
+
+	file, err := os.Open(filepath.Clean(path))
+	if err != nil {
+		return 0, fmt.Errorf("failed to open video: %w", err)
+	}
+
+	defer func() {
+		_ = file.Close()
+	}()
+
+	head := make([]byte, sniffLength)
+
+	n, err := io.ReadFull(file, head)
+	if err != nil && !errors.Is(err, io.ErrUnexpectedEOF) {
+		return 0, fmt.Errorf("failed to read video: %w", err)
+	}
+
+	if contentType := http.DetectContentType(head[:n]); contentType != "video/mp4" {
+		return 0, fmt.Errorf("video file %s is %s, only video/mp4 is supported", path, contentType)
+	}
+
+	duration, err := mp4Duration(file, info.Size())
+	if err != nil {
+		return 0, fmt.Errorf("video file %s: %w", path, err)
+	}
+
+	if duration < MinVideoDuration || duration > MaxVideoDuration {
+		return 0, fmt.Errorf("video file %s is %v long, LinkedIn accepts %v to %v", path, duration.Round(time.Second), MinVideoDuration, MaxVideoDuration)
+	}
+
+	return duration, nil
+}
+
+// ValidateVideoTitle checks the video title length.
+func ValidateVideoTitle(title string) error {
+	if len([]rune(title)) > maxVideoTitleLength {
+		return fmt.Errorf("video title must be at most %d characters", maxVideoTitleLength)
+	}
+
+	return nil
+}
+
+// mp4Duration reads the duration from the movie header (moov/mvhd) of an MP4 file.
+func mp4Duration(r io.ReaderAt, size int64) (time.Duration, error) {
+	moovStart, moovEnd, err := findBox(r, 0, size, "moov")
+	if err != nil {
+		return 0, err
+	}
+
+	mvhdStart, mvhdEnd, err := findBox(r, moovStart, moovEnd, "mvhd")
+	if err != nil {
+		return 0, err
+	}
+
+	header := make([]byte, mvhdEnd-mvhdStart)
+	if _, err := r.ReadAt(header, mvhdStart); err != nil {
+		return 0, fmt.Errorf("failed to read movie header: %w", err)
+	}
+
+	var timescale, duration uint64
+
+	// Version 0 uses 32-bit creation/modification times and duration, version 1 uses 64-bit ones
+	switch {
+	case len(header) >= 20 && header[0] == 0:
+		timescale = uint64(binary.BigEndian.Uint32(header[12:16]))
+		duration = uint64(binary.BigEndian.Uint32(header[16:20]))
+	case len(header) >= 32 && header[0] == 1:
+		timescale = uint64(binary.BigEndian.Uint32(header[20:24]))
+		duration = binary.BigEndian.Uint64(header[24:32])
+	default:
+		return 0, fmt.Errorf("unsupported movie header")
+	}
+
+	if timescale == 0 {
+		return 0, fmt.Errorf("movie header has no timescale")
+	}
+
+	return time.Duration(float64(duration) / float64(timescale) * float64(time.Second)), nil
+}
+
+// findBox scans the MP4 boxes in [start, end) and returns the payload range of the first box of the given type.
+func findBox(r io.ReaderAt, start, end int64, boxType string) (int64, int64, error) {
+	header := make([]byte, 16)
+
+	for offset := start; offset+8 <= end; {
+		if _, err := r.ReadAt(header[:8], offset); err != nil {
+			return 0, 0, fmt.Errorf("failed to read MP4 box: %w", err)
+		}
+
+		size := int64(binary.BigEndian.Uint32(header[:4]))
+		headerLen := int64(8)
+
+		switch size {
+		case 0:
+			size = end - offset // Box extends to the end of its parent
+		case 1:
+			if _, err := r.ReadAt(header[8:16], offset+8); err != nil {
+				return 0, 0, fmt.Errorf("failed to read MP4 box: %w", err)
+			}
+
+			size = int64(binary.BigEndian.Uint64(header[8:16]))
+			headerLen = 16
+		}
+
+		if size < headerLen || offset+size > end {
+			return 0, 0, fmt.Errorf("malformed MP4 box at offset %d", offset)
+		}
+
+		if string(header[4:8]) == boxType {
+			return offset + headerLen, offset + size, nil
+		}
+
+		offset += size
+	}
+
+	return 0, 0, fmt.Errorf("not a valid MP4: no %s box", boxType)
+}
+
+// UploadVideo uploads a local MP4 owned by the given author URN and returns the video URN to
+// reference in a post. It runs LinkedIn's multi-step flow: register the upload to get the part
+// instructions, PUT each part, finalize with the collected part IDs and wait for processing.
+// Errors name the stage that failed.
+func (c *Client) UploadVideo(ctx context.Context, owner, path string) (string, error) {
+	if c.token == nil {
+		return "", fmt.Errorf("no access token available")
+	}
+
+	if _, err := ValidateVideoFile(path); err != nil {
+		return "", err
+	}
+
+	file, err := os.Open(filepath.Clean(path))
+	if err != nil {
+		return "", fmt.Errorf("failed to open video: %w", err)
+	}
+
+	defer func() {
+		_ = file.Close()
+	}()
+
+	info, err := file.Stat()
+	if err != nil {
+		return "", fmt.Errorf("failed to read video: %w", err)
+	}
+
+	video, token, parts, err := c.initializeVideoUpload(ctx, owner, info.Size())
+	if err != nil {
+		return "", fmt.Errorf("video upload: initialize failed: %w", err)
+	}
+
+	partIDs := make([]string, 0, len(parts))
+
+	for i, part := range parts {
+		etag, err := c.putVideoPart(ctx, file, part)
+		if err != nil {
+			return "", fmt.Errorf("video upload: part %d of %d for %s failed: %w", i+1, len(parts), video, err)
+		}
+
+		partIDs = append(partIDs, etag)
+
+		fmt.Printf("📤 Uploaded video part %d of %d (%d bytes)\n", i+1, len(parts), part.LastByte-part.FirstByte+1)
+	}
+
+	if err := c.finalizeVideoUpload(ctx, video, token, partIDs); err != nil {
+		return "", fmt.Errorf("video upload: finalize %s failed: %w", video, err)
+	}
+
+	if err := c.waitForVideo(ctx, video); err != nil {
+		return "", fmt.Errorf("video upload: processing %s failed: %w", video, err)
+	}
+
+	return video, nil
+}
+
+// initializeVideoUpload registers an upload and returns the video URN, upload token and part instructions.
+func (c *Client) initializeVideoUpload(ctx context.Context, owner string, size int64) (string, string, []uploadInstruction, error) {
+	payload, err := json.Marshal(map[string]interface{}{
+		"initializeUploadRequest": map[string]interface{}{
+			"owner":           owner,
+			"fileSizeBytes":   size,
+			"uploadCaptions":  false,
+			"uploadThumbnail": false,
+		},
+	})
+	if err != nil {
+		return "", "", nil, fmt.Errorf("failed to marshal upload request: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", VideosURL+"?action=initializeUpload", bytes.NewBuffer(payload))
+	if err != nil {
+		return "", "", nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	c.setAPIHeaders(req)
+
+	body, status, err := c.do(req)
+	if err != nil {
+		return "", "", nil, err
+	}
+
+	if status != http.StatusOK {
+		return "", "", nil, c.apiError("API", status, body)
+	}
+
+	var result struct {
+		Value struct {
+			Video              string              `json:"video"`
+			UploadToken        string              `json:"uploadToken"`
+			UploadInstructions []uploadInstruction `json:"uploadInstructions"`
+		} `json:"value"`
+	}
+
+	if err := json.Unmarshal(body, &result); err != nil {
+		return "", "", nil, fmt.Errorf("failed to parse upload response: %w", err)
+	}
+
+	if result.Value.Video == "" || len(result.Value.UploadInstructions) == 0 {
+		return "", "", nil, fmt.Errorf("upload response is missing the video URN or upload instructions")
+	}
+
+	return result.Value.Video, result.Value.UploadToken, result.Value.UploadInstructions, nil
+}
+
+// putVideoPart sends one byte range of the video and returns the part ID (ETag) LinkedIn assigned.
+func (c *Client) putVideoPart(ctx context.Context, file *os.File, part uploadInstruction) (string, error) {
+	length := part.LastByte - part.FirstByte + 1
+	if part.FirstByte < 0 || length <= 0 {
+		return "", fmt.Errorf("invalid byte range %d-%d", part.FirstByte, part.LastByte)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "PUT", part.UploadURL, io.NewSectionReader(file, part.FirstByte, length))
+	if err != nil {
+		return "", fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.ContentLength = length
+	req.Header.Set("Content-Type", "application/octet-stream")
+	req.Header.Set("Authorization", "Bearer "+c.token.AccessToken)
+
+	client := &http.Client{
+		Timeout: httpTimeout,
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("request failed: %w", err)
+	}
+
+	defer func() {
+		if closeErr := resp.Body.Close(); closeErr != nil {
+			fmt.Printf("Warning: failed to close response body: %v\n", closeErr)
+		}
+	}()
+
+	c.lastRequestID = RequestID(resp.Header)
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusCreated {
+		body, _ := io.ReadAll(resp.Body)
+		return "", c.apiError("upload", resp.StatusCode, body)
+	}
+
+	etag := resp.Header.Get("ETag")
+	if etag == "" {
+		return "", fmt.Errorf("upload response is missing the ETag part ID")
+	}
+
+	return etag, nil
+}
+
+// finalizeVideoUpload tells LinkedIn all parts were sent.
+func (c *Client) finalizeVideoUpload(ctx context.Context, video, token string, partIDs []string) error {
+	payload, err := json.Marshal(map[string]interface{}{
+		"finalizeUploadRequest": map[string]interface{}{
+			"video":           video,
+			"uploadToken":     token,
+			"uploadedPartIds": partIDs,
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to marshal finalize request: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", VideosURL+"?action=finalizeUpload", bytes.NewBuffer(payload))
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	c.setAPIHeaders(req)
+
+	body, status, err := c.do(req)
+	if err != nil {
+		return err
+	}
+
+	if status != http.StatusOK {
+		return c.apiError("API", status, body)
+	}
+
+	return nil
+}
+
+// waitForVideo polls the video until LinkedIn has processed it, since posting an unprocessed video fails.
+func (c *Client) waitForVideo(ctx context.Context, video string) error {
+	ctx, cancel := context.WithTimeout(ctx, maxVideoProcessing)
+	defer cancel()
+
+	for {
+		req, err := http.NewRequestWithContext(ctx, "GET", VideosURL+"/"+url.PathEscape(video), http.NoBody)
+		if err != nil {
+			return fmt.Errorf("failed to create request: %w", err)
+		}
+
+		c.setAPIHeaders(req)
+
+		body, status, err := c.do(req)
+		if err != nil {
+			return err
+		}
+
+		if status != http.StatusOK {
+			return c.apiError("API", status, body)
+		}
+
+		var result struct {
+			Status string `json:"status"`
+		}
+
+		if err := json.Unmarshal(body, &result); err != nil {
+			return fmt.Errorf("failed to parse video status: %w", err)
+		}
+
+		switch result.Status {
+		case videoAvailable:
+			return nil
+		case videoProcessingFailed:
+			return fmt.Errorf("LinkedIn could not process the video")
+		}
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("video still %s: %w", result.Status, ctx.Err())
+		case <-time.After(videoPollInterval):
+		}
+	}
+}
@@ -0,0 +1,203 @@
+package linkedin
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+// box encodes an MP4 box of the given type around payload.
+func box(boxType string, payload []byte) []byte {
+	data := binary.BigEndian.AppendUint32(nil, uint32(8+len(payload)))
+	data = append(data, boxType...)
+
+	return append(data, payload...)
+}
+
+// writeMP4 writes a minimal MP4 of the given duration, padded with media data to size bytes.
+func writeMP4(t *testing.T, duration time.Duration, size int) string {
+	t.Helper()
+
+	const timescale = 1000
+
+	mvhd := make([]byte, 20) // Version 0: version/flags, creation, modification, timescale, duration
+	binary.BigEndian.PutUint32(mvhd[12:16], timescale)
+	binary.BigEndian.PutUint32(mvhd[16:20], uint32(duration.Milliseconds()))
+
+	data := box("ftyp", []byte("mp42\x00\x00\x00\x00mp42isom"))
+	data = append(data, box("moov", box("mvhd", mvhd))...)
+	data = append(data, box("mdat", make([]byte, max(size-len(data)-8, 0)))...)
+
+	path := filepath.Join(t.TempDir(), "clip.mp4")
+	if err := os.WriteFile(path, data, 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	return path
+}
+
+func TestValidateVideoFile(t *testing.T) {
+	duration, err := ValidateVideoFile(writeMP4(t, 90*time.Second, MinVideoSize))
+	if err != nil {
+		t.Fatalf("ValidateVideoFile: %v", err)
+	}
+
+	if duration != 90*time.Second {
+		t.Errorf("duration = %v, want 90s", duration)
+	}
+
+	tests := map[string]string{
+		"too short":  writeMP4(t, time.Second, MinVideoSize),
+		"too long":   writeMP4(t, time.Hour, MinVideoSize),
+		"too small":  writeMP4(t, 90*time.Second, MinVideoSize/2),
+		"not an mp4": writeFile(t, "clip.mp4", strings.Repeat("x", MinVideoSize)),
+		"missing":    filepath.Join(t.TempDir(), "missing.mp4"),
+	}
+
+	for name, path := range tests {
+		if _, err := ValidateVideoFile(path); err == nil {
+			t.Errorf("%s: ValidateVideoFile accepted %s", name, path)
+		}
+	}
+}
+
+// fakeVideoAPI serves LinkedIn's video upload flow for a file of size bytes split in two parts.
+// fail names the stage that responds with an error: "initialize", "part 2", "finalize" or "processing".
+func fakeVideoAPI(t *testing.T, size int, fail string) (*[]string, *[][]byte) {
+	t.Helper()
+
+	var (
+		steps []string
+		parts [][]byte
+	)
+
+	half := size / 2
+
+	useFakeAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost && r.URL.Query().Get("action") == "initializeUpload":
+			steps = append(steps, "initialize")
+
+			if fail == "initialize" {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+
+			_, _ = fmt.Fprintf(w, `{"value": {"video": "urn:li:video:v1", "uploadToken": "tok", "uploadInstructions": [
+				{"uploadUrl": "https://uploads.linkedin.com/v1/part1", "firstByte": 0, "lastByte": %d},
+				{"uploadUrl": "https://uploads.linkedin.com/v1/part2", "firstByte": %d, "lastByte": %d}]}}`, half-1, half, size-1)
+		case r.Method == http.MethodPut:
+			part := strings.TrimPrefix(r.URL.Path, "/v1/part")
+			steps = append(steps, "part "+part)
+
+			if fail == "part "+part {
+				w.WriteHeader(http.StatusInternalServerError)
+				return
+			}
+
+			body, _ := io.ReadAll(r.Body)
+			parts = append(parts, body)
+
+			w.Header().Set("ETag", "etag-"+part)
+		case r.Method == http.MethodPost && r.URL.Query().Get("action") == "finalizeUpload":
+			steps = append(steps, "finalize")
+
+			var req struct {
+				Finalize struct {
+					Video   string   `json:"video"`
+					Token   string   `json:"uploadToken"`
+					PartIDs []string `json:"uploadedPartIds"`
+				} `json:"finalizeUploadRequest"`
+			}
+
+			if err := json.NewDecoder(r.Body).Decode(&req); err != nil || req.Finalize.Token != "tok" ||
+				strings.Join(req.Finalize.PartIDs, ",") != "etag-1,etag-2" {
+				t.Errorf("finalize request = %+v, want the upload token and both part IDs in order", req.Finalize)
+			}
+
+			if fail == "finalize" {
+				w.WriteHeader(http.StatusBadRequest)
+			}
+		case r.Method == http.MethodGet:
+			steps = append(steps, "status")
+
+			if fail == "processing" {
+				_, _ = io.WriteString(w, `{"status": "PROCESSING_FAILED"}`)
+				return
+			}
+
+			_, _ = io.WriteString(w, `{"status": "AVAILABLE"}`)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL)
+		}
+	})
+
+	return &steps, &parts
+}
+
+func TestUploadVideoSequence(t *testing.T) {
+	path := writeMP4(t, 90*time.Second, MinVideoSize)
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	steps, parts := fakeVideoAPI(t, len(data), "")
+
+	video, err := newTestClient().UploadVideo(context.Background(), "urn:li:person:abc", path)
+	if err != nil {
+		t.Fatalf("UploadVideo: %v", err)
+	}
+
+	if video != "urn:li:video:v1" {
+		t.Errorf("video = %q, want urn:li:video:v1", video)
+	}
+
+	if got := strings.Join(*steps, ","); got != "initialize,part 1,part 2,finalize,status" {
+		t.Errorf("steps = %s, want initialize, both parts, finalize, then the status check", got)
+	}
+
+	if len(*parts) != 2 || !bytes.Equal(bytes.Join(*parts, nil), data) {
+		t.Error("uploaded parts do not add up to the video file")
+	}
+}
+
+func TestUploadVideoStageErrors(t *testing.T) {
+	path := writeMP4(t, 90*time.Second, MinVideoSize)
+
+	tests := []struct {
+		fail      string
+		wantErr   string
+		wantSteps string
+	}{
+		{"initialize", "initialize failed", "initialize"},
+		{"part 2", "part 2 of 2 for urn:li:video:v1 failed", "initialize,part 1,part 2"},
+		{"finalize", "finalize urn:li:video:v1 failed", "initialize,part 1,part 2,finalize"},
+		{"processing", "processing urn:li:video:v1 failed", "initialize,part 1,part 2,finalize,status"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.fail, func(t *testing.T) {
+			steps, _ := fakeVideoAPI(t, MinVideoSize, tt.fail)
+
+			_, err := newTestClient().UploadVideo(context.Background(), "urn:li:person:abc", path)
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("error = %v, want it to contain %q", err, tt.wantErr)
+			}
+
+			if got := strings.Join(*steps, ","); got != tt.wantSteps {
+				t.Errorf("steps = %s, want %s", got, tt.wantSteps)
+			}
+		})
+	}
+}
@@ -0,0 +1,31 @@
+package linkedin
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	// VisibilityPublic makes a post visible to anyone on or off LinkedIn.
+	VisibilityPublic = "PUBLIC"
+	// VisibilityConnections limits a post to the author's first-degree connections.
+	VisibilityConnections = "CONNECTIONS"
+	// VisibilityLoggedIn limits a post to members signed in to LinkedIn.
+	VisibilityLoggedIn = "LOGGED_IN"
+)
+
+// Visibilities lists the post visibilities supported by the scheduler.
+var Visibilities = []string{VisibilityPublic, VisibilityConnections, VisibilityLoggedIn}
+
+// NormalizeVisibility upper-cases a visibility and checks that LinkedIn supports it.
+func NormalizeVisibility(v string) (string, error) {
+	v = strings.ToUpper(strings.TrimSpace(v))
+
+	for _, valid := range Visibilities {
+		if v == valid {
+			return v, nil
+		}
+	}
+
+	return "", fmt.Errorf("visibility must be one of %s", strings.Join(Visibilities, ", "))
+}
@@ -1,8 +1,9 @@
-// Package storage provides data persistence functionality for LinkedIn posts using JSON file storage.
+// Package storage provides data persistence for LinkedIn posts behind the Storage interface, with a JSON file implementation.
 package storage
 
 import (
 	"encoding/json"
+	"log"
 	"os"
 
 	"PostedIn/internal/models"
@@ -11,6 +12,7 @@ import (
 // JSONStorage provides JSON file-based storage for LinkedIn posts.
 type JSONStorage struct {
 	filename string
+	replica  string // Optional second copy written after every save and read when the primary is unusable
 }
 
 // NewJSONStorage creates a new JSON storage instance with the specified filename.
@@ -20,18 +22,56 @@ func NewJSONStorage(filename string) *JSONStorage {
 	}
 }
 
-// LoadPosts loads all posts from the JSON storage file.
+// NewReplicatedJSONStorage creates a JSON storage that mirrors every save to the replica file.
+// An empty replica disables mirroring.
+func NewReplicatedJSONStorage(filename, replica string) *JSONStorage {
+	return &JSONStorage{
+		filename: filename,
+		replica:  replica,
+	}
+}
+
+// LoadPosts loads all posts from the JSON storage file, falling back to the replica when the
+// primary file is missing or corrupt.
 func (js *JSONStorage) LoadPosts() ([]models.Post, error) {
-	data, err := os.ReadFile(js.filename)
+	posts, err := readPosts(js.filename)
+	if err == nil && posts != nil {
+		return posts, nil
+	}
+
+	if js.replica != "" {
+		replicaPosts, replicaErr := readPosts(js.replica)
+		if replicaErr == nil && replicaPosts != nil {
+			reason := "missing"
+			if err != nil {
+				reason = err.Error()
+			}
+
+			log.Printf("⚠️ Posts file %s is unusable (%s), loaded %d post(s) from replica %s", js.filename, reason, len(replicaPosts), js.replica)
+
+			return replicaPosts, nil
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return []models.Post{}, nil // File doesn't exist yet, return empty slice
+}
+
+// readPosts reads posts from a JSON file; a missing file returns nil posts and no error.
+func readPosts(filename string) ([]models.Post, error) {
+	data, err := os.ReadFile(filename)
 	if err != nil {
 		if os.IsNotExist(err) {
-			return []models.Post{}, nil // File doesn't exist yet, return empty slice
+			return nil, nil
 		}
 
 		return nil, err // Return the actual error for other cases
 	}
 
-	var posts []models.Post
+	posts := []models.Post{}
 
 	err = json.Unmarshal(data, &posts)
 	if err != nil {
@@ -41,7 +81,7 @@ func (js *JSONStorage) LoadPosts() ([]models.Post, error) {
 	return posts, nil
 }
 
-// SavePosts saves all posts to the JSON storage file.
+// SavePosts saves all posts to the JSON storage file, then best-effort to the replica.
 func (js *JSONStorage) SavePosts(posts []models.Post) error {
 	data, err := json.MarshalIndent(posts, "", "  ")
 	if err != nil {
@@ -50,5 +90,16 @@ func (js *JSONStorage) SavePosts(posts []models.Post) error {
 
 	const restrictedPerm = 0o600
 
-	return os.WriteFile(js.filename, data, restrictedPerm)
+	if err := os.WriteFile(js.filename, data, restrictedPerm); err != nil {
+		return err
+	}
+
+	// The primary is the source of truth, so a failed replica write only gets logged
+	if js.replica != "" {
+		if err := os.WriteFile(js.replica, data, restrictedPerm); err != nil {
+			log.Printf("⚠️ Failed to write posts replica %s: %v", js.replica, err)
+		}
+	}
+
+	return nil
 }
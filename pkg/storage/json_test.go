@@ -0,0 +1,95 @@
+package storage
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"PostedIn/internal/models"
+)
+
+func TestReplicaWriteThrough(t *testing.T) {
+	dir := t.TempDir()
+	primary, replica := filepath.Join(dir, "posts.json"), filepath.Join(dir, "replica.json")
+	store := NewReplicatedJSONStorage(primary, replica)
+
+	if err := store.SavePosts([]models.Post{{ID: 1, Content: "mirrored"}}); err != nil {
+		t.Fatalf("SavePosts: %v", err)
+	}
+
+	want, err := os.ReadFile(primary)
+	if err != nil {
+		t.Fatalf("read primary: %v", err)
+	}
+
+	got, err := os.ReadFile(replica)
+	if err != nil {
+		t.Fatalf("read replica: %v", err)
+	}
+
+	if string(got) != string(want) {
+		t.Errorf("replica = %s, want a copy of the primary %s", got, want)
+	}
+}
+
+func TestReplicaWriteFailureKeepsPrimary(t *testing.T) {
+	dir := t.TempDir()
+	primary := filepath.Join(dir, "posts.json")
+	store := NewReplicatedJSONStorage(primary, filepath.Join(dir, "missing", "replica.json"))
+
+	if err := store.SavePosts([]models.Post{{ID: 1, Content: "kept"}}); err != nil {
+		t.Fatalf("SavePosts failed on a replica error: %v", err)
+	}
+
+	posts, err := NewJSONStorage(primary).LoadPosts()
+	if err != nil || len(posts) != 1 {
+		t.Fatalf("primary = %+v, %v, want the saved post", posts, err)
+	}
+}
+
+func TestLoadFallsBackToReplica(t *testing.T) {
+	dir := t.TempDir()
+	primary, replica := filepath.Join(dir, "posts.json"), filepath.Join(dir, "replica.json")
+	store := NewReplicatedJSONStorage(primary, replica)
+
+	if err := store.SavePosts([]models.Post{{ID: 1, Content: "survivor"}}); err != nil {
+		t.Fatalf("SavePosts: %v", err)
+	}
+
+	if err := os.WriteFile(primary, []byte(`[{"id": 1, "content": `), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	posts, err := store.LoadPosts()
+	if err != nil {
+		t.Fatalf("LoadPosts with a corrupt primary: %v", err)
+	}
+
+	if len(posts) != 1 || posts[0].Content != "survivor" {
+		t.Errorf("loaded %+v, want the replica's post", posts)
+	}
+
+	if err := os.Remove(primary); err != nil {
+		t.Fatal(err)
+	}
+
+	if posts, err := store.LoadPosts(); err != nil || len(posts) != 1 {
+		t.Errorf("LoadPosts with a missing primary = %+v, %v, want the replica's post", posts, err)
+	}
+}
+
+func TestLoadCorruptWithoutReplica(t *testing.T) {
+	primary := filepath.Join(t.TempDir(), "posts.json")
+	if err := os.WriteFile(primary, []byte("not json"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := NewJSONStorage(primary).LoadPosts(); err == nil {
+		t.Error("LoadPosts accepted a corrupt file without a replica")
+	}
+
+	posts, err := NewJSONStorage(filepath.Join(t.TempDir(), "new.json")).LoadPosts()
+	if err != nil || len(posts) != 0 {
+		t.Errorf("missing file = %+v, %v, want no posts", posts, err)
+	}
+}
@@ -0,0 +1,192 @@
+// Package sqlite stores posts in a SQLite database. It needs cgo, so it lives apart from package storage
+// and is only linked into binaries built with the sqlite tag.
+package sqlite
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"PostedIn/internal/models"
+	"PostedIn/pkg/storage"
+
+	_ "github.com/mattn/go-sqlite3" // SQLite driver; needs cgo
+)
+
+// sqliteTimeLayout stores times in UTC with fixed-width fractions so they sort as text.
+const sqliteTimeLayout = "2006-01-02 15:04:05.000000000"
+
+// sqliteSchema creates the posts table; data holds the whole post as JSON so fields without
+// a column, such as polls, tags and history, are kept.
+const sqliteSchema = `
+CREATE TABLE IF NOT EXISTS posts (
+	id            INTEGER PRIMARY KEY,
+	content       TEXT    NOT NULL,
+	scheduled_at  TEXT    NOT NULL,
+	status        TEXT    NOT NULL,
+	created_at    TEXT    NOT NULL,
+	cron_entry_id INTEGER NOT NULL DEFAULT 0,
+	data          TEXT    NOT NULL
+);
+CREATE INDEX IF NOT EXISTS posts_scheduled_at ON posts (scheduled_at);`
+
+const sqliteUpsert = `
+INSERT INTO posts (id, content, scheduled_at, status, created_at, cron_entry_id, data)
+VALUES (?, ?, ?, ?, ?, ?, ?)
+ON CONFLICT (id) DO UPDATE SET
+	content = excluded.content,
+	scheduled_at = excluded.scheduled_at,
+	status = excluded.status,
+	created_at = excluded.created_at,
+	cron_entry_id = excluded.cron_entry_id,
+	data = excluded.data`
+
+// Storage stores posts in a SQLite database, one row per post. Saves only write the rows
+// that changed since the last load or save, so large post lists are not rewritten on every change.
+type Storage struct {
+	db *sql.DB
+
+	mu    sync.Mutex
+	saved map[int]string // JSON of each stored post as last loaded or saved
+}
+
+var _ storage.Storage = (*Storage)(nil)
+
+// NewStorage opens the SQLite database at dsn, e.g. "posts.db", creating the posts table if missing.
+func NewStorage(dsn string) (*Storage, error) {
+	db, err := sql.Open("sqlite3", dsn)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open SQLite database: %w", err)
+	}
+
+	// SQLite allows one writer at a time; a single connection avoids "database is locked" errors
+	db.SetMaxOpenConns(1)
+
+	if _, err := db.Exec(sqliteSchema); err != nil {
+		_ = db.Close()
+		return nil, fmt.Errorf("failed to create posts table: %w", err)
+	}
+
+	s := &Storage{db: db}
+
+	// Loading fills the row cache, so the first save also removes posts deleted since
+	if _, err := s.LoadPosts(); err != nil {
+		_ = db.Close()
+		return nil, err
+	}
+
+	return s, nil
+}
+
+// Close closes the database.
+func (s *Storage) Close() error {
+	return s.db.Close()
+}
+
+// LoadPosts loads all posts ordered by scheduled time.
+func (s *Storage) LoadPosts() ([]models.Post, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	rows, err := s.db.Query(`SELECT id, data FROM posts ORDER BY scheduled_at, id`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query posts: %w", err)
+	}
+
+	defer func() { _ = rows.Close() }()
+
+	posts := []models.Post{}
+	saved := make(map[int]string)
+
+	for rows.Next() {
+		var (
+			id   int
+			data string
+		)
+
+		if err := rows.Scan(&id, &data); err != nil {
+			return nil, fmt.Errorf("failed to read post row: %w", err)
+		}
+
+		var post models.Post
+		if err := json.Unmarshal([]byte(data), &post); err != nil {
+			return nil, fmt.Errorf("failed to decode post %d: %w", id, err)
+		}
+
+		posts = append(posts, post)
+		saved[id] = data
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read posts: %w", err)
+	}
+
+	s.saved = saved
+
+	return posts, nil
+}
+
+// SavePosts writes the posts that changed and deletes those no longer present, in one transaction.
+func (s *Storage) SavePosts(posts []models.Post) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	current := make(map[int]string, len(posts))
+
+	tx, err := s.db.Begin()
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+
+	defer func() { _ = tx.Rollback() }()
+
+	for _, post := range posts {
+		data, err := json.Marshal(post)
+		if err != nil {
+			return fmt.Errorf("failed to encode post %d: %w", post.ID, err)
+		}
+
+		current[post.ID] = string(data)
+		if s.saved[post.ID] == string(data) {
+			continue
+		}
+
+		_, err = tx.Exec(sqliteUpsert,
+			post.ID,
+			post.Content,
+			sqliteTime(post.ScheduledAt),
+			post.Status,
+			sqliteTime(post.CreatedAt),
+			post.CronEntryID,
+			string(data),
+		)
+		if err != nil {
+			return fmt.Errorf("failed to save post %d: %w", post.ID, err)
+		}
+	}
+
+	for id := range s.saved {
+		if _, ok := current[id]; ok {
+			continue
+		}
+
+		if _, err := tx.Exec(`DELETE FROM posts WHERE id = ?`, id); err != nil {
+			return fmt.Errorf("failed to delete post %d: %w", id, err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit posts: %w", err)
+	}
+
+	s.saved = current
+
+	return nil
+}
+
+// sqliteTime formats a time the way the posts table stores it.
+func sqliteTime(t time.Time) string {
+	return t.UTC().Format(sqliteTimeLayout)
+}
@@ -0,0 +1,57 @@
+package sqlite
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"PostedIn/internal/models"
+)
+
+func TestStorageRoundTrip(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "posts.db")
+
+	store, err := NewStorage(path)
+	if err != nil {
+		t.Fatalf("NewStorage: %v", err)
+	}
+
+	at := time.Date(2025, 6, 1, 9, 0, 0, 0, time.UTC)
+	posts := []models.Post{
+		{ID: 2, Content: "second", ScheduledAt: at.Add(time.Hour), Status: models.StatusScheduled, Tags: []string{"launch"}},
+		{ID: 1, Content: "first", ScheduledAt: at, Status: models.StatusScheduled, Poll: &models.Poll{Question: "?", Options: []string{"a", "b"}}},
+	}
+
+	if err := store.SavePosts(posts); err != nil {
+		t.Fatalf("SavePosts: %v", err)
+	}
+
+	// Dropping a post deletes its row
+	if err := store.SavePosts(posts[:1]); err != nil {
+		t.Fatalf("SavePosts after delete: %v", err)
+	}
+
+	if err := store.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	reopened, err := NewStorage(path)
+	if err != nil {
+		t.Fatalf("reopen: %v", err)
+	}
+
+	defer func() { _ = reopened.Close() }()
+
+	loaded, err := reopened.LoadPosts()
+	if err != nil {
+		t.Fatalf("LoadPosts: %v", err)
+	}
+
+	if len(loaded) != 1 || loaded[0].ID != 2 || loaded[0].Content != "second" {
+		t.Fatalf("loaded %+v, want only post 2", loaded)
+	}
+
+	if len(loaded[0].Tags) != 1 || loaded[0].Tags[0] != "launch" {
+		t.Errorf("fields without a column were not kept: tags = %v", loaded[0].Tags)
+	}
+}
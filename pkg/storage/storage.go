@@ -0,0 +1,12 @@
+package storage
+
+import "PostedIn/internal/models"
+
+// Storage persists the full set of posts; the scheduler works with any implementation.
+type Storage interface {
+	LoadPosts() ([]models.Post, error)
+	SavePosts(posts []models.Post) error
+}
+
+// JSONStorage is the default implementation.
+var _ Storage = (*JSONStorage)(nil)